@@ -11,14 +11,51 @@ import (
 var (
 	APP_ENV string
 	PORT    string
+	// TRUSTED_PROXIES are the addresses or CIDR ranges of the reverse proxies whose X-Forwarded-For
+	// header is believed. Without any, the client IP address is always the address of the connection.
+	TRUSTED_PROXIES []string
 
 	DATABASE_HOST     string
 	DATABASE_PORT     string
 	DATABASE_NAME     string
 	DATABASE_USERNAME string
 	DATABASE_PASSWORD string
+	// DATABASE_TIMEZONE is the timezone DATETIME values are stored in, not a domain's display timezone
+	DATABASE_TIMEZONE string
 
 	JWT_SECRET string
+	// CARD_QR_SECRET signs the QR codes printed on membership cards, defaults to JWT_SECRET
+	CARD_QR_SECRET string
+	// FOLLOWER_JWT_SECRET signs follower portal tokens, defaults to JWT_SECRET
+	FOLLOWER_JWT_SECRET string
+
+	// OTP_SENDER delivers follower portal sign-in codes: log or messaging
+	OTP_SENDER string
+
+	// Password Policy Configuration
+	PASSWORD_MIN_LENGTH     int
+	PASSWORD_REQUIRE_LOWER  bool
+	PASSWORD_REQUIRE_UPPER  bool
+	PASSWORD_REQUIRE_DIGIT  bool
+	PASSWORD_REQUIRE_SYMBOL bool
+	// PASSWORD_HISTORY is how many recent passwords, the current one included, cannot be reused
+	PASSWORD_HISTORY int
+
+	// PASSWORD_RESET_NOTIFIER delivers password reset tokens: log or webhook
+	PASSWORD_RESET_NOTIFIER       string
+	PASSWORD_RESET_WEBHOOK_URL    string
+	PASSWORD_RESET_WEBHOOK_SECRET string
+
+	// Messaging Configuration
+	MESSAGING_PROVIDER                 string // whatsapp, sms or log
+	MESSAGING_MAX_ATTEMPTS             int
+	MESSAGING_LOG_PATH                 string
+	MESSAGING_WHATSAPP_API_URL         string
+	MESSAGING_WHATSAPP_PHONE_NUMBER_ID string
+	MESSAGING_WHATSAPP_ACCESS_TOKEN    string
+	MESSAGING_SMS_GATEWAY_URL          string
+	MESSAGING_SMS_API_KEY              string
+	MESSAGING_SMS_SENDER               string
 
 	// Logging Configuration
 	LOG_LEVEL              string
@@ -44,17 +81,58 @@ func LoadEnv() {
 
 	APP_ENV = os.Getenv("APP_ENV")
 	PORT = os.Getenv("PORT")
+	TRUSTED_PROXIES = getEnvListWithDefault("TRUSTED_PROXIES", nil)
 
 	DATABASE_HOST = os.Getenv("DATABASE_HOST")
 	DATABASE_PORT = os.Getenv("DATABASE_PORT")
 	DATABASE_NAME = os.Getenv("DATABASE_NAME")
 	DATABASE_USERNAME = os.Getenv("DATABASE_USERNAME")
 	DATABASE_PASSWORD = os.Getenv("DATABASE_PASSWORD")
+	DATABASE_TIMEZONE = getEnvWithDefault("DATABASE_TIMEZONE", "Asia/Jakarta")
 
 	JWT_SECRET = os.Getenv("JWT_SECRET")
+	CARD_QR_SECRET = getEnvWithDefault("CARD_QR_SECRET", JWT_SECRET)
+	FOLLOWER_JWT_SECRET = getEnvWithDefault("FOLLOWER_JWT_SECRET", JWT_SECRET)
+
+	OTP_SENDER = getEnvWithDefault("OTP_SENDER", "log")
+
+	// Load password policy and reset configuration with defaults
+	loadPasswordConfig()
 
 	// Load logging configuration with defaults
 	loadLoggingConfig()
+
+	// Load messaging configuration with defaults
+	loadMessagingConfig()
+}
+
+// loadPasswordConfig loads the password policy and the password reset notifier configuration
+func loadPasswordConfig() {
+	PASSWORD_MIN_LENGTH = getEnvIntWithDefault("PASSWORD_MIN_LENGTH", 8)
+	PASSWORD_REQUIRE_LOWER = getEnvBoolWithDefault("PASSWORD_REQUIRE_LOWER", true)
+	PASSWORD_REQUIRE_UPPER = getEnvBoolWithDefault("PASSWORD_REQUIRE_UPPER", true)
+	PASSWORD_REQUIRE_DIGIT = getEnvBoolWithDefault("PASSWORD_REQUIRE_DIGIT", true)
+	PASSWORD_REQUIRE_SYMBOL = getEnvBoolWithDefault("PASSWORD_REQUIRE_SYMBOL", false)
+	PASSWORD_HISTORY = getEnvIntWithDefault("PASSWORD_HISTORY", 5)
+
+	PASSWORD_RESET_NOTIFIER = getEnvWithDefault("PASSWORD_RESET_NOTIFIER", "log")
+	PASSWORD_RESET_WEBHOOK_URL = os.Getenv("PASSWORD_RESET_WEBHOOK_URL")
+	PASSWORD_RESET_WEBHOOK_SECRET = os.Getenv("PASSWORD_RESET_WEBHOOK_SECRET")
+}
+
+// loadMessagingConfig loads the outbound messaging provider configuration
+func loadMessagingConfig() {
+	MESSAGING_PROVIDER = getEnvWithDefault("MESSAGING_PROVIDER", "log")
+	MESSAGING_MAX_ATTEMPTS = getEnvIntWithDefault("MESSAGING_MAX_ATTEMPTS", 5)
+	MESSAGING_LOG_PATH = getEnvWithDefault("MESSAGING_LOG_PATH", "logs/messages.log")
+
+	MESSAGING_WHATSAPP_API_URL = getEnvWithDefault("MESSAGING_WHATSAPP_API_URL", "https://graph.facebook.com/v19.0")
+	MESSAGING_WHATSAPP_PHONE_NUMBER_ID = os.Getenv("MESSAGING_WHATSAPP_PHONE_NUMBER_ID")
+	MESSAGING_WHATSAPP_ACCESS_TOKEN = os.Getenv("MESSAGING_WHATSAPP_ACCESS_TOKEN")
+
+	MESSAGING_SMS_GATEWAY_URL = os.Getenv("MESSAGING_SMS_GATEWAY_URL")
+	MESSAGING_SMS_API_KEY = os.Getenv("MESSAGING_SMS_API_KEY")
+	MESSAGING_SMS_SENDER = os.Getenv("MESSAGING_SMS_SENDER")
 }
 
 // loadLoggingConfig loads logging-specific configuration with sensible defaults
@@ -108,6 +186,19 @@ func getEnvWithDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvListWithDefault(key string, defaultValue []string) []string {
+	var list []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			list = append(list, value)
+		}
+	}
+	if len(list) == 0 {
+		return defaultValue
+	}
+	return list
+}
+
 func getEnvBoolWithDefault(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
 		if parsed, err := strconv.ParseBool(value); err == nil {
@@ -14,3 +14,17 @@ const (
 
 const AccessTokenExpiry = 30 * time.Minute
 const RefreshTokenExpiry = 24 * time.Hour
+
+const FollowerAccessTokenExpiry = 24 * time.Hour
+
+// MFAChallengeExpiry is how long the second step of a two-step sign-in can be completed.
+const MFAChallengeExpiry = 5 * time.Minute
+
+// FollowerTokenAudience marks follower portal tokens so they are never accepted as user tokens.
+const FollowerTokenAudience = "follower-portal"
+
+// MFATokenAudience marks the challenge tokens of a two-step sign-in so they are never accepted as access tokens.
+const MFATokenAudience = "mfa-challenge"
+
+// MFAIssuer is the name authenticator apps show next to the codes.
+const MFAIssuer = "Dhamma"
@@ -18,6 +18,86 @@ var ApiPermissions = []entity.Permission{
 		Type:             PermissionTypeApi,
 		IsDomainSpecific: false,
 	},
+	{
+		Name:             "blood-donation - index",
+		Object:           "blood-donation",
+		Action:           "index",
+		Description:      "Index all blood donations",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "blood-donation - show",
+		Object:           "blood-donation",
+		Action:           "show",
+		Description:      "View blood donation details",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "blood-donation - create",
+		Object:           "blood-donation",
+		Action:           "create",
+		Description:      "Record a blood donation",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "blood-donation - delete",
+		Object:           "blood-donation",
+		Action:           "delete",
+		Description:      "Delete a blood donation recorded by mistake",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "blood-donation - eligible",
+		Object:           "blood-donation",
+		Action:           "eligible",
+		Description:      "List the blood donors who may donate again",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "campaign - index",
+		Object:           "campaign",
+		Action:           "index",
+		Description:      "Index all campaigns",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "campaign - show",
+		Object:           "campaign",
+		Action:           "show",
+		Description:      "View campaign details and runs",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "campaign - create",
+		Object:           "campaign",
+		Action:           "create",
+		Description:      "Schedule a new campaign",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "campaign - update",
+		Object:           "campaign",
+		Action:           "update",
+		Description:      "Update a scheduled campaign",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "campaign - cancel",
+		Object:           "campaign",
+		Action:           "cancel",
+		Description:      "Cancel a scheduled campaign",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
 	{
 		Name:             "chat-template - index",
 		Object:           "chat-template",
@@ -74,6 +154,30 @@ var ApiPermissions = []entity.Permission{
 		Type:             PermissionTypeApi,
 		IsDomainSpecific: false,
 	},
+	{
+		Name:             "chat-template - render",
+		Object:           "chat-template",
+		Action:           "render",
+		Description:      "Render a chat template for a follower or event",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "chat-template - versions",
+		Object:           "chat-template",
+		Action:           "versions",
+		Description:      "List and compare the versions of a chat template",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "chat-template - restore-version",
+		Object:           "chat-template",
+		Action:           "restore-version",
+		Description:      "Restore a previous version of a chat template",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
 	{
 		Name:             "event - index",
 		Object:           "event",
@@ -114,6 +218,22 @@ var ApiPermissions = []entity.Permission{
 		Type:             PermissionTypeApi,
 		IsDomainSpecific: false,
 	},
+	{
+		Name:             "event - attend-bulk",
+		Object:           "event",
+		Action:           "attend-bulk",
+		Description:      "Upload a batch of offline event check-ins",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "event - check-in-code",
+		Object:           "event",
+		Action:           "check-in-code",
+		Description:      "Display the rotating self check-in code of an event",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
 	{
 		Name:             "event-attendance - index",
 		Object:           "event-attendance",
@@ -130,6 +250,22 @@ var ApiPermissions = []entity.Permission{
 		Type:             PermissionTypeApi,
 		IsDomainSpecific: false,
 	},
+	{
+		Name:             "event-attendance - void",
+		Object:           "event-attendance",
+		Action:           "void",
+		Description:      "Void an event attendance and reverse its points",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "event-attendance - report",
+		Object:           "event-attendance",
+		Action:           "report",
+		Description:      "View attendance reports and inactive followers",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
 	{
 		Name:             "follower - index",
 		Object:           "follower",
@@ -178,6 +314,142 @@ var ApiPermissions = []entity.Permission{
 		Type:             PermissionTypeApi,
 		IsDomainSpecific: false,
 	},
+	{
+		Name:             "follower - update-card-status",
+		Object:           "follower",
+		Action:           "update-card-status",
+		Description:      "Mark a follower's card as active, lost or revoked",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower - replace-card",
+		Object:           "follower",
+		Action:           "replace-card",
+		Description:      "Revoke a follower's card and issue a new one in its place",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower - print-cards",
+		Object:           "follower",
+		Action:           "print-cards",
+		Description:      "Print followers' active cards on a PDF sheet",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower - import",
+		Object:           "follower",
+		Action:           "import",
+		Description:      "Import followers from a CSV or XLSX file",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower - export",
+		Object:           "follower",
+		Action:           "export",
+		Description:      "Export followers to a CSV or XLSX file",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower - find-duplicates",
+		Object:           "follower",
+		Action:           "find-duplicates",
+		Description:      "Find likely duplicate followers",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower - merge",
+		Object:           "follower",
+		Action:           "merge",
+		Description:      "Merge a duplicate follower into another follower",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower-segment - index",
+		Object:           "follower-segment",
+		Action:           "index",
+		Description:      "Index all follower segments",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower-segment - show",
+		Object:           "follower-segment",
+		Action:           "show",
+		Description:      "View follower segment details",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower-segment - create",
+		Object:           "follower-segment",
+		Action:           "create",
+		Description:      "Create a new follower segment",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower-segment - update",
+		Object:           "follower-segment",
+		Action:           "update",
+		Description:      "Update follower segment information",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower-segment - delete",
+		Object:           "follower-segment",
+		Action:           "delete",
+		Description:      "Delete a follower segment",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "follower-segment - followers",
+		Object:           "follower-segment",
+		Action:           "followers",
+		Description:      "List the followers of a segment",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "outbound-message - index",
+		Object:           "outbound-message",
+		Action:           "index",
+		Description:      "Index all outbound messages",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "outbound-message - show",
+		Object:           "outbound-message",
+		Action:           "show",
+		Description:      "View outbound message details and delivery status",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "outbound-message - broadcast",
+		Object:           "outbound-message",
+		Action:           "broadcast",
+		Description:      "Broadcast a chat template to filtered followers",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "outbound-message - retry",
+		Object:           "outbound-message",
+		Action:           "retry",
+		Description:      "Retry a failed outbound message",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
 	{
 		Name:             "permission - index",
 		Object:           "permission",
@@ -202,6 +474,94 @@ var ApiPermissions = []entity.Permission{
 		Type:             PermissionTypeApi,
 		IsDomainSpecific: false,
 	},
+	{
+		Name:             "point-mutation - create",
+		Object:           "point-mutation",
+		Action:           "create",
+		Description:      "Manually grant or deduct follower points",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "point-mutation - redeem",
+		Object:           "point-mutation",
+		Action:           "redeem",
+		Description:      "Redeem follower points",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "reward - index",
+		Object:           "reward",
+		Action:           "index",
+		Description:      "Index all rewards of the catalogue",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "reward - show",
+		Object:           "reward",
+		Action:           "show",
+		Description:      "View reward details",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "reward - create",
+		Object:           "reward",
+		Action:           "create",
+		Description:      "Add a reward to the catalogue",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "reward - update",
+		Object:           "reward",
+		Action:           "update",
+		Description:      "Update a reward, including its point cost and stock",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "reward-redemption - index",
+		Object:           "reward-redemption",
+		Action:           "index",
+		Description:      "Index all reward redemption orders",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "reward-redemption - show",
+		Object:           "reward-redemption",
+		Action:           "show",
+		Description:      "View reward redemption order details",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "reward-redemption - create",
+		Object:           "reward-redemption",
+		Action:           "create",
+		Description:      "Redeem a follower's points for a reward",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "reward-redemption - fulfill",
+		Object:           "reward-redemption",
+		Action:           "fulfill",
+		Description:      "Mark a redemption order as handed over",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "reward-redemption - cancel",
+		Object:           "reward-redemption",
+		Action:           "cancel",
+		Description:      "Cancel a pending redemption order, refunding its points",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
 	{
 		Name:             "role - index",
 		Object:           "role",
@@ -306,6 +666,30 @@ var ApiPermissions = []entity.Permission{
 		Type:             PermissionTypeApi,
 		IsDomainSpecific: false,
 	},
+	{
+		Name:             "user - sessions",
+		Object:           "user",
+		Action:           "sessions",
+		Description:      "List the signed-in sessions of a user",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "user - revoke-session",
+		Object:           "user",
+		Action:           "revoke-session",
+		Description:      "Sign one session of a user out",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
+	{
+		Name:             "user - unlock-login",
+		Object:           "user",
+		Action:           "unlock-login",
+		Description:      "Lift the sign-in lockout of a user",
+		Type:             PermissionTypeApi,
+		IsDomainSpecific: false,
+	},
 }
 
 // GetAllPermissionCodes returns all permission codes
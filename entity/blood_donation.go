@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+// BloodTypes lists the blood types a donation can record.
+var BloodTypes = []string{"A+", "A-", "B+", "B-", "AB+", "AB-", "O+", "O-"}
+
+// BloodDonation records a follower donating blood, e.g. at one of the domain's donation drives.
+type BloodDonation struct {
+	ID             uint64    `json:"id" gorm:"primary_key;not null"`
+	DomainID       uint64    `json:"domain_id" gorm:"not null;index"`
+	FollowerID     uint64    `json:"follower_id" gorm:"not null;index"`
+	DonatedAt      time.Time `json:"donated_at" gorm:"not null;index"`
+	Location       string    `json:"location" gorm:"not null;size:255"`
+	BloodType      string    `json:"blood_type" gorm:"not null;size:3"`
+	NextEligibleAt time.Time `json:"next_eligible_at" gorm:"not null;index"` // DonatedAt plus the domain's donation interval
+	Points         int       `json:"points" gorm:"not null;default:0"`       // Points awarded for the donation
+	Note           *string   `json:"note" gorm:"size:255;null"`
+	RecordedBy     uint64    `json:"recorded_by" gorm:"not null"`
+	Timestamp
+
+	Follower *Follower `json:"follower,omitempty" gorm:"foreignKey:FollowerID"`
+}
+
+// TableName specifies the table name for the BloodDonation entity.
+func (BloodDonation) TableName() string {
+	return "blood_donations"
+}
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// Constants for Campaign Status
+const (
+	// CampaignStatusScheduled waits for its ScheduledAt time.
+	CampaignStatusScheduled = "scheduled"
+	// CampaignStatusRunning is queueing its messages.
+	CampaignStatusRunning = "running"
+	// CampaignStatusCompleted queued its messages; their delivery is tracked by the run.
+	CampaignStatusCompleted = "completed"
+	// CampaignStatusFailed could not queue its messages, see the run's error.
+	CampaignStatusFailed = "failed"
+	// CampaignStatusCancelled was cancelled before it ran.
+	CampaignStatusCancelled = "cancelled"
+)
+
+// Campaign sends a chat template to the followers of a segment at a scheduled time.
+type Campaign struct {
+	ID             uint64    `json:"id" gorm:"primary_key;not null"`
+	DomainID       uint64    `json:"domain_id" gorm:"not null;index"`
+	Name           string    `json:"name" gorm:"not null;size:255"`
+	SegmentID      uint64    `json:"segment_id" gorm:"not null;index"`
+	ChatTemplateID uint64    `json:"chat_template_id" gorm:"not null;index"`
+	EventID        *uint64   `json:"event_id" gorm:"null;index"` // Event filling in the template's event variables
+	ScheduledAt    time.Time `json:"scheduled_at" gorm:"not null;index"`
+	Status         string    `json:"status" gorm:"not null;size:20;index"` // One of the CampaignStatus constants
+	CreatedBy      uint64    `json:"created_by" gorm:"not null"`
+	Timestamp
+
+	Segment      *FollowerSegment `json:"segment,omitempty" gorm:"foreignKey:SegmentID"`
+	ChatTemplate *ChatTemplate    `json:"chat_template,omitempty" gorm:"foreignKey:ChatTemplateID"`
+}
+
+// TableName specifies the table name for the Campaign entity.
+func (Campaign) TableName() string {
+	return "campaigns"
+}
@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+// CampaignRun records a campaign queueing its messages.
+// How many of them were sent or failed is read from the outbound messages of the run.
+type CampaignRun struct {
+	ID         uint64     `json:"id" gorm:"primary_key;not null"`
+	CampaignID uint64     `json:"campaign_id" gorm:"not null;index"`
+	DomainID   uint64     `json:"domain_id" gorm:"not null;index"`
+	Status     string     `json:"status" gorm:"not null;size:20"`       // CampaignStatusRunning, CampaignStatusCompleted or CampaignStatusFailed
+	Recipients int        `json:"recipients" gorm:"not null;default:0"` // Followers in the segment
+	Skipped    int        `json:"skipped" gorm:"not null;default:0"`    // Followers without a phone number
+	Queued     int        `json:"queued" gorm:"not null;default:0"`
+	Error      *string    `json:"error" gorm:"size:500;null"`
+	StartedAt  time.Time  `json:"started_at" gorm:"not null"`
+	FinishedAt *time.Time `json:"finished_at" gorm:"null"`
+	Timestamp
+}
+
+// TableName specifies the table name for the CampaignRun entity.
+func (CampaignRun) TableName() string {
+	return "campaign_runs"
+}
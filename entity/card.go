@@ -1,12 +1,29 @@
 package entity
 
+import "time"
+
+// Constants for Card Status
+const (
+	// CardStatusActive can be used to check in.
+	CardStatusActive = "active"
+	// CardStatusLost was reported lost by its follower.
+	CardStatusLost = "lost"
+	// CardStatusRevoked was withdrawn, e.g. after being replaced by a new card.
+	CardStatusRevoked = "revoked"
+	// CardStatusExpired has passed its expiry date.
+	CardStatusExpired = "expired"
+)
+
 // Card represents a card associated with a follower.
 // Each card has a unique code.
 type Card struct {
-	ID         uint64 `json:"id" gorm:"primary_key;not null"`
-	DomainID   uint64 `json:"domain_id" gorm:"not null;index"`      // Foreign key to DomainInf
-	FollowerID uint64 `json:"follower_id" gorm:"not null;index"`    // Foreign key to Follower
-	Code       string `json:"code" gorm:"not null;unique;size:100"` // Unique identifier for the card
+	ID           uint64     `json:"id" gorm:"primary_key;not null"`
+	DomainID     uint64     `json:"domain_id" gorm:"not null;index"`                       // Foreign key to DomainInf
+	FollowerID   uint64     `json:"follower_id" gorm:"not null;index"`                     // Foreign key to Follower
+	Code         string     `json:"code" gorm:"not null;unique;size:100"`                  // Unique identifier for the card
+	Status       string     `json:"status" gorm:"not null;size:20;default:'active';index"` // One of the CardStatus constants
+	ExpiresAt    *time.Time `json:"expires_at" gorm:"null;index"`                          // Cards without an expiry date never expire
+	ReplacedByID *uint64    `json:"replaced_by_id" gorm:"null"`                            // Card issued in place of this one
 	Timestamp
 
 	// Follower is the follower to whom this card belongs.
@@ -18,3 +35,16 @@ type Card struct {
 func (Card) TableName() string {
 	return "cards"
 }
+
+// StatusAt returns the card's status at the given time. An active card is expired from its expiry date on,
+// even before its status is updated, and an expired card was still active before that date.
+func (c Card) StatusAt(at time.Time) string {
+	switch c.Status {
+	case CardStatusActive, CardStatusExpired:
+		if c.ExpiresAt != nil && !at.Before(*c.ExpiresAt) {
+			return CardStatusExpired
+		}
+		return CardStatusActive
+	}
+	return c.Status
+}
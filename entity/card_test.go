@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardStatusAt(t *testing.T) {
+	expiresAt := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	before := expiresAt.Add(-time.Second)
+
+	tests := []struct {
+		name     string
+		card     Card
+		at       time.Time
+		expected string
+	}{
+		{name: "active without expiry", card: Card{Status: CardStatusActive}, at: expiresAt, expected: CardStatusActive},
+		{name: "active before expiry", card: Card{Status: CardStatusActive, ExpiresAt: &expiresAt}, at: before, expected: CardStatusActive},
+		{name: "active at expiry", card: Card{Status: CardStatusActive, ExpiresAt: &expiresAt}, at: expiresAt, expected: CardStatusExpired},
+		{name: "expired before expiry", card: Card{Status: CardStatusExpired, ExpiresAt: &expiresAt}, at: before, expected: CardStatusActive},
+		{name: "expired after expiry", card: Card{Status: CardStatusExpired, ExpiresAt: &expiresAt}, at: expiresAt.Add(time.Hour), expected: CardStatusExpired},
+		{name: "lost before expiry", card: Card{Status: CardStatusLost, ExpiresAt: &expiresAt}, at: before, expected: CardStatusLost},
+		{name: "revoked", card: Card{Status: CardStatusRevoked}, at: before, expected: CardStatusRevoked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.card.StatusAt(tt.at))
+		})
+	}
+}
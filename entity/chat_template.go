@@ -2,17 +2,20 @@ package entity
 
 // ChatTemplate represents a chat template that can be used within a domain.
 // Each domain can have multiple chat templates with one marked as default.
+// The default template is pinned to a version, which is what it renders until it is set as default again.
 type ChatTemplate struct {
-	ID          uint64  `json:"id" gorm:"primary_key;not null"`
-	DomainID    uint64  `json:"domain_id" gorm:"not null;index"` // Foreign key to Domain
-	Name        string  `json:"name" gorm:"not null;size:255"`
-	Description *string `json:"description" gorm:"size:500;null"`
-	Content     string  `json:"content" gorm:"not null;type:text"` // Template content/body
-	IsDefault   bool    `json:"is_default" gorm:"not null;default:false"`
-	IsActive    bool    `json:"is_active" gorm:"not null;default:true"`
+	ID              uint64  `json:"id" gorm:"primary_key;not null"`
+	DomainID        uint64  `json:"domain_id" gorm:"not null;index"` // Foreign key to Domain
+	Name            string  `json:"name" gorm:"not null;size:255"`
+	Description     *string `json:"description" gorm:"size:500;null"`
+	Content         string  `json:"content" gorm:"not null;type:text"` // Template content/body
+	IsDefault       bool    `json:"is_default" gorm:"not null;default:false"`
+	IsActive        bool    `json:"is_active" gorm:"not null;default:true"`
+	PinnedVersionID *uint64 `json:"pinned_version_id" gorm:"null"` // Version rendered instead of Content, set for the default template
 	Timestamp
 
-	Domain *Domain `json:"domain,omitempty" gorm:"foreignKey:DomainID"`
+	Domain        *Domain              `json:"domain,omitempty" gorm:"foreignKey:DomainID"`
+	PinnedVersion *ChatTemplateVersion `json:"pinned_version,omitempty" gorm:"foreignKey:PinnedVersionID"`
 }
 
 // TableName specifies the table name for the ChatTemplate entity.
@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// ChatTemplateVersion is an immutable snapshot of a chat template's name, description and content.
+// A version is written on every change to them; the latest version matches the template.
+type ChatTemplateVersion struct {
+	ID             uint64    `json:"id" gorm:"primary_key;not null"`
+	ChatTemplateID uint64    `json:"chat_template_id" gorm:"not null;uniqueIndex:idx_chat_template_version"`
+	DomainID       uint64    `json:"domain_id" gorm:"not null;index"`
+	Version        int       `json:"version" gorm:"not null;uniqueIndex:idx_chat_template_version"` // Starts at 1 for each template
+	Name           string    `json:"name" gorm:"not null;size:255"`
+	Description    *string   `json:"description" gorm:"size:500;null"`
+	Content        string    `json:"content" gorm:"not null;type:text"`
+	RestoredFrom   *int      `json:"restored_from" gorm:"null"` // Version this one was restored from
+	CreatedBy      *uint64   `json:"created_by" gorm:"null"`    // Empty for the snapshot of a template written before versioning
+	CreatedAt      time.Time `json:"created_at" gorm:"not null"`
+}
+
+// TableName specifies the table name for the ChatTemplateVersion entity.
+func (ChatTemplateVersion) TableName() string {
+	return "chat_template_versions"
+}
@@ -14,9 +14,36 @@ type Timestamp struct {
 	DeletedAt gorm.DeletedAt `json:"-"`
 }
 
+// AccessClaims are the claims of a user access token. Tokens issued by a domain sign-in carry
+// the domain and are only accepted on that domain's routes; Scopes, when set, limit the token
+// to the listed permission objects ("event") or codes ("event/show"). Revoking the session
+// the token was issued for revokes the token.
 type AccessClaims struct {
-	UserID uint64 `json:"user_id"`
-	Role   string `json:"role"`
+	UserID    uint64   `json:"user_id"`
+	Role      string   `json:"role"`
+	DomainID  *uint64  `json:"domain_id,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+	SessionID string   `json:"session_id,omitempty"` // Refresh token family the token was issued for
+
+	jwt.RegisteredClaims
+}
+
+// FollowerClaims are the claims of a follower portal token.
+// They carry no user or role, so a follower can never pass the user middlewares.
+type FollowerClaims struct {
+	FollowerID uint64 `json:"follower_id"`
+	DomainID   uint64 `json:"domain_id"`
+
+	jwt.RegisteredClaims
+}
+
+// MFAClaims are the claims of the challenge token handed out between the two steps of a sign-in.
+// They carry the sign-in to complete; the MFA audience keeps them from being used as access tokens.
+type MFAClaims struct {
+	UserID             uint64   `json:"user_id"`
+	DomainID           *uint64  `json:"domain_id,omitempty"`
+	Scopes             []string `json:"scopes,omitempty"`
+	EnrollmentRequired bool     `json:"enrollment_required,omitempty"` // The user has to set up an authenticator before signing in
 
 	jwt.RegisteredClaims
 }
@@ -1,11 +1,29 @@
 package entity
 
+import (
+	"time"
+
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// DefaultBloodDonationIntervalDays is the blood donation interval of a domain that does not set one.
+const DefaultBloodDonationIntervalDays = 60
+
 type Domain struct {
 	ID          uint64 `json:"id" gorm:"primary_key;not null"`
 	Name        string `json:"name" gorm:"size:100;unique;not null"`
 	Code        string `json:"code" gorm:"size:50;unique;not null"`
 	Description string `json:"description" gorm:"size:255"`
 	IsActive    bool   `json:"is_active" gorm:"default:true"`
+	// Timezone is the IANA timezone used for the domain's day boundaries
+	Timezone string `json:"timezone" gorm:"size:64;not null;default:'Asia/Jakarta'"`
+	// BloodDonationIntervalDays is the minimum number of days between two blood donations of a follower
+	BloodDonationIntervalDays int `json:"blood_donation_interval_days" gorm:"not null;default:60"`
+	// CardCodeFormat is the format generated card codes follow, see utility/cardcode; empty means cardcode.DefaultFormat
+	CardCodeFormat string `json:"card_code_format" gorm:"size:100"`
+	// CardSequence is the sequence number of the last generated card code.
+	// It is read-only to gorm so saving a domain never rolls it back; see domain.Repository.NextCardSequence
+	CardSequence uint64 `json:"card_sequence" gorm:"<-:false;not null;default:0"`
 	Timestamp
 
 	// Has-Many relationship with Role
@@ -19,3 +37,8 @@ type Domain struct {
 	// Many-to-Many with User through UserDomain
 	Users *[]User `json:"users,omitempty" gorm:"many2many:user_domains;"`
 }
+
+// Location returns the domain's timezone, falling back to utility.DefaultTimezone.
+func (d Domain) Location() *time.Location {
+	return utility.LoadLocation(d.Timezone)
+}
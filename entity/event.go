@@ -1,19 +1,191 @@
 package entity
 
+import (
+	"time"
+)
+
+// Constants for Event Recurrence
+const (
+	// EventRecurrenceDaily repeats the event every day.
+	EventRecurrenceDaily = "daily"
+	// EventRecurrenceWeekly repeats the event every week on the same weekday.
+	EventRecurrenceWeekly = "weekly"
+	// EventRecurrenceMonthly repeats the event every month on the same day of month,
+	// or on the last day of months that are too short.
+	EventRecurrenceMonthly = "monthly"
+)
+
 // Event represents an event that followers can attend.
+// A scheduled event has a start and end time and may repeat according to its Recurrence,
+// each repetition being an EventOccurrence. Events without a StartAt are unscheduled and
+// accept check-ins at any time.
 type Event struct {
-	ID            uint64  `json:"id" gorm:"primary_key;not null"`
-	DomainID      uint64  `json:"domain_id" gorm:"not null;index"` // Foreign key to Domain
-	Name          string  `json:"name" gorm:"not null;size:255"`
-	Description   *string `json:"description" gorm:"type:text;null"`
-	PointsAwarded int     `json:"points_awarded" gorm:"not null;default:0"` // Points awarded for attendance
+	ID                 uint64     `json:"id" gorm:"primary_key;not null"`
+	DomainID           uint64     `json:"domain_id" gorm:"not null;index"` // Foreign key to Domain
+	Name               string     `json:"name" gorm:"not null;size:255"`
+	Description        *string    `json:"description" gorm:"type:text;null"`
+	PointsAwarded      int        `json:"points_awarded" gorm:"not null;default:0"`        // Points awarded for attendance
+	StartAt            *time.Time `json:"start_at" gorm:"null"`                            // Start of the first occurrence
+	EndAt              *time.Time `json:"end_at" gorm:"null"`                              // End of the first occurrence
+	Recurrence         *string    `json:"recurrence" gorm:"size:20;null"`                  // One of the EventRecurrence constants, nil for a one-off event
+	RecurrenceUntil    *time.Time `json:"recurrence_until" gorm:"null"`                    // No occurrence starts after this time
+	CheckInOpensBefore int        `json:"check_in_opens_before" gorm:"not null;default:0"` // Minutes before an occurrence starts that check-in opens
+	CheckInClosesAfter int        `json:"check_in_closes_after" gorm:"not null;default:0"` // Minutes after an occurrence ends that check-in closes
+	CheckInSecret      string     `json:"-" gorm:"size:64"`                                // Derives the rotating self check-in codes, see utility/checkintoken
 	Timestamp
 
 	Domain           *Domain           `json:"domain,omitempty" gorm:"foreignKey:DomainID"`
 	EventAttendances []EventAttendance `json:"event_attendances,omitempty" gorm:"foreignKey:EventID"`
 }
 
+// EventOccurrence is a single instance of a (possibly recurring) event.
+type EventOccurrence struct {
+	StartAt        time.Time `json:"start_at"`
+	EndAt          time.Time `json:"end_at"`
+	CheckInOpenAt  time.Time `json:"check_in_open_at"`
+	CheckInCloseAt time.Time `json:"check_in_close_at"`
+}
+
 // TableName specifies the table name for the Event entity.
 func (Event) TableName() string {
 	return "events"
 }
+
+// IsScheduled reports whether the event has a start and end time.
+func (e Event) IsScheduled() bool {
+	return e.StartAt != nil && e.EndAt != nil
+}
+
+// occurrence returns the nth occurrence of the event, counting from zero.
+// It returns false if the event does not have an nth occurrence.
+func (e Event) occurrence(n int) (EventOccurrence, bool) {
+	if !e.IsScheduled() || n < 0 {
+		return EventOccurrence{}, false
+	}
+
+	start := *e.StartAt
+	if n > 0 {
+		if e.Recurrence == nil {
+			return EventOccurrence{}, false
+		}
+		switch *e.Recurrence {
+		case EventRecurrenceDaily:
+			start = start.AddDate(0, 0, n)
+		case EventRecurrenceWeekly:
+			start = start.AddDate(0, 0, 7*n)
+		case EventRecurrenceMonthly:
+			start = addMonths(start, n)
+		default:
+			return EventOccurrence{}, false
+		}
+		if e.RecurrenceUntil != nil && start.After(*e.RecurrenceUntil) {
+			return EventOccurrence{}, false
+		}
+	}
+
+	end := start.Add(e.EndAt.Sub(*e.StartAt))
+	return EventOccurrence{
+		StartAt:        start,
+		EndAt:          end,
+		CheckInOpenAt:  start.Add(-time.Duration(e.CheckInOpensBefore) * time.Minute),
+		CheckInCloseAt: end.Add(time.Duration(e.CheckInClosesAfter) * time.Minute),
+	}, true
+}
+
+// addMonths adds months to t, keeping the day of month but clamping it to the last day of shorter months,
+// so an event on January 31 repeats on February 28 rather than March 3.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// RecurrenceInterval returns the shortest time between the starts of two occurrences,
+// or zero for a one-off event. Monthly events can be as close as the 28 days of February.
+func (e Event) RecurrenceInterval() time.Duration {
+	if e.Recurrence == nil {
+		return 0
+	}
+
+	switch *e.Recurrence {
+	case EventRecurrenceDaily:
+		return 24 * time.Hour
+	case EventRecurrenceWeekly:
+		return 7 * 24 * time.Hour
+	case EventRecurrenceMonthly:
+		return 28 * 24 * time.Hour
+	}
+	return 0
+}
+
+// occurrenceIndex estimates the index of the occurrence starting around t.
+func (e Event) occurrenceIndex(t time.Time) int {
+	if e.Recurrence == nil || !t.After(*e.StartAt) {
+		return 0
+	}
+
+	switch *e.Recurrence {
+	case EventRecurrenceDaily:
+		return int(t.Sub(*e.StartAt) / (24 * time.Hour))
+	case EventRecurrenceWeekly:
+		return int(t.Sub(*e.StartAt) / (7 * 24 * time.Hour))
+	case EventRecurrenceMonthly:
+		t = t.In(e.StartAt.Location())
+		return (t.Year()-e.StartAt.Year())*12 + int(t.Month()) - int(e.StartAt.Month())
+	}
+	return 0
+}
+
+// OccurrenceAt returns the occurrence whose check-in window contains t.
+// When check-in windows overlap, the occurrence starting closest to t is returned.
+func (e Event) OccurrenceAt(t time.Time) (occurrence EventOccurrence, ok bool) {
+	if !e.IsScheduled() {
+		return
+	}
+
+	// Check-in windows can reach into neighbouring periods, so look around the estimate.
+	// They are never longer than the RecurrenceInterval, see validateSchedule in the event service.
+	estimate := e.occurrenceIndex(t)
+	for n := estimate - 2; n <= estimate+2; n++ {
+		candidate, exists := e.occurrence(n)
+		if !exists {
+			continue
+		}
+		if t.Before(candidate.CheckInOpenAt) || t.After(candidate.CheckInCloseAt) {
+			continue
+		}
+		if !ok || candidate.StartAt.Sub(t).Abs() < occurrence.StartAt.Sub(t).Abs() {
+			occurrence, ok = candidate, true
+		}
+	}
+
+	return
+}
+
+// Occurrences returns the occurrences starting within [from, to), up to limit entries.
+func (e Event) Occurrences(from time.Time, to time.Time, limit int) []EventOccurrence {
+	occurrences := []EventOccurrence{}
+	if !e.IsScheduled() {
+		return occurrences
+	}
+
+	n := e.occurrenceIndex(from) - 1
+	if n < 0 {
+		n = 0
+	}
+	for len(occurrences) < limit {
+		occurrence, exists := e.occurrence(n)
+		if !exists || !occurrence.StartAt.Before(to) {
+			break
+		}
+		if !occurrence.StartAt.Before(from) {
+			occurrences = append(occurrences, occurrence)
+		}
+		n++
+	}
+
+	return occurrences
+}
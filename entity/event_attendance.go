@@ -7,10 +7,14 @@ import (
 // EventAttendance represents a follower's attendance at an event.
 // This acts as a join table between Follower and Event.
 type EventAttendance struct {
-	ID         uint64    `json:"id" gorm:"primary_key;not null"`
-	FollowerID uint64    `json:"follower_id" gorm:"not null;index"`
-	EventID    uint64    `json:"event_id" gorm:"not null;index"`
-	AttendedAt time.Time `json:"attended_at" gorm:"not null"` // Timestamp of when the follower attended
+	ID           uint64     `json:"id" gorm:"primary_key;not null"`
+	FollowerID   uint64     `json:"follower_id" gorm:"not null;index"`
+	EventID      uint64     `json:"event_id" gorm:"not null;index"`
+	AttendedAt   time.Time  `json:"attended_at" gorm:"not null"`     // Timestamp of when the follower attended
+	OccurrenceAt *time.Time `json:"occurrence_at" gorm:"null;index"` // Start of the event occurrence attended, nil for unscheduled events
+	VoidedAt     *time.Time `json:"voided_at" gorm:"null"`           // When the attendance was voided; voided rows are soft-deleted
+	VoidedBy     *uint64    `json:"voided_by" gorm:"null"`           // User who voided the attendance
+	VoidReason   *string    `json:"void_reason" gorm:"size:255;null"`
 	Timestamp
 
 	Follower      *Follower      `json:"follower,omitempty" gorm:"foreignKey:FollowerID"`
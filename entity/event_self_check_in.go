@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// EventSelfCheckIn records a follower checking in with a rotating event code.
+// Each code, identified by its Step, can only be used once per follower, so a captured request cannot be replayed.
+type EventSelfCheckIn struct {
+	ID         uint64    `json:"id" gorm:"primary_key;not null"`
+	EventID    uint64    `json:"event_id" gorm:"not null;uniqueIndex:idx_event_self_check_in_use"`
+	FollowerID uint64    `json:"follower_id" gorm:"not null;uniqueIndex:idx_event_self_check_in_use"`
+	Step       int64     `json:"step" gorm:"not null;uniqueIndex:idx_event_self_check_in_use"` // Window of the code, see utility/checkintoken
+	CardID     uint64    `json:"card_id" gorm:"not null"`
+	CreatedAt  time.Time `json:"created_at" gorm:"not null"`
+}
+
+// TableName specifies the table name for the EventSelfCheckIn entity.
+func (EventSelfCheckIn) TableName() string {
+	return "event_self_check_ins"
+}
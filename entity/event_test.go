@@ -0,0 +1,185 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newScheduledEvent(recurrence *string) Event {
+	start := time.Date(2025, time.January, 5, 19, 0, 0, 0, time.UTC) // Sunday evening
+	end := start.Add(2 * time.Hour)
+	return Event{
+		StartAt:            &start,
+		EndAt:              &end,
+		Recurrence:         recurrence,
+		CheckInOpensBefore: 30,
+		CheckInClosesAfter: 15,
+	}
+}
+
+func TestEventOccurrenceAt(t *testing.T) {
+	weekly := EventRecurrenceWeekly
+	monthly := EventRecurrenceMonthly
+
+	tests := []struct {
+		name          string
+		event         Event
+		at            time.Time
+		expectedOK    bool
+		expectedStart time.Time
+	}{
+		{
+			name:          "one-off event inside window",
+			event:         newScheduledEvent(nil),
+			at:            time.Date(2025, time.January, 5, 18, 45, 0, 0, time.UTC),
+			expectedOK:    true,
+			expectedStart: time.Date(2025, time.January, 5, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "one-off event before window opens",
+			event:      newScheduledEvent(nil),
+			at:         time.Date(2025, time.January, 5, 18, 29, 0, 0, time.UTC),
+			expectedOK: false,
+		},
+		{
+			name:       "one-off event after window closes",
+			event:      newScheduledEvent(nil),
+			at:         time.Date(2025, time.January, 5, 21, 16, 0, 0, time.UTC),
+			expectedOK: false,
+		},
+		{
+			name:       "one-off event on a later week",
+			event:      newScheduledEvent(nil),
+			at:         time.Date(2025, time.January, 12, 19, 30, 0, 0, time.UTC),
+			expectedOK: false,
+		},
+		{
+			name:          "weekly event on a later week",
+			event:         newScheduledEvent(&weekly),
+			at:            time.Date(2025, time.March, 2, 20, 0, 0, 0, time.UTC),
+			expectedOK:    true,
+			expectedStart: time.Date(2025, time.March, 2, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "weekly event on the wrong weekday",
+			event:      newScheduledEvent(&weekly),
+			at:         time.Date(2025, time.March, 3, 20, 0, 0, 0, time.UTC),
+			expectedOK: false,
+		},
+		{
+			name:          "monthly event",
+			event:         newScheduledEvent(&monthly),
+			at:            time.Date(2025, time.June, 5, 21, 10, 0, 0, time.UTC),
+			expectedOK:    true,
+			expectedStart: time.Date(2025, time.June, 5, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "unscheduled event",
+			event:      Event{},
+			at:         time.Date(2025, time.June, 5, 21, 10, 0, 0, time.UTC),
+			expectedOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			occurrence, ok := tt.event.OccurrenceAt(tt.at)
+			require.Equal(t, tt.expectedOK, ok)
+			if tt.expectedOK {
+				assert.True(t, tt.expectedStart.Equal(occurrence.StartAt), "got %v", occurrence.StartAt)
+				assert.Equal(t, 2*time.Hour, occurrence.EndAt.Sub(occurrence.StartAt))
+			}
+		})
+	}
+}
+
+func TestEventOccurrenceAtRespectsRecurrenceUntil(t *testing.T) {
+	weekly := EventRecurrenceWeekly
+	event := newScheduledEvent(&weekly)
+	until := time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC)
+	event.RecurrenceUntil = &until
+
+	_, ok := event.OccurrenceAt(time.Date(2025, time.January, 26, 19, 30, 0, 0, time.UTC))
+	assert.True(t, ok)
+
+	_, ok = event.OccurrenceAt(time.Date(2025, time.February, 2, 19, 30, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestEventOccurrencesMonthlyClampsToMonthEnd(t *testing.T) {
+	monthly := EventRecurrenceMonthly
+	start := time.Date(2025, time.January, 31, 19, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	event := Event{StartAt: &start, EndAt: &end, Recurrence: &monthly}
+
+	occurrences := event.Occurrences(
+		time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC),
+		100,
+	)
+	require.Len(t, occurrences, 4)
+	assert.True(t, time.Date(2025, time.January, 31, 19, 0, 0, 0, time.UTC).Equal(occurrences[0].StartAt))
+	assert.True(t, time.Date(2025, time.February, 28, 19, 0, 0, 0, time.UTC).Equal(occurrences[1].StartAt))
+	assert.True(t, time.Date(2025, time.March, 31, 19, 0, 0, 0, time.UTC).Equal(occurrences[2].StartAt))
+	assert.True(t, time.Date(2025, time.April, 30, 19, 0, 0, 0, time.UTC).Equal(occurrences[3].StartAt))
+
+	occurrence, ok := event.OccurrenceAt(time.Date(2025, time.February, 28, 20, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.True(t, occurrences[1].StartAt.Equal(occurrence.StartAt))
+
+	_, ok = event.OccurrenceAt(time.Date(2025, time.March, 3, 20, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestEventOccurrences(t *testing.T) {
+	weekly := EventRecurrenceWeekly
+	event := newScheduledEvent(&weekly)
+
+	occurrences := event.Occurrences(
+		time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+		100,
+	)
+	require.Len(t, occurrences, 4)
+	assert.True(t, time.Date(2025, time.February, 2, 19, 0, 0, 0, time.UTC).Equal(occurrences[0].StartAt))
+	assert.True(t, time.Date(2025, time.February, 23, 19, 0, 0, 0, time.UTC).Equal(occurrences[3].StartAt))
+
+	limited := event.Occurrences(
+		time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		3,
+	)
+	assert.Len(t, limited, 3)
+
+	oneOff := newScheduledEvent(nil)
+	assert.Len(t, oneOff.Occurrences(
+		time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		100,
+	), 1)
+}
+
+func TestEventOccurrenceAtFindsTheLongestWindows(t *testing.T) {
+	for _, recurrence := range []string{EventRecurrenceDaily, EventRecurrenceWeekly, EventRecurrenceMonthly} {
+		t.Run(recurrence, func(t *testing.T) {
+			event := newScheduledEvent(&recurrence)
+			interval := event.RecurrenceInterval()
+			event.CheckInOpensBefore = int(interval / time.Minute)
+			event.CheckInClosesAfter = int((interval - event.EndAt.Sub(*event.StartAt)) / time.Minute)
+
+			// Both edges of the window of an occurrence a year in are found
+			occurrences := event.Occurrences(event.StartAt.AddDate(1, 0, 0), event.StartAt.AddDate(1, 2, 0), 1)
+			require.Len(t, occurrences, 1)
+			want := occurrences[0]
+
+			for _, at := range []time.Time{want.CheckInOpenAt, want.CheckInCloseAt} {
+				occurrence, ok := event.OccurrenceAt(at)
+				require.True(t, ok, at)
+				assert.False(t, at.Before(occurrence.CheckInOpenAt) || at.After(occurrence.CheckInCloseAt), at)
+			}
+		})
+	}
+}
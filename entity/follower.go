@@ -1,11 +1,18 @@
 package entity
 
+import (
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/utility/fuzzy"
+)
+
 // Follower represents a follower within a domain.
 type Follower struct {
 	ID           uint64  `json:"id" gorm:"primary_key;not null"`
 	DomainID     uint64  `json:"domain_id" gorm:"not null;index"` // Foreign key to Domain
 	Name         string  `json:"name" gorm:"not null;size:255"`
-	Phone        *string `json:"phone" gorm:"size:50;null"` // Optional phone number
+	Phone        *string `json:"phone" gorm:"size:50;null"`   // Optional phone number
+	PhoneKey     *string `json:"-" gorm:"size:20;null;index"` // fuzzy.NormalizePhone of Phone, set on save
 	Points       int     `json:"points" gorm:"not null;default:0"`
 	IsBloodDonor bool    `json:"is_blood_donor" gorm:"not null;default:false"`
 	IsYouth      bool    `json:"is_youth" gorm:"not null;default:false"`
@@ -21,3 +28,14 @@ type Follower struct {
 func (Follower) TableName() string {
 	return "followers"
 }
+
+// BeforeSave keeps PhoneKey in line with Phone, so followers can be looked up by phone number in SQL.
+func (follower *Follower) BeforeSave(tx *gorm.DB) (err error) {
+	follower.PhoneKey = nil
+	if follower.Phone != nil {
+		if key := fuzzy.NormalizePhone(*follower.Phone); key != "" {
+			follower.PhoneKey = &key
+		}
+	}
+	return
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// FollowerMerge is the audit record of merging a duplicate follower into a surviving one.
+// It keeps a snapshot of the merged follower since that row is soft-deleted by the merge.
+type FollowerMerge struct {
+	ID                  uint64    `json:"id" gorm:"primary_key;not null"`
+	DomainID            uint64    `json:"domain_id" gorm:"not null;index"`
+	SurvivorID          uint64    `json:"survivor_id" gorm:"not null;index"` // Follower that was kept
+	MergedID            uint64    `json:"merged_id" gorm:"not null;index"`   // Follower that was soft-deleted
+	MergedName          string    `json:"merged_name" gorm:"not null;size:255"`
+	MergedPhone         *string   `json:"merged_phone" gorm:"size:50;null"`
+	CardsMoved          int64     `json:"cards_moved" gorm:"not null;default:0"`
+	AttendancesMoved    int64     `json:"attendances_moved" gorm:"not null;default:0"`
+	PointMutationsMoved int64     `json:"point_mutations_moved" gorm:"not null;default:0"`
+	PointsBefore        int       `json:"points_before" gorm:"not null"` // Sum of both followers' points before the merge
+	PointsAfter         int       `json:"points_after" gorm:"not null"`  // Survivor points recomputed from the point mutations
+	MergedBy            uint64    `json:"merged_by" gorm:"not null"`     // User who performed the merge
+	Note                *string   `json:"note" gorm:"size:255;null"`
+	CreatedAt           time.Time `json:"created_at" gorm:"not null"`
+
+	Survivor *Follower `json:"survivor,omitempty" gorm:"foreignKey:SurvivorID"`
+}
+
+// TableName specifies the table name for the FollowerMerge entity.
+func (FollowerMerge) TableName() string {
+	return "follower_merges"
+}
@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// Follower portal sign-in code limits.
+const (
+	// FollowerOTPExpiry is how long a sign-in code can be used.
+	FollowerOTPExpiry = 5 * time.Minute
+	// FollowerOTPResendInterval is how long a new code cannot be requested for the same phone number.
+	FollowerOTPResendInterval = time.Minute
+	// FollowerOTPMaxAttempts is how many times a code can be checked before it is burnt.
+	FollowerOTPMaxAttempts = 5
+)
+
+// FollowerOTP is a one-time sign-in code sent to a phone number for the follower portal.
+// Codes are issued per phone number rather than per follower because family members often share one.
+type FollowerOTP struct {
+	ID         uint64     `json:"id" gorm:"primary_key;not null"`
+	DomainID   uint64     `json:"domain_id" gorm:"not null;index:idx_follower_otp_phone"`
+	PhoneKey   string     `json:"-" gorm:"not null;size:20;index:idx_follower_otp_phone"` // fuzzy.NormalizePhone of the number
+	CodeHash   string     `json:"-" gorm:"not null;size:64"`                              // HMAC of the code, never the code itself
+	Attempts   int        `json:"attempts" gorm:"not null;default:0"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"not null"`
+	ConsumedAt *time.Time `json:"consumed_at" gorm:"null"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"not null"`
+}
+
+// TableName specifies the table name for the FollowerOTP entity.
+func (FollowerOTP) TableName() string {
+	return "follower_otps"
+}
+
+// IsUsable reports whether the code can still be checked at the given time.
+func (o FollowerOTP) IsUsable(at time.Time) bool {
+	return o.ConsumedAt == nil && at.Before(o.ExpiresAt) && o.Attempts < FollowerOTPMaxAttempts
+}
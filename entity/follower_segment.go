@@ -0,0 +1,35 @@
+package entity
+
+import "encoding/json"
+
+// FollowerSegment is a named follower query, e.g. "youth" or "not attended in 30 days".
+// Filters holds the follower list query conditions as JSON, keyed by filter name.
+type FollowerSegment struct {
+	ID           uint64  `json:"id" gorm:"primary_key;not null"`
+	DomainID     uint64  `json:"domain_id" gorm:"not null;index"`
+	Name         string  `json:"name" gorm:"not null;size:255"`
+	Description  *string `json:"description" gorm:"size:500;null"`
+	Filters      string  `json:"filters" gorm:"not null;type:text"`
+	InactiveDays *int    `json:"inactive_days" gorm:"null"` // Only followers who have not attended any event for this many days
+	Timestamp
+
+	Domain *Domain `json:"domain,omitempty" gorm:"foreignKey:DomainID"`
+}
+
+// TableName specifies the table name for the FollowerSegment entity.
+func (FollowerSegment) TableName() string {
+	return "follower_segments"
+}
+
+// Conditions decodes Filters into query conditions as accepted by the follower pagination.
+func (s FollowerSegment) Conditions() (map[string][]string, error) {
+	conditions := make(map[string][]string)
+	if s.Filters == "" {
+		return conditions, nil
+	}
+
+	if err := json.Unmarshal([]byte(s.Filters), &conditions); err != nil {
+		return nil, err
+	}
+	return conditions, nil
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginThrottleNextBlock(t *testing.T) {
+	at := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		throttle LoginThrottle
+		until    time.Time
+		locked   bool
+	}{
+		{name: "free failure", throttle: LoginThrottle{Scope: LoginThrottleScopeUsername, Failures: 3}},
+		{name: "first backoff", throttle: LoginThrottle{Scope: LoginThrottleScopeUsername, Failures: 4}, until: at.Add(time.Second)},
+		{name: "backoff doubles", throttle: LoginThrottle{Scope: LoginThrottleScopeUsername, Failures: 6}, until: at.Add(4 * time.Second)},
+		{name: "username lockout", throttle: LoginThrottle{Scope: LoginThrottleScopeUsername, Failures: 10}, until: at.Add(LoginThrottleLockoutDuration), locked: true},
+		{name: "ip has more room", throttle: LoginThrottle{Scope: LoginThrottleScopeIP, Failures: 10}},
+		{name: "ip backoff is capped", throttle: LoginThrottle{Scope: LoginThrottleScopeIP, Failures: 49}, until: at.Add(LoginThrottleMaxBackoff)},
+		{name: "ip lockout", throttle: LoginThrottle{Scope: LoginThrottleScopeIP, Failures: 50}, until: at.Add(LoginThrottleLockoutDuration), locked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			until, locked := tt.throttle.NextBlock(at)
+			assert.Equal(t, tt.until, until)
+			assert.Equal(t, tt.locked, locked)
+		})
+	}
+}
+
+func TestLoginThrottleIsBlocked(t *testing.T) {
+	at := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	until := at.Add(time.Minute)
+
+	assert.False(t, LoginThrottle{}.IsBlocked(at))
+	assert.True(t, LoginThrottle{BlockedUntil: &until}.IsBlocked(at))
+	assert.False(t, LoginThrottle{BlockedUntil: &until}.IsBlocked(until))
+}
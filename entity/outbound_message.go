@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+// Constants for OutboundMessage Status
+const (
+	// OutboundMessageStatusPending is waiting in the outbox for its next delivery attempt.
+	OutboundMessageStatusPending = "pending"
+	// OutboundMessageStatusSending is being handed to the provider. A message stuck in this
+	// status, e.g. after a crash, becomes due again once NextAttemptAt passes.
+	OutboundMessageStatusSending = "sending"
+	// OutboundMessageStatusSent was accepted by the provider.
+	OutboundMessageStatusSent = "sent"
+	// OutboundMessageStatusFailed was rejected permanently or ran out of attempts.
+	OutboundMessageStatusFailed = "failed"
+)
+
+// OutboundMessage is a message in the outbox, sent to a follower's phone by the messaging cron job.
+type OutboundMessage struct {
+	ID                uint64     `json:"id" gorm:"primary_key;not null"`
+	DomainID          uint64     `json:"domain_id" gorm:"not null;index"`
+	FollowerID        *uint64    `json:"follower_id" gorm:"null;index"`
+	ChatTemplateID    *uint64    `json:"chat_template_id" gorm:"null;index"` // Template the body was rendered from
+	EventID           *uint64    `json:"event_id" gorm:"null;index"`         // Event the message is about, e.g. a reminder
+	CampaignRunID     *uint64    `json:"campaign_run_id" gorm:"null;index"`  // Campaign run that queued the message
+	Recipient         string     `json:"recipient" gorm:"not null;size:50"`  // Phone number the message is sent to
+	Body              string     `json:"body" gorm:"not null;type:text"`
+	Status            string     `json:"status" gorm:"not null;size:20;index"` // One of the OutboundMessageStatus constants
+	Attempts          int        `json:"attempts" gorm:"not null;default:0"`
+	NextAttemptAt     *time.Time `json:"next_attempt_at" gorm:"null;index"`
+	LastError         *string    `json:"last_error" gorm:"size:500;null"`
+	Provider          *string    `json:"provider" gorm:"size:50;null"` // Provider that accepted the message
+	ProviderMessageID *string    `json:"provider_message_id" gorm:"size:255;null"`
+	SentAt            *time.Time `json:"sent_at" gorm:"null"`
+	CreatedBy         *uint64    `json:"created_by" gorm:"null"` // User who queued the message
+	Timestamp
+
+	Follower *Follower `json:"follower,omitempty" gorm:"foreignKey:FollowerID"`
+}
+
+// TableName specifies the table name for the OutboundMessage entity.
+func (OutboundMessage) TableName() string {
+	return "outbound_messages"
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// PasswordHistory is a password hash a user had before, kept so it cannot be reused.
+type PasswordHistory struct {
+	ID           uint64    `json:"id" gorm:"primary_key;not null"`
+	UserID       uint64    `json:"user_id" gorm:"not null;index"`
+	PasswordHash string    `json:"-" gorm:"not null;size:255"`
+	CreatedAt    time.Time `json:"created_at" gorm:"not null"`
+}
+
+// TableName specifies the table name for the PasswordHistory entity.
+func (PasswordHistory) TableName() string {
+	return "password_histories"
+}
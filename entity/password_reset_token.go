@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+// Password reset token limits.
+const (
+	// PasswordResetTokenExpiry is how long a reset token can be used.
+	PasswordResetTokenExpiry = 30 * time.Minute
+	// PasswordResetResendInterval is how long a new token cannot be requested for the same user.
+	PasswordResetResendInterval = time.Minute
+)
+
+// PasswordResetToken is a single-use token that lets a user who forgot their password set a new one.
+type PasswordResetToken struct {
+	ID        uint64     `json:"id" gorm:"primary_key;not null"`
+	UserID    uint64     `json:"user_id" gorm:"not null;index"`
+	TokenHash string     `json:"-" gorm:"not null;size:64;uniqueIndex"` // SHA-256 of the token, never the token itself
+	IPAddress string     `json:"ip_address" gorm:"size:45;not null;default:''"`
+	ExpiresAt time.Time  `json:"expires_at" gorm:"not null"`
+	UsedAt    *time.Time `json:"used_at" gorm:"null"`
+	CreatedAt time.Time  `json:"created_at" gorm:"not null"`
+
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for the PasswordResetToken entity.
+func (PasswordResetToken) TableName() string {
+	return "password_reset_tokens"
+}
+
+// IsUsable reports whether the token can still be used at the given time.
+func (t PasswordResetToken) IsUsable(at time.Time) bool {
+	return t.UsedAt == nil && at.Before(t.ExpiresAt)
+}
@@ -10,6 +10,16 @@ const (
 	PointMutationSourceTypeManual = "manual_adjustment"
 	// PointMutationSourceTypeInitial indicates points assigned at follower creation or initial setup.
 	PointMutationSourceTypeInitial = "initial_setup"
+	// PointMutationSourceTypeRedemption indicates points spent by a follower.
+	PointMutationSourceTypeRedemption = "redemption"
+	// PointMutationSourceTypeReconciliation indicates a correction bringing the ledger back in line with the follower's points.
+	PointMutationSourceTypeReconciliation = "reconciliation"
+	// PointMutationSourceTypeBloodDonation indicates points awarded for a blood donation.
+	// This should match the table name of the BloodDonation entity.
+	PointMutationSourceTypeBloodDonation = "blood_donations"
+	// PointMutationSourceTypeRewardRedemption indicates points spent on, or refunded for, a reward redemption order.
+	// This should match the table name of the RewardRedemption entity.
+	PointMutationSourceTypeRewardRedemption = "reward_redemptions"
 	// Add other source types as needed
 )
 
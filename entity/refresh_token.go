@@ -1,18 +1,34 @@
 package entity
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// RefreshToken is one token of a refresh token family. A family starts at sign-in and is the session
+// of one device: each refresh rotates the family's valid token into a new one, so a rotated token
+// being presented again means it was copied, and the whole family is revoked.
 type RefreshToken struct {
-	ID        uuid.UUID `json:"id" gorm:"primary_key;not null"`
-	UserID    uint64    `json:"user_id" gorm:"not null"`
-	ExpiresAt time.Time `json:"expires_at" gorm:"not null"`
-	IsValid   bool      `json:"is_valid" gorm:"not null;default:true"`
-	CreatedAt time.Time `json:"created_at" gorm:"not null"`
-	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+	ID           uuid.UUID  `json:"id" gorm:"primary_key;not null"`
+	FamilyID     uuid.UUID  `json:"family_id" gorm:"index"`
+	UserID       uint64     `json:"user_id" gorm:"not null"`
+	ExpiresAt    time.Time  `json:"expires_at" gorm:"not null"`
+	IsValid      bool       `json:"is_valid" gorm:"not null;default:true"`
+	ReplacedByID *uuid.UUID `json:"replaced_by_id" gorm:"null"`                  // Token the refresh rotated this one into
+	DomainID     *uint64    `json:"domain_id" gorm:"null;index"`                 // Domain the tokens it issues are bound to
+	Scopes       string     `json:"scopes" gorm:"size:1000;not null;default:''"` // Space separated scopes of the tokens it issues
+	SignedInAt   time.Time  `json:"signed_in_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UserAgent    string     `json:"user_agent" gorm:"size:255;not null;default:''"`
+	IPAddress    string     `json:"ip_address" gorm:"size:45;not null;default:''"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"not null"`
+	UpdatedAt    time.Time  `json:"updated_at" gorm:"not null"`
 
 	User User `json:"user" gorm:"foreignKey:UserID"`
 }
+
+// ScopeList returns the scopes of the tokens the refresh token issues.
+func (r RefreshToken) ScopeList() []string {
+	return strings.Fields(r.Scopes)
+}
@@ -0,0 +1,20 @@
+package entity
+
+// Reward is an item of a domain's rewards catalogue that followers can redeem their points for.
+type Reward struct {
+	ID          uint64  `json:"id" gorm:"primary_key;not null"`
+	DomainID    uint64  `json:"domain_id" gorm:"not null;index"`
+	Name        string  `json:"name" gorm:"not null;size:255"`
+	Description *string `json:"description" gorm:"size:500;null"`
+	PointCost   int     `json:"point_cost" gorm:"not null"`                // Points needed to redeem one unit
+	Stock       int     `json:"stock" gorm:"<-:create;not null;default:0"` // Units left, only changed through the stock updates of the repository
+	IsActive    bool    `json:"is_active" gorm:"not null"`                 // Inactive rewards cannot be redeemed
+	Timestamp
+
+	Domain *Domain `json:"domain,omitempty" gorm:"foreignKey:DomainID"`
+}
+
+// TableName specifies the table name for the Reward entity.
+func (Reward) TableName() string {
+	return "rewards"
+}
@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+// Constants for RewardRedemption Status
+const (
+	// RewardRedemptionStatusPending has been paid for with points but not yet handed over.
+	RewardRedemptionStatusPending = "pending"
+	// RewardRedemptionStatusFulfilled has been handed over to the follower.
+	RewardRedemptionStatusFulfilled = "fulfilled"
+	// RewardRedemptionStatusCancelled was cancelled; its points and stock were given back.
+	RewardRedemptionStatusCancelled = "cancelled"
+)
+
+// RewardRedemption is a follower's order for a reward, paid for with points.
+type RewardRedemption struct {
+	ID           uint64     `json:"id" gorm:"primary_key;not null"`
+	DomainID     uint64     `json:"domain_id" gorm:"not null;index"`
+	RewardID     uint64     `json:"reward_id" gorm:"not null;index"`
+	FollowerID   uint64     `json:"follower_id" gorm:"not null;index"`
+	Quantity     int        `json:"quantity" gorm:"not null"`
+	Points       int        `json:"points" gorm:"not null"` // Points spent, the reward's cost at the time times the quantity
+	Status       string     `json:"status" gorm:"not null;size:20;default:'pending';index"`
+	Note         *string    `json:"note" gorm:"size:255;null"`
+	CreatedBy    uint64     `json:"created_by" gorm:"not null"`
+	FulfilledAt  *time.Time `json:"fulfilled_at" gorm:"null"`
+	CancelledAt  *time.Time `json:"cancelled_at" gorm:"null"`
+	CancelReason *string    `json:"cancel_reason" gorm:"size:255;null"`
+	Timestamp
+
+	Reward   *Reward   `json:"reward,omitempty" gorm:"foreignKey:RewardID"`
+	Follower *Follower `json:"follower,omitempty" gorm:"foreignKey:FollowerID"`
+}
+
+// TableName specifies the table name for the RewardRedemption entity.
+func (RewardRedemption) TableName() string {
+	return "reward_redemptions"
+}
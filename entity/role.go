@@ -6,6 +6,7 @@ type Role struct {
 	Name        string `json:"name" gorm:"size:255;index:idx_domain_role_name,unique,priority:2"`
 	Description string `json:"description" gorm:"size:255"`
 	IsActive    bool   `json:"is_active" gorm:"default:true"`
+	RequireMFA  bool   `json:"require_mfa" gorm:"not null;default:false"` // Users holding the role must sign in to the domain with an authenticator
 	Timestamp
 
 	Domain      *Domain  `json:"domain,omitempty" gorm:"foreignKey:DomainID"`
@@ -0,0 +1,38 @@
+package entity
+
+// Constants for SecurityEvent Type
+const (
+	// SecurityEventTypeRefreshTokenReuse records a rotated refresh token being presented again.
+	SecurityEventTypeRefreshTokenReuse = "refresh_token_reuse"
+	// SecurityEventTypeLoginLockout records a username or IP address locked out after too many failed sign-ins.
+	SecurityEventTypeLoginLockout = "login_lockout"
+	// SecurityEventTypeLoginUnlock records an admin lifting a sign-in lockout.
+	SecurityEventTypeLoginUnlock = "login_unlock"
+	// SecurityEventTypeMFAEnabled records a user confirming an authenticator.
+	SecurityEventTypeMFAEnabled = "mfa_enabled"
+	// SecurityEventTypeMFADisabled records a user removing their authenticator.
+	SecurityEventTypeMFADisabled = "mfa_disabled"
+	// SecurityEventTypeMFARecoveryCodeUsed records a recovery code standing in for the authenticator.
+	SecurityEventTypeMFARecoveryCodeUsed = "mfa_recovery_code_used"
+	// SecurityEventTypePasswordReset records a password set with a reset token.
+	SecurityEventTypePasswordReset = "password_reset"
+)
+
+// SecurityEvent records a security relevant event, e.g. a session revoked because its refresh token was stolen.
+type SecurityEvent struct {
+	ID        uint64  `json:"id" gorm:"primary_key;not null"`
+	Type      string  `json:"type" gorm:"not null;size:50;index"` // One of the SecurityEventType constants
+	UserID    *uint64 `json:"user_id" gorm:"null;index"`
+	DomainID  *uint64 `json:"domain_id" gorm:"null;index"`
+	IPAddress string  `json:"ip_address" gorm:"size:45;not null;default:''"`
+	UserAgent string  `json:"user_agent" gorm:"size:255;not null;default:''"`
+	Detail    *string `json:"detail" gorm:"size:500;null"`
+	Timestamp
+
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for the SecurityEvent entity.
+func (SecurityEvent) TableName() string {
+	return "security_events"
+}
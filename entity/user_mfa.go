@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// UserMFA is the TOTP authenticator of a user. It is pending until the first code is confirmed.
+type UserMFA struct {
+	ID           uint64     `json:"id" gorm:"primary_key;not null"`
+	UserID       uint64     `json:"user_id" gorm:"not null;uniqueIndex"`
+	Secret       string     `json:"-" gorm:"not null;size:64"`   // Base32 TOTP secret, see utility/totp
+	EnabledAt    *time.Time `json:"enabled_at" gorm:"null"`      // Set once the first code is confirmed
+	LastUsedStep int64      `json:"-" gorm:"not null;default:0"` // Step of the last accepted code, so a code cannot be replayed
+	Timestamp
+
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for the UserMFA entity.
+func (UserMFA) TableName() string {
+	return "user_mfas"
+}
+
+// IsEnabled reports whether sign-ins must pass the authenticator.
+func (m UserMFA) IsEnabled() bool {
+	return m.EnabledAt != nil
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// UserRecoveryCode is a one-time code that replaces the authenticator of a user once.
+type UserRecoveryCode struct {
+	ID        uint64     `json:"id" gorm:"primary_key;not null"`
+	UserID    uint64     `json:"user_id" gorm:"not null;index"`
+	CodeHash  string     `json:"-" gorm:"not null;size:64;index"` // See utility/recoverycode, never the code itself
+	UsedAt    *time.Time `json:"used_at" gorm:"null"`
+	CreatedAt time.Time  `json:"created_at" gorm:"not null"`
+}
+
+// TableName specifies the table name for the UserRecoveryCode entity.
+func (UserRecoveryCode) TableName() string {
+	return "user_recovery_codes"
+}
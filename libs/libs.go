@@ -3,6 +3,9 @@ package libs
 import (
 	"github.com/PhantomX7/dhamma/libs/casbin"
 	"github.com/PhantomX7/dhamma/libs/gocache"
+	"github.com/PhantomX7/dhamma/libs/messaging"
+	"github.com/PhantomX7/dhamma/libs/otp"
+	"github.com/PhantomX7/dhamma/libs/password_reset"
 	"github.com/PhantomX7/dhamma/libs/transaction_manager"
 
 	"go.uber.org/fx"
@@ -13,5 +16,8 @@ var Module = fx.Options(
 		transaction_manager.New,
 		casbin.New,
 		gocache.New,
+		messaging.New,
+		otp.New,
+		password_reset.New,
 	),
 )
@@ -0,0 +1,65 @@
+package messaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// httpTimeout bounds a single request to a provider.
+const httpTimeout = 15 * time.Second
+
+// postJSON posts payload as JSON and decodes a successful response into out, if not nil.
+// Client errors other than 429 Too Many Requests are reported as permanent failures.
+// Any 2xx response means the provider accepted the message, so a response that cannot be decoded
+// is only logged and out is left as it is. Retrying it would send the message again.
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, payload any, out any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPermanent, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPermanent, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, _ := io.ReadAll(io.LimitReader(res.Body, 64<<10))
+	if res.StatusCode >= http.StatusBadRequest {
+		err = fmt.Errorf("provider responded %d: %s", res.StatusCode, bytes.TrimSpace(resBody))
+		if res.StatusCode < http.StatusInternalServerError && res.StatusCode != http.StatusTooManyRequests {
+			return fmt.Errorf("%w: %v", ErrPermanent, err)
+		}
+		return err
+	}
+
+	if out != nil && len(resBody) > 0 {
+		if err = json.Unmarshal(resBody, out); err != nil {
+			logger.FromCtx(ctx).Warn("failed to decode provider response",
+				zap.String("url", url),
+				zap.Int("status", res.StatusCode),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostJSON_StatusClassification(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		wantErr       bool
+		wantPermanent bool
+	}{
+		{name: "ok", status: http.StatusOK},
+		{name: "created", status: http.StatusCreated},
+		{name: "bad request is permanent", status: http.StatusBadRequest, wantErr: true, wantPermanent: true},
+		{name: "unauthorized is permanent", status: http.StatusUnauthorized, wantErr: true, wantPermanent: true},
+		{name: "not found is permanent", status: http.StatusNotFound, wantErr: true, wantPermanent: true},
+		{name: "too many requests is retried", status: http.StatusTooManyRequests, wantErr: true},
+		{name: "server error is retried", status: http.StatusInternalServerError, wantErr: true},
+		{name: "bad gateway is retried", status: http.StatusBadGateway, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"error":"details"}`))
+			}))
+			defer server.Close()
+
+			err := postJSON(context.Background(), server.Client(), server.URL, nil, map[string]string{}, nil)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "details")
+			assert.Equal(t, tt.wantPermanent, isPermanent(err))
+		})
+	}
+}
+
+func TestPostJSON_NetworkErrorIsRetried(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	err := postJSON(context.Background(), http.DefaultClient, url, nil, map[string]string{}, nil)
+	require.Error(t, err)
+	assert.False(t, isPermanent(err))
+}
+
+func TestPostJSON_SendsPayloadAndDecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var payload map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "+62812", payload["to"])
+
+		_, _ = w.Write([]byte(`{"id":"msg-1"}`))
+	}))
+	defer server.Close()
+
+	var out struct {
+		ID string `json:"id"`
+	}
+	err := postJSON(context.Background(), server.Client(), server.URL, map[string]string{"Authorization": "Bearer secret"}, map[string]string{"to": "+62812"}, &out)
+	require.NoError(t, err)
+	assert.Equal(t, "msg-1", out.ID)
+}
+
+func TestPostJSON_UndecodableResponseIsNotRetried(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`not json`))
+	}))
+	defer server.Close()
+
+	// The provider accepted the message, so it is delivered without an ID rather than sent again
+	var out struct {
+		ID string `json:"id"`
+	}
+	err := postJSON(context.Background(), server.Client(), server.URL, nil, map[string]string{}, &out)
+	require.NoError(t, err)
+	assert.Empty(t, out.ID)
+}
+
+func TestWhatsApp_Send(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/12345/messages", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		var payload struct {
+			To   string `json:"to"`
+			Text struct {
+				Body string `json:"body"`
+			} `json:"text"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "+62812", payload.To)
+		assert.Equal(t, "Hello", payload.Text.Body)
+
+		_, _ = w.Write([]byte(`{"messages":[{"id":"wamid.1"}]}`))
+	}))
+	defer server.Close()
+
+	result, err := NewWhatsApp(server.URL+"/", "12345", "token").Send(context.Background(), Message{To: "+62812", Body: "Hello"})
+	require.NoError(t, err)
+	assert.Equal(t, "wamid.1", result.ProviderMessageID)
+}
+
+func TestSMS_Send(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, map[string]string{"from": "DHAMMA", "to": "+62812", "message": "Hello"}, payload)
+
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))
+	defer server.Close()
+
+	_, err := NewSMS(server.URL, "", "DHAMMA").Send(context.Background(), Message{To: "+62812", Body: "Hello"})
+	require.Error(t, err)
+	assert.True(t, isPermanent(err))
+}
+
+func isPermanent(err error) bool {
+	return errors.Is(err, ErrPermanent)
+}
@@ -0,0 +1,80 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// Log is a sink that appends messages to a JSON lines file instead of sending them.
+// It is meant for development and tests. With an empty path messages only go to the application log.
+type Log struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewLog creates a sink writing to the file at path.
+func NewLog(path string) *Log {
+	return &Log{path: path}
+}
+
+func (l *Log) Name() string {
+	return ProviderLog
+}
+
+func (l *Log) Send(ctx context.Context, message Message) (result Result, err error) {
+	result.ProviderMessageID = fmt.Sprintf("log-%d", time.Now().UnixNano())
+
+	// The application log may be shipped elsewhere, so it only gets enough of the recipient to tell messages apart
+	logger.FromCtx(ctx).Info("outbound message",
+		zap.String("id", result.ProviderMessageID),
+		zap.String("to", maskRecipient(message.To)),
+	)
+
+	if l.path == "" {
+		return
+	}
+
+	line, err := json.Marshal(map[string]any{
+		"id":      result.ProviderMessageID,
+		"to":      message.To,
+		"body":    message.Body,
+		"sent_at": time.Now(),
+	})
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err = os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+		return
+	}
+
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return
+}
+
+// maskRecipient hides all but the last four characters of a recipient.
+func maskRecipient(recipient string) string {
+	runes := []rune(recipient)
+	for i := 0; i < len(runes)-4; i++ {
+		runes[i] = '*'
+	}
+	return string(runes)
+}
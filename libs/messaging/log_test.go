@@ -0,0 +1,13 @@
+package messaging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskRecipient(t *testing.T) {
+	assert.Equal(t, "*********7890", maskRecipient("+628123457890"))
+	assert.Equal(t, "7890", maskRecipient("7890"))
+	assert.Equal(t, "", maskRecipient(""))
+}
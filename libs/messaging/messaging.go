@@ -0,0 +1,65 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// Provider names, as set in MESSAGING_PROVIDER.
+const (
+	ProviderWhatsApp = "whatsapp"
+	ProviderSMS      = "sms"
+	ProviderLog      = "log"
+)
+
+// ErrPermanent marks a failure that retrying will not fix, such as an invalid recipient or rejected credentials.
+var ErrPermanent = errors.New("permanent delivery failure")
+
+// Message is a text message to a single recipient.
+type Message struct {
+	To   string // Recipient phone number
+	Body string
+}
+
+// Result is what the provider reports about an accepted message.
+type Result struct {
+	ProviderMessageID string
+}
+
+// Client sends messages through an outbound messaging provider.
+type Client interface {
+	// Name returns the provider name recorded on sent messages.
+	Name() string
+	// Send delivers the message to the provider. Errors wrapping ErrPermanent must not be retried.
+	Send(ctx context.Context, message Message) (Result, error)
+}
+
+// New returns the client of the provider configured in MESSAGING_PROVIDER.
+// Unknown providers fall back to the log sink so messages are never sent by accident.
+func New() Client {
+	switch strings.ToLower(config.MESSAGING_PROVIDER) {
+	case ProviderWhatsApp:
+		return NewWhatsApp(
+			config.MESSAGING_WHATSAPP_API_URL,
+			config.MESSAGING_WHATSAPP_PHONE_NUMBER_ID,
+			config.MESSAGING_WHATSAPP_ACCESS_TOKEN,
+		)
+	case ProviderSMS:
+		return NewSMS(
+			config.MESSAGING_SMS_GATEWAY_URL,
+			config.MESSAGING_SMS_API_KEY,
+			config.MESSAGING_SMS_SENDER,
+		)
+	case ProviderLog, "":
+		return NewLog(config.MESSAGING_LOG_PATH)
+	default:
+		logger.Get().Warn("unknown messaging provider, falling back to log", zap.String("provider", config.MESSAGING_PROVIDER))
+		return NewLog(config.MESSAGING_LOG_PATH)
+	}
+}
@@ -0,0 +1,52 @@
+package messaging
+
+import (
+	"context"
+	"net/http"
+)
+
+// SMS sends text messages through a generic HTTP gateway.
+// The gateway receives a JSON body {"from", "to", "message"} and may answer with {"id"}.
+type SMS struct {
+	gatewayURL string
+	apiKey     string
+	sender     string
+	client     *http.Client
+}
+
+// NewSMS creates a client for the SMS gateway at gatewayURL, authenticating with apiKey as a bearer token.
+func NewSMS(gatewayURL string, apiKey string, sender string) *SMS {
+	return &SMS{
+		gatewayURL: gatewayURL,
+		apiKey:     apiKey,
+		sender:     sender,
+		client:     &http.Client{Timeout: httpTimeout},
+	}
+}
+
+func (s *SMS) Name() string {
+	return ProviderSMS
+}
+
+func (s *SMS) Send(ctx context.Context, message Message) (result Result, err error) {
+	headers := map[string]string{}
+	if s.apiKey != "" {
+		headers["Authorization"] = "Bearer " + s.apiKey
+	}
+
+	var res struct {
+		ID string `json:"id"`
+	}
+
+	err = postJSON(ctx, s.client, s.gatewayURL, headers, map[string]string{
+		"from":    s.sender,
+		"to":      message.To,
+		"message": message.Body,
+	}, &res)
+	if err != nil {
+		return
+	}
+
+	result.ProviderMessageID = res.ID
+	return
+}
@@ -0,0 +1,63 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// WhatsApp sends text messages through the WhatsApp Business Cloud API.
+type WhatsApp struct {
+	apiURL        string
+	phoneNumberID string
+	accessToken   string
+	client        *http.Client
+}
+
+// NewWhatsApp creates a WhatsApp Business API client sending from the given business phone number ID.
+func NewWhatsApp(apiURL string, phoneNumberID string, accessToken string) *WhatsApp {
+	return &WhatsApp{
+		apiURL:        strings.TrimRight(apiURL, "/"),
+		phoneNumberID: phoneNumberID,
+		accessToken:   accessToken,
+		client:        &http.Client{Timeout: httpTimeout},
+	}
+}
+
+func (w *WhatsApp) Name() string {
+	return ProviderWhatsApp
+}
+
+func (w *WhatsApp) Send(ctx context.Context, message Message) (result Result, err error) {
+	payload := map[string]any{
+		"messaging_product": "whatsapp",
+		"recipient_type":    "individual",
+		"to":                message.To,
+		"type":              "text",
+		"text":              map[string]any{"preview_url": false, "body": message.Body},
+	}
+
+	var res struct {
+		Messages []struct {
+			ID string `json:"id"`
+		} `json:"messages"`
+	}
+
+	err = postJSON(
+		ctx,
+		w.client,
+		fmt.Sprintf("%s/%s/messages", w.apiURL, w.phoneNumberID),
+		map[string]string{"Authorization": "Bearer " + w.accessToken},
+		payload,
+		&res,
+	)
+	if err != nil {
+		return
+	}
+
+	if len(res.Messages) > 0 {
+		result.ProviderMessageID = res.Messages[0].ID
+	}
+	return
+}
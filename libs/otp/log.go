@@ -0,0 +1,27 @@
+package otp
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// Log writes sign-in codes to the application log instead of sending them.
+// It is meant for development only, since anyone reading the logs can sign in as any follower.
+type Log struct{}
+
+// NewLog creates a log sender.
+func NewLog() *Log {
+	return &Log{}
+}
+
+func (l *Log) Send(ctx context.Context, code Code) error {
+	logger.FromCtx(ctx).Info("follower portal sign-in code",
+		zap.String("phone", code.Phone),
+		zap.String("code", code.Code),
+		zap.String("domain", code.DomainName),
+	)
+	return nil
+}
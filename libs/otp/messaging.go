@@ -0,0 +1,31 @@
+package otp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/messaging"
+)
+
+// Messaging sends sign-in codes through the outbound messaging provider.
+// Codes are sent directly rather than through the outbox, a code delivered after it expired is useless.
+type Messaging struct {
+	client messaging.Client
+}
+
+// NewMessaging creates a sender using client.
+func NewMessaging(client messaging.Client) *Messaging {
+	return &Messaging{client: client}
+}
+
+func (m *Messaging) Send(ctx context.Context, code Code) error {
+	_, err := m.client.Send(ctx, messaging.Message{
+		To: code.Phone,
+		Body: fmt.Sprintf(
+			"%s is your %s sign-in code. It expires in %d minutes, do not share it with anyone.",
+			code.Code, code.DomainName, int(entity.FollowerOTPExpiry.Minutes()),
+		),
+	})
+	return err
+}
@@ -0,0 +1,45 @@
+// Package otp delivers follower portal sign-in codes.
+package otp
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/libs/messaging"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// Sender names, as set in OTP_SENDER.
+const (
+	SenderLog       = "log"
+	SenderMessaging = "messaging"
+)
+
+// Code is a sign-in code to deliver to a phone number.
+type Code struct {
+	Phone      string
+	Code       string
+	DomainName string
+}
+
+// Sender delivers sign-in codes.
+type Sender interface {
+	Send(ctx context.Context, code Code) error
+}
+
+// New returns the sender configured in OTP_SENDER.
+// Unknown senders fall back to the log sender so codes are never sent by accident.
+func New(messagingClient messaging.Client) Sender {
+	switch strings.ToLower(config.OTP_SENDER) {
+	case SenderMessaging:
+		return NewMessaging(messagingClient)
+	case SenderLog, "":
+		return NewLog()
+	default:
+		logger.Get().Warn("unknown otp sender, falling back to log", zap.String("sender", config.OTP_SENDER))
+		return NewLog()
+	}
+}
@@ -0,0 +1,28 @@
+package password_reset
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// Log writes reset tokens to the application log instead of sending them.
+// It is meant for development only, since anyone reading the logs can reset any password.
+type Log struct{}
+
+// NewLog creates a log notifier.
+func NewLog() *Log {
+	return &Log{}
+}
+
+func (l *Log) Notify(ctx context.Context, notice Notice) error {
+	logger.FromCtx(ctx).Info("password reset token",
+		zap.Uint64("user_id", notice.UserID),
+		zap.String("username", notice.Username),
+		zap.String("token", notice.Token),
+		zap.Time("expires_at", notice.ExpiresAt),
+	)
+	return nil
+}
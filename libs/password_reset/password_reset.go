@@ -0,0 +1,62 @@
+// Package password_reset delivers password reset tokens.
+package password_reset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/constants"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// Notifier names, as set in PASSWORD_RESET_NOTIFIER.
+const (
+	NotifierLog     = "log"
+	NotifierWebhook = "webhook"
+)
+
+// Notice is a reset token to deliver to a user.
+type Notice struct {
+	UserID     uint64
+	Username   string
+	Token      string
+	ExpiresAt  time.Time
+	DomainCode *string // The domain the reset was requested from, nil for the admin route
+}
+
+// Notifier delivers reset tokens.
+type Notifier interface {
+	Notify(ctx context.Context, notice Notice) error
+}
+
+// New returns the notifier configured in PASSWORD_RESET_NOTIFIER.
+// Outside production, unknown notifiers fall back to the log notifier so tokens are never sent by accident.
+// In production the log notifier would let anyone reading the logs reset any password, so the app refuses
+// to start unless the webhook is configured.
+func New() (Notifier, error) {
+	notifier := strings.ToLower(config.PASSWORD_RESET_NOTIFIER)
+	if config.APP_ENV == constants.EnumRunProduction {
+		if notifier != NotifierWebhook {
+			return nil, fmt.Errorf("password reset notifier %q writes reset tokens to the log, set PASSWORD_RESET_NOTIFIER=webhook in production", config.PASSWORD_RESET_NOTIFIER)
+		}
+		if config.PASSWORD_RESET_WEBHOOK_URL == "" {
+			return nil, errors.New("PASSWORD_RESET_WEBHOOK_URL is required in production")
+		}
+	}
+
+	switch notifier {
+	case NotifierWebhook:
+		return NewWebhook(config.PASSWORD_RESET_WEBHOOK_URL, config.PASSWORD_RESET_WEBHOOK_SECRET), nil
+	case NotifierLog, "":
+		return NewLog(), nil
+	default:
+		logger.Get().Warn("unknown password reset notifier, falling back to log", zap.String("notifier", config.PASSWORD_RESET_NOTIFIER))
+		return NewLog(), nil
+	}
+}
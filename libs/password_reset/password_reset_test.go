@@ -0,0 +1,53 @@
+package password_reset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/constants"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+func TestNew_RefusesToLogTokensInProduction(t *testing.T) {
+	logger.NewLogger()
+
+	appEnv, notifier, webhookURL := config.APP_ENV, config.PASSWORD_RESET_NOTIFIER, config.PASSWORD_RESET_WEBHOOK_URL
+	t.Cleanup(func() {
+		config.APP_ENV, config.PASSWORD_RESET_NOTIFIER, config.PASSWORD_RESET_WEBHOOK_URL = appEnv, notifier, webhookURL
+	})
+
+	tests := []struct {
+		name       string
+		appEnv     string
+		notifier   string
+		webhookURL string
+		want       Notifier
+	}{
+		{name: "log in development", appEnv: "development", notifier: NotifierLog, want: &Log{}},
+		{name: "unknown in development", appEnv: "development", notifier: "email", want: &Log{}},
+		{name: "webhook in production", appEnv: constants.EnumRunProduction, notifier: NotifierWebhook, webhookURL: "https://example.com/reset", want: &Webhook{}},
+		{name: "log in production", appEnv: constants.EnumRunProduction, notifier: NotifierLog},
+		{name: "default in production", appEnv: constants.EnumRunProduction},
+		{name: "unknown in production", appEnv: constants.EnumRunProduction, notifier: "email"},
+		{name: "webhook without url in production", appEnv: constants.EnumRunProduction, notifier: NotifierWebhook},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.APP_ENV, config.PASSWORD_RESET_NOTIFIER, config.PASSWORD_RESET_WEBHOOK_URL = tt.appEnv, tt.notifier, tt.webhookURL
+
+			got, err := New()
+			if tt.want == nil {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.IsType(t, tt.want, got)
+		})
+	}
+}
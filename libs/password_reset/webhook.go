@@ -0,0 +1,66 @@
+package password_reset
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// httpTimeout bounds a single request to the webhook.
+const httpTimeout = 15 * time.Second
+
+// Webhook posts reset tokens to an HTTP endpoint, which looks up how to reach the user and sends them the link.
+// The endpoint receives a JSON body {"user_id", "username", "token", "expires_at", "domain_code"}.
+type Webhook struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewWebhook creates a notifier posting to url, authenticating with secret as a bearer token.
+func NewWebhook(url string, secret string) *Webhook {
+	return &Webhook{
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: httpTimeout},
+	}
+}
+
+func (w *Webhook) Notify(ctx context.Context, notice Notice) error {
+	body, err := json.Marshal(map[string]any{
+		"user_id":     notice.UserID,
+		"username":    notice.Username,
+		"token":       notice.Token,
+		"expires_at":  notice.ExpiresAt,
+		"domain_code": notice.DomainCode,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.secret != "" {
+		req.Header.Set("Authorization", "Bearer "+w.secret)
+	}
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, 64<<10))
+		return fmt.Errorf("password reset webhook responded %d: %s", res.StatusCode, bytes.TrimSpace(resBody))
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"time"
 
@@ -63,7 +64,7 @@ func main() {
 
 // setupServer configures and returns the Gin engine.
 // It sets up middleware including CORS and logging.
-func setupServer(m *middleware.Middleware) *gin.Engine {
+func setupServer(m *middleware.Middleware) (*gin.Engine, error) {
 	// set gin mode
 	if config.APP_ENV == constants.EnumRunProduction {
 		gin.SetMode(gin.ReleaseMode)
@@ -71,6 +72,12 @@ func setupServer(m *middleware.Middleware) *gin.Engine {
 
 	server := gin.New()
 
+	// The client IP address is used for rate limiting and sign-in throttling, so X-Forwarded-For
+	// is only believed when it was set by one of our own proxies
+	if err := server.SetTrustedProxies(config.TRUSTED_PROXIES); err != nil {
+		return nil, err
+	}
+
 	// Enable CORS middleware and custom logger
 	// the order is important
 	server.Use(
@@ -105,7 +112,7 @@ func setupServer(m *middleware.Middleware) *gin.Engine {
 	// Register Prometheus metrics endpoint
 	server.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
-	return server
+	return server, nil
 }
 
 // startServer initializes and starts the HTTP server.
@@ -119,8 +126,10 @@ func startServer(
 
 	// list of custom validators
 	validators := map[string]validator.Func{
-		"unique": cv.Unique(),
-		"exist":  cv.Exist(),
+		"unique":           cv.Unique(),
+		"exist":            cv.Exist(),
+		"timezone":         cv.Timezone(),
+		"card_code_format": cv.CardCodeFormat(),
 	}
 	registerValidators(validators)
 
@@ -172,12 +181,13 @@ func startServer(
 // setupDatabase initializes the database connection and runs migrations.
 func setupDatabase(lc fx.Lifecycle) *gorm.DB {
 	dsn := fmt.Sprintf(
-		"%s:%s@(%s:%s)/%s?charset=utf8mb4&parseTime=true&loc=Asia%%2FJakarta",
+		"%s:%s@(%s:%s)/%s?charset=utf8mb4&parseTime=true&loc=%s",
 		config.DATABASE_USERNAME,
 		config.DATABASE_PASSWORD,
 		config.DATABASE_HOST,
 		config.DATABASE_PORT,
 		config.DATABASE_NAME,
+		url.QueryEscape(config.DATABASE_TIMEZONE),
 	)
 
 	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
@@ -213,6 +213,8 @@ func (m *Middleware) formatValidationErrors(validationErrors validator.Validatio
 			message = "Value must be unique"
 		case "exists":
 			message = "Value does not exist"
+		case "card_code_format":
+			message = "Must use {domain}, {year}, {seq:N} or {rand:N} tokens and include {seq:N} or {rand:N}"
 		default:
 			message = "Invalid value"
 		}
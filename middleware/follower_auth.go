@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/constants"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// FollowerAuthHandle authenticates follower portal requests.
+// It only accepts follower tokens issued for the domain in the route, and stores the follower
+// apart from the user context values so the request can never pass AuthHandle, ValidateDomain or Permission.
+func (m *Middleware) FollowerAuthHandle() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		parts := strings.Split(authHeader, " ")
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header is required"})
+			return
+		}
+
+		claims := &entity.FollowerClaims{}
+		token, err := jwt.ParseWithClaims(parts[1], claims, func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, errors.New("invalid signing method")
+			}
+			return []byte(config.FOLLOWER_JWT_SECRET), nil
+		})
+		if err != nil || !token.Valid || !slices.Contains(claims.Audience, constants.FollowerTokenAudience) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+			return
+		}
+
+		domain, err := m.domainRepo.FindOneByField(c.Request.Context(), "code", c.Param("domain_code"))
+		if err != nil || domain.ID != claims.DomainID || !domain.IsActive {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "token is not valid for this domain"})
+			return
+		}
+
+		// The follower may have been merged away or moved since the token was issued
+		follower, err := m.followerRepo.FindByID(c.Request.Context(), claims.FollowerID)
+		if err != nil || follower.DomainID != domain.ID {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid follower"})
+			return
+		}
+
+		c.Request = c.Request.WithContext(utility.NewContextWithFollower(
+			c.Request.Context(),
+			utility.FollowerContextValues{
+				FollowerID: follower.ID,
+				DomainID:   domain.ID,
+				Location:   domain.Location(),
+			},
+		))
+
+		c.Next()
+	}
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/constants"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// signToken signs claims the way the auth and portal services do.
+func signToken(t *testing.T, claims jwt.Claims, secret string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
+}
+
+func TestFollowerTokensNeverPassUserMiddlewares(t *testing.T) {
+	logger.NewLogger()
+	gin.SetMode(gin.TestMode)
+
+	// Share the secret so only the claims keep the two kinds of tokens apart
+	config.JWT_SECRET = "secret"
+	config.FOLLOWER_JWT_SECRET = "secret"
+
+	// The repositories are nil: each request must be rejected before any lookup
+	middleware := &Middleware{}
+	now := time.Now()
+	registered := jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		IssuedAt:  jwt.NewNumericDate(now),
+	}
+
+	followerClaims := entity.FollowerClaims{FollowerID: 1, DomainID: 1, RegisteredClaims: registered}
+	followerClaims.Audience = jwt.ClaimStrings{constants.FollowerTokenAudience}
+	followerToken := signToken(t, followerClaims, config.FOLLOWER_JWT_SECRET)
+	mfaClaims := entity.MFAClaims{UserID: 1, RegisteredClaims: registered}
+	mfaClaims.Audience = jwt.ClaimStrings{constants.MFATokenAudience}
+	mfaToken := signToken(t, mfaClaims, config.JWT_SECRET)
+	userToken := signToken(t, entity.AccessClaims{UserID: 1, Role: constants.EnumRoleAdmin, RegisteredClaims: registered}, config.JWT_SECRET)
+
+	tests := []struct {
+		name    string
+		handler gin.HandlerFunc
+		token   string
+		context func(c *gin.Context)
+	}{
+		{name: "follower token on user routes", handler: middleware.AuthHandle(), token: followerToken},
+		{name: "sign-in challenge on user routes", handler: middleware.AuthHandle(), token: mfaToken},
+		{name: "user token on portal routes", handler: middleware.FollowerAuthHandle(), token: userToken},
+		{
+			name:    "signed-in follower on a permission check",
+			handler: middleware.Permission("follower", "index"),
+			context: func(c *gin.Context) {
+				c.Request = c.Request.WithContext(utility.NewContextWithFollower(
+					c.Request.Context(),
+					utility.FollowerContextValues{FollowerID: 1, DomainID: 1},
+				))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			reached := false
+			handlers := []gin.HandlerFunc{}
+			if tt.context != nil {
+				handlers = append(handlers, tt.context)
+			}
+			handlers = append(handlers, tt.handler, func(c *gin.Context) {
+				reached = true
+				c.Status(http.StatusOK)
+			})
+			router.GET("/:domain_code/test", handlers...)
+
+			req := httptest.NewRequest(http.MethodGet, "/test/test", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.False(t, reached)
+			assert.Contains(t, []int{http.StatusUnauthorized, http.StatusForbidden}, w.Code)
+		})
+	}
+}
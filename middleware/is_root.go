@@ -6,11 +6,16 @@ import (
 	"net/http"
 )
 
+// IsRoot only lets root tokens through. The admin routes it guards are not tied to permissions,
+// so no scope can cover them and scoped tokens are refused even for root users.
 func (m *Middleware) IsRoot() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Get role from context
 		contextValues, err := utility.ValuesFromContext(c.Request.Context())
 		if err != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "context values not found",
+			})
 			return
 		}
 
@@ -22,6 +27,13 @@ func (m *Middleware) IsRoot() gin.HandlerFunc {
 			return
 		}
 
+		if len(contextValues.Scopes) > 0 {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "token scope does not allow this action",
+			})
+			return
+		}
+
 		c.Next()
 	}
 }
@@ -3,6 +3,7 @@ package middleware
 import (
 	"errors"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/PhantomX7/dhamma/entity"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 )
 
 func (m *Middleware) AuthHandle() gin.HandlerFunc {
@@ -50,7 +52,9 @@ func (m *Middleware) AuthHandle() gin.HandlerFunc {
 			return
 		}
 
-		if !token.Valid {
+		// Follower portal tokens and sign-in challenges are never access tokens, even when signed with the same secret
+		if !token.Valid || slices.Contains(claims.Audience, constants.FollowerTokenAudience) ||
+			slices.Contains(claims.Audience, constants.MFATokenAudience) {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
 			c.Abort()
 			return
@@ -70,10 +74,8 @@ func (m *Middleware) AuthHandle() gin.HandlerFunc {
 			return
 		}
 
-		// Check if refresh token is valid
-		var count int64
-		count, _ = m.refreshTokenRepo.GetValidCountByUserID(c.Request.Context(), claims.UserID)
-		if count == 0 {
+		// Check if the session the token was issued for is still signed in
+		if !m.hasValidSession(c, claims) {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
 			c.Abort()
 			return
@@ -84,11 +86,31 @@ func (m *Middleware) AuthHandle() gin.HandlerFunc {
 		c.Request = c.Request.WithContext(utility.NewContextWithValues(
 			c.Request.Context(),
 			utility.ContextValues{
-				UserID: claims.UserID,
-				IsRoot: claims.Role == constants.EnumRoleRoot,
+				UserID:        claims.UserID,
+				IsRoot:        claims.Role == constants.EnumRoleRoot,
+				TokenDomainID: claims.DomainID,
+				Scopes:        claims.Scopes,
+				SessionID:     claims.SessionID,
 			},
 		))
 
 		c.Next()
 	}
 }
+
+// hasValidSession reports whether the session of an access token still has a valid refresh token.
+// Tokens issued before sessions existed only need the user to have any valid refresh token.
+func (m *Middleware) hasValidSession(c *gin.Context, claims *entity.AccessClaims) bool {
+	if claims.SessionID == "" {
+		count, _ := m.refreshTokenRepo.GetValidCountByUserID(c.Request.Context(), claims.UserID)
+		return count > 0
+	}
+
+	familyID, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		return false
+	}
+
+	valid, _ := m.refreshTokenRepo.HasValidInFamily(c.Request.Context(), familyID)
+	return valid
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/libs/casbin"
 	"github.com/PhantomX7/dhamma/modules/domain"
+	"github.com/PhantomX7/dhamma/modules/follower"
 	"github.com/PhantomX7/dhamma/modules/permission"
 	"github.com/PhantomX7/dhamma/modules/refresh_token"
 	"github.com/PhantomX7/dhamma/modules/user"
@@ -21,6 +22,7 @@ type Middleware struct {
 	refreshTokenRepo      refresh_token.Repository
 	userDomainRepo        user_domain.Repository
 	domainRepo            domain.Repository
+	followerRepo          follower.Repository
 	permissionRepo        permission.Repository
 	casbin                casbin.Client
 	permissionDefinitions map[string]entity.Permission // Add map to store definitions
@@ -33,6 +35,7 @@ func New(
 	refreshTokenRepo refresh_token.Repository,
 	userDomainRepo user_domain.Repository,
 	domainRepo domain.Repository,
+	followerRepo follower.Repository,
 	permissionRepo permission.Repository,
 	casbin casbin.Client,
 ) *Middleware {
@@ -58,6 +61,7 @@ func New(
 		refreshTokenRepo:      refreshTokenRepo,
 		userDomainRepo:        userDomainRepo,
 		domainRepo:            domainRepo,
+		followerRepo:          followerRepo,
 		permissionRepo:        permissionRepo,
 		casbin:                casbin,
 		permissionDefinitions: permissionDefsMap,
@@ -23,6 +23,14 @@ func (m *Middleware) Permission(object string, action string) gin.HandlerFunc {
 			return
 		}
 
+		// Scoped tokens are limited even for root users
+		if !scopeAllows(contextValues.Scopes, object, action) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "token scope does not allow this action",
+			})
+			return
+		}
+
 		// Root user bypass permission check
 		if contextValues.IsRoot {
 			c.Next()
@@ -82,3 +90,20 @@ func (m *Middleware) Permission(object string, action string) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// scopeAllows reports whether a token with the given scopes may use a permission.
+// A scope is either a permission object, allowing all of its actions, or a full permission code.
+// Tokens without scopes are not limited.
+func scopeAllows(scopes []string, object string, action string) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+
+	code := fmt.Sprintf("%s/%s", object, action)
+	for _, scope := range scopes {
+		if scope == object || scope == code {
+			return true
+		}
+	}
+	return false
+}
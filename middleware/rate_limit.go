@@ -2,23 +2,46 @@ package middleware
 
 import (
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	// rateLimitWindow is how long a client waits between two requests.
+	rateLimitWindow = time.Second
+	// rateLimitPruneSize is how many clients the rate limiter remembers before forgetting those outside the window.
+	rateLimitPruneSize = 10000
+)
+
 func (m *Middleware) RateLimit() gin.HandlerFunc {
-	store := make(map[string]int64)
+	var mu sync.Mutex
+	store := make(map[string]time.Time)
 	return func(c *gin.Context) {
 		ip := c.ClientIP()
-		if timestamp, exists := store[ip]; exists {
-			if time.Now().Unix()-timestamp < 1 { // 1 request per second
-				c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many requests"})
-				c.Abort()
-				return
+		now := time.Now()
+
+		mu.Lock()
+		last, exists := store[ip]
+		limited := exists && now.Sub(last) < rateLimitWindow // 1 request per second
+		if !limited {
+			if len(store) >= rateLimitPruneSize {
+				for key, seen := range store {
+					if now.Sub(seen) >= rateLimitWindow {
+						delete(store, key)
+					}
+				}
 			}
+			store[ip] = now
+		}
+		mu.Unlock()
+
+		if limited {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many requests"})
+			c.Abort()
+			return
 		}
-		store[ip] = time.Now().Unix()
 		c.Next()
 	}
 }
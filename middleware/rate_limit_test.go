@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	middleware := &Middleware{}
+	router := gin.New()
+	router.POST("/otp", middleware.RateLimit(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	send := func(remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodPost, "/otp", nil)
+		req.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, send("10.0.0.1:1234"))
+	assert.Equal(t, http.StatusTooManyRequests, send("10.0.0.1:1234"))
+	assert.Equal(t, http.StatusOK, send("10.0.0.2:1234"))
+}
+
+func TestRateLimit_ConcurrentRequestsFromOneClient(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	middleware := &Middleware{}
+	router := gin.New()
+	router.POST("/otp", middleware.RateLimit(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	var mu sync.Mutex
+	statuses := make(map[int]int)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			req := httptest.NewRequest(http.MethodPost, "/otp", nil)
+			req.RemoteAddr = "10.0.0.1:1234"
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			mu.Lock()
+			statuses[w.Code]++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, statuses[http.StatusOK])
+	assert.Equal(t, 20, statuses[http.StatusOK]+statuses[http.StatusTooManyRequests])
+}
@@ -25,6 +25,14 @@ func (m *Middleware) ValidateDomain() gin.HandlerFunc {
 		}
 
 		if !contextValues.IsRoot {
+			// Tokens are bound to the domain they were issued for; switching domain issues a new one
+			if contextValues.TokenDomainID == nil || *contextValues.TokenDomainID != domain.ID {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+					"error": "token is not valid for this domain",
+				})
+				return
+			}
+
 			// Check if user has domain
 			hasDomain, err := m.userDomainRepo.HasDomain(c.Request.Context(), contextValues.UserID, domain.ID)
 			if err != nil {
@@ -42,9 +50,13 @@ func (m *Middleware) ValidateDomain() gin.HandlerFunc {
 		c.Request = c.Request.WithContext(utility.NewContextWithValues(
 			c.Request.Context(),
 			utility.ContextValues{
-				DomainID: &domain.ID,
-				UserID:   contextValues.UserID,
-				IsRoot:   contextValues.IsRoot,
+				DomainID:      &domain.ID,
+				UserID:        contextValues.UserID,
+				IsRoot:        contextValues.IsRoot,
+				Location:      domain.Location(),
+				TokenDomainID: contextValues.TokenDomainID,
+				Scopes:        contextValues.Scopes,
+				SessionID:     contextValues.SessionID,
 			},
 		))
 
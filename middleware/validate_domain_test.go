@@ -0,0 +1,172 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/domain"
+	"github.com/PhantomX7/dhamma/modules/user_domain"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// codeDomainRepo finds the domains of domainsByCode by their code.
+type codeDomainRepo struct {
+	domain.Repository
+	domainsByCode map[string]entity.Domain
+}
+
+func (r codeDomainRepo) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.Domain, error) {
+	found, ok := r.domainsByCode[value.(string)]
+	if !ok {
+		return entity.Domain{}, errors.ErrNotFound
+	}
+	return found, nil
+}
+
+// memberUserDomainRepo gives every user access to every domain.
+type memberUserDomainRepo struct {
+	user_domain.Repository
+}
+
+func (memberUserDomainRepo) HasDomain(ctx context.Context, userID, domainID uint64) (bool, error) {
+	return true, nil
+}
+
+func TestValidateDomainRejectsTokensOfOtherDomains(t *testing.T) {
+	logger.NewLogger()
+	gin.SetMode(gin.TestMode)
+
+	middleware := &Middleware{
+		domainRepo: codeDomainRepo{domainsByCode: map[string]entity.Domain{
+			"first":  {ID: 1, Code: "first"},
+			"second": {ID: 2, Code: "second"},
+		}},
+		userDomainRepo: memberUserDomainRepo{},
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		values  utility.ContextValues
+		allowed bool
+	}{
+		{name: "token of the domain", path: "/first/test", values: utility.ContextValues{UserID: 1, TokenDomainID: utility.PointOf[uint64](1)}, allowed: true},
+		{name: "token of another domain", path: "/second/test", values: utility.ContextValues{UserID: 1, TokenDomainID: utility.PointOf[uint64](1)}},
+		{name: "token without a domain", path: "/first/test", values: utility.ContextValues{UserID: 1}},
+		{name: "root token", path: "/second/test", values: utility.ContextValues{UserID: 1, IsRoot: true}, allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			reached := false
+			router.GET("/:domain_code/test", func(c *gin.Context) {
+				c.Request = c.Request.WithContext(utility.NewContextWithValues(c.Request.Context(), tt.values))
+			}, middleware.ValidateDomain(), func(c *gin.Context) {
+				reached = true
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.allowed, reached)
+			if !tt.allowed {
+				assert.Equal(t, http.StatusForbidden, w.Code)
+			}
+		})
+	}
+}
+
+func TestPermissionLimitsScopedTokens(t *testing.T) {
+	logger.NewLogger()
+	gin.SetMode(gin.TestMode)
+
+	// Root tokens skip the role check, so only the scopes decide
+	middleware := &Middleware{}
+
+	tests := []struct {
+		name    string
+		scopes  []string
+		object  string
+		action  string
+		allowed bool
+	}{
+		{name: "unscoped token", object: "follower", action: "index", allowed: true},
+		{name: "scoped to the object", scopes: []string{"event"}, object: "event", action: "attend", allowed: true},
+		{name: "scoped to the code", scopes: []string{"event/show"}, object: "event", action: "show", allowed: true},
+		{name: "other action of a scoped code", scopes: []string{"event/show"}, object: "event", action: "update"},
+		{name: "other object", scopes: []string{"event"}, object: "follower", action: "index"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			reached := false
+			router.GET("/test", func(c *gin.Context) {
+				c.Request = c.Request.WithContext(utility.NewContextWithValues(c.Request.Context(), utility.ContextValues{
+					UserID: 1,
+					IsRoot: true,
+					Scopes: tt.scopes,
+				}))
+			}, middleware.Permission(tt.object, tt.action), func(c *gin.Context) {
+				reached = true
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+			assert.Equal(t, tt.allowed, reached)
+			if !tt.allowed {
+				assert.Equal(t, http.StatusForbidden, w.Code)
+			}
+		})
+	}
+}
+
+func TestIsRootRefusesScopedTokens(t *testing.T) {
+	logger.NewLogger()
+	gin.SetMode(gin.TestMode)
+
+	middleware := &Middleware{}
+
+	tests := []struct {
+		name    string
+		values  utility.ContextValues
+		allowed bool
+	}{
+		{name: "unscoped root token", values: utility.ContextValues{UserID: 1, IsRoot: true}, allowed: true},
+		{name: "scoped root token", values: utility.ContextValues{UserID: 1, IsRoot: true, Scopes: []string{"event"}}},
+		{name: "domain token", values: utility.ContextValues{UserID: 1, TokenDomainID: utility.PointOf[uint64](1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			reached := false
+			router.GET("/api/event", func(c *gin.Context) {
+				c.Request = c.Request.WithContext(utility.NewContextWithValues(c.Request.Context(), tt.values))
+			}, middleware.IsRoot(), func(c *gin.Context) {
+				reached = true
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/event", nil))
+
+			assert.Equal(t, tt.allowed, reached)
+			if !tt.allowed {
+				assert.Equal(t, http.StatusForbidden, w.Code)
+			}
+		})
+	}
+}
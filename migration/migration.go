@@ -2,25 +2,69 @@ package migration
 
 import (
 	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/fuzzy"
 
 	"gorm.io/gorm"
 )
 
 func RunMigration(db *gorm.DB) error {
-	return db.AutoMigrate(
+	err := db.AutoMigrate(
 		// list all migration here
 		entity.Domain{},
 		entity.RefreshToken{},
+		entity.SecurityEvent{},
+		entity.LoginThrottle{},
 		entity.Role{},
 		entity.User{},
 		entity.UserDomain{},
 		entity.UserRole{},
+		entity.UserMFA{},
+		entity.UserRecoveryCode{},
+		entity.PasswordHistory{},
+		entity.PasswordResetToken{},
 		entity.Permission{},
 		entity.Follower{},
 		entity.Card{},
 		entity.ChatTemplate{},
+		entity.ChatTemplateVersion{},
 		entity.Event{},
 		entity.EventAttendance{},
 		entity.PointMutation{},
+		entity.FollowerMerge{},
+		entity.FollowerOTP{},
+		entity.EventSelfCheckIn{},
+		entity.OutboundMessage{},
+		entity.FollowerSegment{},
+		entity.Campaign{},
+		entity.CampaignRun{},
+		entity.BloodDonation{},
+		entity.Reward{},
+		entity.RewardRedemption{},
 	)
+	if err != nil {
+		return err
+	}
+
+	return backfillFollowerPhoneKeys(db)
+}
+
+// backfillFollowerPhoneKeys sets the phone key of followers saved before it was kept on save.
+func backfillFollowerPhoneKeys(db *gorm.DB) error {
+	var followers []entity.Follower
+	return db.Select("id", "phone").
+		Where("phone IS NOT NULL AND phone <> '' AND phone_key IS NULL").
+		FindInBatches(&followers, 500, func(_ *gorm.DB, _ int) error {
+			for _, follower := range followers {
+				key := fuzzy.NormalizePhone(*follower.Phone)
+				if key == "" {
+					continue
+				}
+
+				err := db.Model(&entity.Follower{}).Where("id = ?", follower.ID).UpdateColumn("phone_key", key).Error
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
 }
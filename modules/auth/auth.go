@@ -5,8 +5,10 @@ import (
 
 	"gorm.io/gorm"
 
+	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
 	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+	sessionResponse "github.com/PhantomX7/dhamma/modules/refresh_token/dto/response"
 	"github.com/gin-gonic/gin"
 )
 
@@ -15,10 +17,22 @@ type Service interface {
 	SignInWithDomain(ctx context.Context, request request.SignInRequest, domainCode string) (response.AuthResponse, error) // New method for domain-specific sign-in
 	SignUp(ctx context.Context, request request.SignUpRequest) (response.AuthResponse, error)
 	Refresh(ctx context.Context, request request.RefreshRequest) (response.AuthResponse, error)
+	SwitchDomain(ctx context.Context, request request.SwitchDomainRequest, domainCode string) (response.AuthResponse, error)
 	UpdatePassword(ctx context.Context, request request.UpdatePasswordRequest) error
+	ForgotPassword(ctx context.Context, request request.ForgotPasswordRequest, domainCode *string) error
+	ResetPassword(ctx context.Context, request request.ResetPasswordRequest) error
 	GetMe(ctx context.Context) (response.MeResponse, error)
-	GenerateAccessToken(userID uint64, role string) (string, error)
-	GenerateRefreshToken(userID uint64, tx *gorm.DB) (string, error)
+	Sessions(ctx context.Context) ([]sessionResponse.Session, error)
+	RevokeSession(ctx context.Context, sessionID string) error
+	VerifyMFA(ctx context.Context, request request.MFAVerifyRequest) (response.AuthResponse, error)
+	SetupMFAChallenge(ctx context.Context, request request.MFAChallengeSetupRequest) (response.MFASetupResponse, error)
+	MFAStatus(ctx context.Context) (response.MFAStatusResponse, error)
+	SetupMFA(ctx context.Context) (response.MFASetupResponse, error)
+	EnableMFA(ctx context.Context, request request.MFACodeRequest) (response.RecoveryCodesResponse, error)
+	DisableMFA(ctx context.Context, request request.MFADisableRequest) error
+	RegenerateRecoveryCodes(ctx context.Context, request request.MFACodeRequest) (response.RecoveryCodesResponse, error)
+	GenerateAccessToken(session entity.RefreshToken, role string) (string, error)
+	GenerateRefreshToken(refreshToken *entity.RefreshToken, tx *gorm.DB) (string, error)
 }
 
 type Controller interface {
@@ -27,5 +41,17 @@ type Controller interface {
 	SignInWithDomain(ctx *gin.Context) // Add new method for domain-specific sign-in
 	SignUp(ctx *gin.Context)
 	Refresh(ctx *gin.Context)
+	SwitchDomain(ctx *gin.Context)
 	UpdatePassword(ctx *gin.Context)
+	ForgotPassword(ctx *gin.Context)
+	ResetPassword(ctx *gin.Context)
+	Sessions(ctx *gin.Context)
+	RevokeSession(ctx *gin.Context)
+	VerifyMFA(ctx *gin.Context)
+	SetupMFAChallenge(ctx *gin.Context)
+	MFAStatus(ctx *gin.Context)
+	SetupMFA(ctx *gin.Context)
+	EnableMFA(ctx *gin.Context)
+	DisableMFA(ctx *gin.Context)
+	RegenerateRecoveryCodes(ctx *gin.Context)
 }
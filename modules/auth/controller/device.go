@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+)
+
+// maxUserAgentLength is the length of the user agent stored with a session.
+const maxUserAgentLength = 255
+
+// deviceOf describes the client making the request, to be stored with its session.
+func deviceOf(ctx *gin.Context) request.Device {
+	userAgent := []rune(ctx.Request.UserAgent())
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+
+	return request.Device{
+		UserAgent: string(userAgent),
+		IPAddress: ctx.ClientIP(),
+	}
+}
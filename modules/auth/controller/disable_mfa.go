@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DisableMFA removes the signed-in user's authenticator.
+// Expected route: POST /auth/mfa/disable
+func (c *controller) DisableMFA(ctx *gin.Context) {
+	var req request.MFADisableRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.Device = deviceOf(ctx)
+
+	err := c.authService.DisableMFA(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", nil))
+}
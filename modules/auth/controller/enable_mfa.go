@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EnableMFA confirms the signed-in user's new authenticator and returns the recovery codes.
+// Expected route: POST /auth/mfa/enable
+func (c *controller) EnableMFA(ctx *gin.Context) {
+	var req request.MFACodeRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.Device = deviceOf(ctx)
+
+	res, err := c.authService.EnableMFA(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
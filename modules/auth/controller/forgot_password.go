@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ForgotPassword sends a password reset token to the user. It always answers ok.
+// Expected routes: POST /api/auth/password/forgot and POST /:domain_code/auth/password/forgot
+func (c *controller) ForgotPassword(ctx *gin.Context) {
+	var req request.ForgotPasswordRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.Device = deviceOf(ctx)
+
+	var domainCode *string
+	if code := ctx.Param("domain_code"); code != "" {
+		domainCode = &code
+	}
+
+	err := c.authService.ForgotPassword(ctx.Request.Context(), req, domainCode)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", nil))
+}
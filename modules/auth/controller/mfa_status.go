@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// MFAStatus describes the signed-in user's authenticator.
+// Expected route: GET /auth/mfa
+func (c *controller) MFAStatus(ctx *gin.Context) {
+	res, err := c.authService.MFAStatus(ctx.Request.Context())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -17,6 +17,7 @@ func (c *controller) Refresh(ctx *gin.Context) {
 		ctx.Error(err)
 		return
 	}
+	req.Device = deviceOf(ctx)
 
 	res, err := c.authService.Refresh(ctx.Request.Context(), req)
 	if err != nil {
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegenerateRecoveryCodes replaces the signed-in user's recovery codes.
+// Expected route: POST /auth/mfa/recovery-codes
+func (c *controller) RegenerateRecoveryCodes(ctx *gin.Context) {
+	var req request.MFACodeRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.Device = deviceOf(ctx)
+
+	res, err := c.authService.RegenerateRecoveryCodes(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
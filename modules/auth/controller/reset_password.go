@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ResetPassword sets a new password with a password reset token.
+// Expected route: POST /auth/password/reset
+func (c *controller) ResetPassword(ctx *gin.Context) {
+	var req request.ResetPasswordRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.Device = deviceOf(ctx)
+
+	err := c.authService.ResetPassword(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", nil))
+}
@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// RevokeSession signs one of the signed-in user's sessions out.
+// Expected route: DELETE /auth/sessions/:session_id
+func (c *controller) RevokeSession(ctx *gin.Context) {
+	err := c.authService.RevokeSession(ctx.Request.Context(), ctx.Param("session_id"))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", nil))
+}
@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Sessions lists the signed-in user's active sessions.
+// Expected route: GET /auth/sessions
+func (c *controller) Sessions(ctx *gin.Context) {
+	res, err := c.authService.Sessions(ctx.Request.Context())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// SetupMFA gives the signed-in user a new authenticator secret and its otpauth URI.
+// Expected route: POST /auth/mfa/setup
+func (c *controller) SetupMFA(ctx *gin.Context) {
+	res, err := c.authService.SetupMFA(ctx.Request.Context())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
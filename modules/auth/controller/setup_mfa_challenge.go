@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupMFAChallenge starts setting up an authenticator during a sign-in that requires one.
+// Expected route: POST /auth/mfa/challenge/setup
+func (c *controller) SetupMFAChallenge(ctx *gin.Context) {
+	var req request.MFAChallengeSetupRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.authService.SetupMFAChallenge(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
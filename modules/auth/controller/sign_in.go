@@ -18,6 +18,7 @@ func (c *controller) SignIn(ctx *gin.Context) {
 		ctx.Error(err)
 		return
 	}
+	req.Device = deviceOf(ctx)
 
 	res, err := c.authService.SignIn(ctx.Request.Context(), req)
 	if err != nil {
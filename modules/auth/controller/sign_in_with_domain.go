@@ -19,6 +19,7 @@ func (c *controller) SignInWithDomain(ctx *gin.Context) {
 		ctx.Error(err)
 		return
 	}
+	req.Device = deviceOf(ctx)
 
 	// Get domain code from URL parameter
 	domainCode := ctx.Param("domain_code")
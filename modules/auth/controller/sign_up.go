@@ -17,6 +17,7 @@ func (c *controller) SignUp(ctx *gin.Context) {
 		ctx.Error(err)
 		return
 	}
+	req.Device = deviceOf(ctx)
 
 	res, err := c.authService.SignUp(ctx.Request.Context(), req)
 	if err != nil {
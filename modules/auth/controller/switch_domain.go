@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// SwitchDomain exchanges the current session for tokens bound to the domain in the URL.
+// Expected route: POST /:domain_code/auth/switch-domain
+func (c *controller) SwitchDomain(ctx *gin.Context) {
+	var req request.SwitchDomainRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.Device = deviceOf(ctx)
+
+	res, err := c.authService.SwitchDomain(ctx.Request.Context(), req, ctx.Param("domain_code"))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -1,14 +1,23 @@
 package request
 
+// Device describes the client signing in or refreshing. It is set by the controller, never bound from the request.
+type Device struct {
+	UserAgent string `json:"-" form:"-"`
+	IPAddress string `json:"-" form:"-"`
+}
+
 type SignInRequest struct {
-	Username   string  `form:"username" json:"username" binding:"required"`
-	Password   string  `form:"password" json:"password" binding:"required"`
-	DomainCode *string `form:"domain_code" json:"domain_code,omitempty"`
+	Username   string   `form:"username" json:"username" binding:"required"`
+	Password   string   `form:"password" json:"password" binding:"required"`
+	DomainCode *string  `form:"domain_code" json:"domain_code,omitempty"`
+	Scopes     []string `form:"scopes" json:"scopes,omitempty" binding:"omitempty,max=50,dive,max=100"` // Limits the tokens to these permission objects or codes, domain sign-ins only
+	Device
 }
 
 type SignUpRequest struct {
 	Username string `form:"username" json:"username" binding:"required,unique=users.username"`
 	Password string `form:"password" json:"password" binding:"required"`
+	Device
 }
 
 type UpdatePasswordRequest struct {
@@ -16,6 +25,54 @@ type UpdatePasswordRequest struct {
 	Password        string `json:"password" form:"password" binding:"required"`
 }
 
+// ForgotPasswordRequest asks for a password reset token to be sent to the user.
+type ForgotPasswordRequest struct {
+	Username string `form:"username" json:"username" binding:"required"`
+	Device
+}
+
+// ResetPasswordRequest sets a new password with a password reset token.
+type ResetPasswordRequest struct {
+	Token    string `form:"token" json:"token" binding:"required"`
+	Password string `form:"password" json:"password" binding:"required"`
+	Device
+}
+
 type RefreshRequest struct {
 	RefreshToken string `form:"refresh_token" json:"refresh_token" binding:"required"`
+	Device
+}
+
+// MFAVerifyRequest completes a two-step sign-in with an authenticator code or a recovery code.
+// For a sign-in that set up the authenticator only a code is accepted.
+type MFAVerifyRequest struct {
+	MFAToken     string `form:"mfa_token" json:"mfa_token" binding:"required"`
+	Code         string `form:"code" json:"code" binding:"required_without=RecoveryCode"`
+	RecoveryCode string `form:"recovery_code" json:"recovery_code"`
+	Device
+}
+
+// MFAChallengeSetupRequest starts setting up an authenticator during a sign-in that requires one.
+type MFAChallengeSetupRequest struct {
+	MFAToken string `form:"mfa_token" json:"mfa_token" binding:"required"`
+}
+
+// MFACodeRequest confirms an action with a code of the user's authenticator.
+type MFACodeRequest struct {
+	Code string `form:"code" json:"code" binding:"required"`
+	Device
+}
+
+// MFADisableRequest removes the user's authenticator. A recovery code may stand in for a lost authenticator.
+type MFADisableRequest struct {
+	Password     string `form:"password" json:"password" binding:"required"`
+	Code         string `form:"code" json:"code"`
+	RecoveryCode string `form:"recovery_code" json:"recovery_code"`
+	Device
+}
+
+// SwitchDomainRequest exchanges the current refresh token for tokens bound to another domain.
+type SwitchDomainRequest struct {
+	RefreshToken string `form:"refresh_token" json:"refresh_token" binding:"required"`
+	Device
 }
@@ -1,10 +1,43 @@
 package response
 
-import "github.com/PhantomX7/dhamma/entity"
+import (
+	"time"
 
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// AuthResponse holds the tokens of a sign-in. When the user must pass an authenticator first
+// the tokens are empty and MFA holds the challenge to complete.
 type AuthResponse struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
+	AccessToken   string        `json:"access_token"`
+	RefreshToken  string        `json:"refresh_token"`
+	MFA           *MFAChallenge `json:"mfa,omitempty"`
+	RecoveryCodes []string      `json:"recovery_codes,omitempty"` // Only set when the sign-in set up the authenticator
+}
+
+// MFAChallenge is the second step of a sign-in.
+type MFAChallenge struct {
+	Token              string    `json:"token"`
+	ExpiresAt          time.Time `json:"expires_at"`
+	EnrollmentRequired bool      `json:"enrollment_required"` // Set up an authenticator with the token before verifying
+}
+
+// MFASetupResponse holds a new authenticator secret. URI is shown as a QR code for authenticator apps.
+type MFASetupResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// MFAStatusResponse describes the authenticator of the signed-in user.
+type MFAStatusResponse struct {
+	Enabled            bool       `json:"enabled"`
+	EnabledAt          *time.Time `json:"enabled_at"`
+	RecoveryCodesCount int64      `json:"recovery_codes_count"`
+}
+
+// RecoveryCodesResponse holds newly issued recovery codes. They are only ever shown once.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
 }
 
 type MeResponse struct {
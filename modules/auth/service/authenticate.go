@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// dummyPasswordHash is compared against when the username does not exist,
+// so the response time does not reveal which usernames are taken.
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// authenticate checks the username and password of a sign-in attempt under the login throttle.
+// Unknown usernames and wrong passwords fail the same way and both count as failures.
+func (s *service) authenticate(ctx context.Context, request request.SignInRequest) (user entity.User, err error) {
+	username := strings.ToLower(strings.TrimSpace(request.Username))
+	now := time.Now()
+
+	throttles, err := s.beginLoginAttempt(ctx, username, request.Device, now)
+	if err != nil {
+		return
+	}
+
+	user, err = s.userRepo.FindOneByField(ctx, "username", username)
+	if err != nil {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(request.Password))
+		s.failLoginAttempt(ctx, throttles, request.Device, now)
+		err = errors.NewServiceError("invalid username or password", nil)
+		return
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password))
+	if err != nil {
+		s.failLoginAttempt(ctx, throttles, request.Device, now)
+		err = errors.NewServiceError("invalid username or password", nil)
+		return
+	}
+
+	s.forgiveLoginAttempt(ctx, throttles)
+	s.clearLoginFailures(ctx, username)
+	return
+}
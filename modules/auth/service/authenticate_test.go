@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/modules/login_throttle"
+	loginThrottleRepo "github.com/PhantomX7/dhamma/modules/login_throttle/repository"
+	refreshTokenRepo "github.com/PhantomX7/dhamma/modules/refresh_token/repository"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// barrierLoginThrottleRepo holds every username failure count until all expected callers have counted theirs,
+// so no concurrent guess has written its backoff by the time the others are checked.
+type barrierLoginThrottleRepo struct {
+	login_throttle.Repository
+	counted *sync.WaitGroup
+}
+
+func (r barrierLoginThrottleRepo) RecordFailure(ctx context.Context, scope, identifier string, at time.Time) (entity.LoginThrottle, error) {
+	throttle, err := r.Repository.RecordFailure(ctx, scope, identifier, at)
+	if scope == entity.LoginThrottleScopeUsername {
+		r.counted.Done()
+		r.counted.Wait()
+	}
+	return throttle, err
+}
+
+func (f authFixture) signInWith(password string) error {
+	return signInWith(f.service, f.user, password)
+}
+
+func signInWith(s auth.Service, user entity.User, password string) error {
+	_, err := s.SignIn(context.Background(), request.SignInRequest{
+		Username: user.Username,
+		Password: password,
+		Device:   request.Device{IPAddress: "203.0.113.7"},
+	})
+	return err
+}
+
+// throttleFailures returns the failures counted against the username and the IP address of signInWith.
+func (f authFixture) throttleFailures(t *testing.T) (username, ip int) {
+	t.Helper()
+
+	var throttles []entity.LoginThrottle
+	require.NoError(t, f.db.Find(&throttles).Error)
+	for _, throttle := range throttles {
+		switch throttle.Scope {
+		case entity.LoginThrottleScopeUsername:
+			username = throttle.Failures
+		case entity.LoginThrottleScopeIP:
+			ip = throttle.Failures
+		}
+	}
+	return
+}
+
+func assertTooManyRequests(t *testing.T, err error, throttled bool) {
+	t.Helper()
+
+	var appErr *errors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, throttled, appErr.Status == http.StatusTooManyRequests, appErr.Message)
+}
+
+func TestAuthenticate_WrongPasswordsStartTheBackoff(t *testing.T) {
+	f := newAuthFixture(t)
+
+	// The free failures answer with the usual error, the next one starts the backoff
+	for range 4 {
+		assertTooManyRequests(t, f.signInWith("wrong"), false)
+	}
+
+	// Even the right password is refused during the backoff, without being counted
+	assertTooManyRequests(t, f.signInWith(authPassword), true)
+
+	username, ip := f.throttleFailures(t)
+	assert.Equal(t, 4, username)
+	assert.Equal(t, 4, ip)
+}
+
+func TestAuthenticate_SuccessDoesNotCount(t *testing.T) {
+	f := newAuthFixture(t)
+
+	assertTooManyRequests(t, f.signInWith("wrong"), false)
+	require.NoError(t, f.signInWith(authPassword))
+
+	// The username starts over and the IP address keeps only the failure
+	username, ip := f.throttleFailures(t)
+	assert.Zero(t, username)
+	assert.Equal(t, 1, ip)
+}
+
+func TestAuthenticate_ConcurrentGuessesStayWithinLockout(t *testing.T) {
+	const guesses = 20
+
+	f := newAuthFixture(t)
+	counted := &sync.WaitGroup{}
+	counted.Add(guesses)
+	s := f.serviceWith(refreshTokenRepo.New(f.db), barrierLoginThrottleRepo{Repository: loginThrottleRepo.New(f.db), counted: counted})
+
+	var wg sync.WaitGroup
+	errs := make([]error, guesses)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = signInWith(s, f.user, "wrong")
+		}(i)
+	}
+	wg.Wait()
+
+	// Every guess is counted before the password is compared, so no more than the lockout are ever compared
+	checked := 0
+	for _, err := range errs {
+		var appErr *errors.AppError
+		require.ErrorAs(t, err, &appErr)
+		if appErr.Status != http.StatusTooManyRequests {
+			checked++
+		}
+	}
+	assert.LessOrEqual(t, checked, 10)
+
+	assertTooManyRequests(t, f.signInWith(authPassword), true)
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// checkMFANotRequired refuses users without a confirmed authenticator who hold a role of the domain that requires one.
+func (s *service) checkMFANotRequired(ctx context.Context, userID, domainID uint64) (err error) {
+	userMFA, found, err := s.userMFARepo.FindByUserID(ctx, userID)
+	if err != nil || (found && userMFA.IsEnabled()) {
+		return
+	}
+
+	required, err := s.userRoleRepo.RequiresMFA(ctx, userID, domainID)
+	if err != nil || !required {
+		return
+	}
+
+	return &errors.AppError{
+		Message: "this domain requires two-factor authentication, sign in to it to set up an authenticator",
+		Status:  http.StatusForbidden,
+	}
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/constants"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+)
+
+// completeSignIn finishes a sign-in whose password was checked. Users with an authenticator, and users
+// holding a role of the domain that requires one, get an MFA challenge instead of tokens.
+func (s *service) completeSignIn(ctx context.Context, user entity.User, domainID *uint64, scopes []string, device request.Device) (res response.AuthResponse, err error) {
+	userMFA, found, err := s.userMFARepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return
+	}
+	enabled := found && userMFA.IsEnabled()
+
+	required := false
+	if !enabled && domainID != nil {
+		required, err = s.userRoleRepo.RequiresMFA(ctx, user.ID, *domainID)
+		if err != nil {
+			return
+		}
+	}
+
+	if !enabled && !required {
+		return s.issueSession(ctx, user, domainID, scopes, device)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(constants.MFAChallengeExpiry)
+	claims := entity.MFAClaims{
+		UserID:             user.ID,
+		DomainID:           domainID,
+		Scopes:             scopes,
+		EnrollmentRequired: !enabled,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{constants.MFATokenAudience},
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(config.JWT_SECRET))
+	if err != nil {
+		return
+	}
+
+	res.MFA = &response.MFAChallenge{
+		Token:              token,
+		ExpiresAt:          expiresAt,
+		EnrollmentRequired: !enabled,
+	}
+	return
+}
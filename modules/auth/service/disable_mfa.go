@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// DisableMFA removes the authenticator and the recovery codes of the signed-in user.
+// A confirmed authenticator needs the password and a code, so a stolen access token alone cannot remove it.
+// Removing a confirmed authenticator signs out the user's other sessions.
+func (s *service) DisableMFA(ctx context.Context, request request.MFADisableRequest) (err error) {
+	user, userMFA, found, err := s.currentUserMFA(ctx)
+	if err != nil || !found {
+		return
+	}
+
+	if userMFA.IsEnabled() {
+		now := time.Now()
+		var throttles []entity.LoginThrottle
+		throttles, err = s.beginLoginAttempt(ctx, user.Username, request.Device, now)
+		if err != nil {
+			return
+		}
+
+		if bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password)) != nil {
+			s.failLoginAttempt(ctx, throttles, request.Device, now)
+			return errors.NewAuthenticationError("password is incorrect", nil)
+		}
+		s.forgiveLoginAttempt(ctx, throttles)
+
+		err = s.verifySecondFactor(ctx, user, userMFA, request.Code, request.RecoveryCode, request.Device)
+		if err != nil {
+			return
+		}
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		if err := s.userRecoveryCodeRepo.DeleteByUserID(ctx, user.ID, tx); err != nil {
+			return err
+		}
+		if err := s.userMFARepo.DeleteByUserID(ctx, user.ID, tx); err != nil {
+			return err
+		}
+
+		if !userMFA.IsEnabled() {
+			return nil
+		}
+		return s.revokeOtherSessions(ctx, user.ID, currentSessionID(ctx), tx)
+	})
+	if err != nil || !userMFA.IsEnabled() {
+		return
+	}
+
+	s.recordSecurityEvent(ctx, entity.SecurityEvent{
+		Type:      entity.SecurityEventTypeMFADisabled,
+		UserID:    &user.ID,
+		IPAddress: request.IPAddress,
+		UserAgent: request.UserAgent,
+	})
+	return
+}
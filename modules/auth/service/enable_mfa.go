@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// EnableMFA confirms the authenticator set up with SetupMFA and returns the recovery codes.
+// The user's other sessions are signed out.
+func (s *service) EnableMFA(ctx context.Context, request request.MFACodeRequest) (res response.RecoveryCodesResponse, err error) {
+	user, userMFA, found, err := s.currentUserMFA(ctx)
+	if err != nil {
+		return
+	}
+
+	if !found {
+		err = &errors.AppError{
+			Message: "set up an authenticator first",
+			Status:  http.StatusBadRequest,
+		}
+		return
+	}
+
+	res.RecoveryCodes, err = s.confirmMFA(ctx, user, userMFA, request.Code, currentSessionID(ctx), request.Device)
+	return
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/password_reset"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// ForgotPassword sends a single-use password reset token through the password reset notifier.
+// It answers the same whether or not a token was sent, so it does not reveal which usernames exist.
+// Like signing in, the admin route only resets root users and a domain route only members of the domain.
+func (s *service) ForgotPassword(ctx context.Context, request request.ForgotPasswordRequest, domainCode *string) (err error) {
+	user, eligible, err := s.findPasswordResetUser(ctx, strings.ToLower(strings.TrimSpace(request.Username)), domainCode)
+	if err != nil || !eligible {
+		return
+	}
+
+	now := time.Now()
+	latest, found, err := s.passwordResetTokenRepo.FindLatestByUserID(ctx, user.ID)
+	if err != nil {
+		return
+	}
+	if found && now.Sub(latest.CreatedAt) < entity.PasswordResetResendInterval {
+		return
+	}
+
+	random := make([]byte, 32)
+	if _, err = rand.Read(random); err != nil {
+		return
+	}
+	token := base64.RawURLEncoding.EncodeToString(random)
+
+	// Only the latest token works
+	err = s.passwordResetTokenRepo.DeleteUnusedByUserID(ctx, user.ID)
+	if err != nil {
+		return
+	}
+
+	resetToken := entity.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: hashResetToken(token),
+		IPAddress: request.IPAddress,
+		ExpiresAt: now.Add(entity.PasswordResetTokenExpiry),
+	}
+	err = s.passwordResetTokenRepo.Create(ctx, &resetToken, nil)
+	if err != nil {
+		return
+	}
+
+	// Delivered in the background so the response time does not reveal whether a token was sent
+	notice := password_reset.Notice{
+		UserID:     user.ID,
+		Username:   user.Username,
+		Token:      token,
+		ExpiresAt:  resetToken.ExpiresAt,
+		DomainCode: domainCode,
+	}
+	go func(ctx context.Context) {
+		if err := s.passwordResetNotifier.Notify(ctx, notice); err != nil {
+			logger.FromCtx(ctx).Error("failed to send password reset token", zap.Uint64("user_id", notice.UserID), zap.Error(err))
+		}
+	}(context.WithoutCancel(ctx))
+
+	return
+}
+
+// findPasswordResetUser finds the user a reset was requested for and reports whether they may reset through the route.
+func (s *service) findPasswordResetUser(ctx context.Context, username string, domainCode *string) (user entity.User, eligible bool, err error) {
+	user, err = s.userRepo.FindOneByField(ctx, "username", username)
+	if err != nil {
+		return user, false, nil
+	}
+
+	if !user.IsActive {
+		return
+	}
+
+	if domainCode == nil {
+		return user, user.IsSuperAdmin, nil
+	}
+
+	if user.IsSuperAdmin {
+		return
+	}
+
+	domain, err := s.domainRepo.FindOneByField(ctx, "code", *domainCode)
+	if err != nil {
+		return user, false, nil
+	}
+
+	eligible, err = s.userDomainRepo.HasDomain(ctx, user.ID, domain.ID)
+	return
+}
+
+// hashResetToken returns the stored form of a reset token. The tokens carry 256 random bits, so a plain hash is enough.
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -10,13 +10,18 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 )
 
-func (s *service) GenerateAccessToken(userID uint64, role string) (string, error) {
+// GenerateAccessToken issues an access token for the session of a refresh token,
+// bound to the same domain and scopes.
+func (s *service) GenerateAccessToken(session entity.RefreshToken, role string) (string, error) {
 	if role == "" {
 		return "", errors.New("empty role")
 	}
 	claims := entity.AccessClaims{
-		UserID: userID,
-		Role:   role,
+		UserID:    session.UserID,
+		Role:      role,
+		DomainID:  session.DomainID,
+		Scopes:    session.ScopeList(),
+		SessionID: session.FamilyID.String(),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(constants.AccessTokenExpiry)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
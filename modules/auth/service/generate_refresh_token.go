@@ -14,12 +14,18 @@ import (
 	"gorm.io/gorm"
 )
 
-func (s *service) GenerateRefreshToken(userID uint64, tx *gorm.DB) (string, error) {
-	refreshToken := &entity.RefreshToken{
-		ID:        uuid.New(),
-		UserID:    userID,
-		ExpiresAt: time.Now().Add(constants.RefreshTokenExpiry),
-		IsValid:   true,
+// GenerateRefreshToken stores and signs a refresh token for the user, domain, scopes and device set on refreshToken.
+// A token without a family starts a new session; rotations pass the family of the token they replace.
+func (s *service) GenerateRefreshToken(refreshToken *entity.RefreshToken, tx *gorm.DB) (string, error) {
+	now := time.Now()
+
+	refreshToken.ID = uuid.New()
+	refreshToken.ExpiresAt = now.Add(constants.RefreshTokenExpiry)
+	refreshToken.IsValid = true
+	refreshToken.ReplacedByID = nil
+	if refreshToken.FamilyID == uuid.Nil {
+		refreshToken.FamilyID = uuid.New()
+		refreshToken.SignedInAt = now
 	}
 
 	// Save to database
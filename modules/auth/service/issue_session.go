@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/PhantomX7/dhamma/constants"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+)
+
+// issueSession starts a new session and returns its tokens. Sessions without a domain are root sessions;
+// the tokens of a domain session only work on the routes of that domain.
+func (s *service) issueSession(ctx context.Context, user entity.User, domainID *uint64, scopes []string, device request.Device) (res response.AuthResponse, err error) {
+	role := constants.EnumRoleRoot // Root users always get root role
+	if domainID != nil {
+		role = constants.EnumRoleAdmin // Domain users get admin role
+	}
+
+	session := entity.RefreshToken{
+		UserID:    user.ID,
+		DomainID:  domainID,
+		Scopes:    strings.Join(scopes, " "),
+		UserAgent: device.UserAgent,
+		IPAddress: device.IPAddress,
+	}
+	refreshToken, err := s.GenerateRefreshToken(&session, nil)
+	if err != nil {
+		return
+	}
+
+	accessToken, err := s.GenerateAccessToken(session, role)
+	if err != nil {
+		return
+	}
+
+	res = response.AuthResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+	return
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// loginThrottleKey identifies one failure counter.
+type loginThrottleKey struct {
+	scope      string
+	identifier string
+}
+
+// loginThrottleKeys returns the counters a sign-in attempt is checked against.
+// Failures count against the submitted username whether or not it exists, so throttling does not reveal which usernames are taken.
+func loginThrottleKeys(username string, device request.Device) []loginThrottleKey {
+	keys := []loginThrottleKey{{scope: entity.LoginThrottleScopeUsername, identifier: username}}
+	if device.IPAddress != "" {
+		keys = append(keys, loginThrottleKey{scope: entity.LoginThrottleScopeIP, identifier: device.IPAddress})
+	}
+	return keys
+}
+
+// beginLoginAttempt counts the attempt as a failure before the credentials are checked and refuses it
+// while the username or the IP address is in backoff or locked out. Counting first means parallel
+// guesses cannot all pass the check before any of them is recorded. The returned counters must be
+// handed to failLoginAttempt or forgiveLoginAttempt once the attempt is checked.
+func (s *service) beginLoginAttempt(ctx context.Context, username string, device request.Device, at time.Time) (throttles []entity.LoginThrottle, err error) {
+	for _, key := range loginThrottleKeys(username, device) {
+		throttle, err := s.loginThrottleRepo.RecordFailure(ctx, key.scope, key.identifier, at)
+		if err != nil {
+			s.forgiveLoginAttempt(ctx, throttles)
+			return nil, err
+		}
+		throttles = append(throttles, throttle)
+	}
+
+	var blockedUntil time.Time
+	for _, throttle := range throttles {
+		// A parallel attempt may have used up the counter without having written its lockout yet
+		if throttle.IsExhausted() {
+			until, _ := throttle.NextBlock(at)
+			if err = s.loginThrottleRepo.Block(ctx, throttle.ID, until); err != nil {
+				s.forgiveLoginAttempt(ctx, throttles)
+				return nil, err
+			}
+			if until.After(blockedUntil) {
+				blockedUntil = until
+			}
+		}
+
+		if throttle.IsBlocked(at) && throttle.BlockedUntil.After(blockedUntil) {
+			blockedUntil = *throttle.BlockedUntil
+		}
+	}
+
+	if blockedUntil.IsZero() {
+		return
+	}
+
+	// A refused attempt was never checked, so it does not count
+	s.forgiveLoginAttempt(ctx, throttles)
+	return nil, errors.NewTooManyRequestsError("too many sign-in attempts, please try again later", nil).
+		WithDetails(map[string]interface{}{
+			"retry_after": int(math.Ceil(blockedUntil.Sub(at).Seconds())),
+		})
+}
+
+// failLoginAttempt starts the backoff or lockout earned by the failures counted in beginLoginAttempt.
+// Errors are only logged so the caller still answers with the usual invalid credentials error.
+func (s *service) failLoginAttempt(ctx context.Context, throttles []entity.LoginThrottle, device request.Device, at time.Time) {
+	for _, throttle := range throttles {
+		until, locked := throttle.NextBlock(at)
+		if until.IsZero() {
+			continue
+		}
+
+		if err := s.loginThrottleRepo.Block(ctx, throttle.ID, until); err != nil {
+			logger.FromCtx(ctx).Error("failed to block sign-ins", zap.Error(err))
+			continue
+		}
+
+		if locked {
+			s.recordLockout(ctx, throttle, device, until)
+		}
+	}
+}
+
+// forgiveLoginAttempt takes back the failures counted in beginLoginAttempt for an attempt that did not fail.
+func (s *service) forgiveLoginAttempt(ctx context.Context, throttles []entity.LoginThrottle) {
+	for _, throttle := range throttles {
+		if err := s.loginThrottleRepo.Forgive(ctx, throttle.ID); err != nil {
+			logger.FromCtx(ctx).Error("failed to forgive sign-in attempt", zap.Error(err))
+		}
+	}
+}
+
+// recordLockout writes the lockout to the logs and the security event audit log.
+func (s *service) recordLockout(ctx context.Context, throttle entity.LoginThrottle, device request.Device, until time.Time) {
+	logger.FromCtx(ctx).Warn("sign-ins locked out after too many failures",
+		zap.String("scope", throttle.Scope),
+		zap.String("identifier", throttle.Identifier),
+		zap.Int("failures", throttle.Failures),
+		zap.Time("locked_until", until),
+	)
+
+	s.recordSecurityEvent(ctx, entity.SecurityEvent{
+		Type:      entity.SecurityEventTypeLoginLockout,
+		IPAddress: device.IPAddress,
+		UserAgent: device.UserAgent,
+		Detail: utility.PointOf(fmt.Sprintf("%s %q locked out until %s after %d failed sign-ins",
+			throttle.Scope, throttle.Identifier, until.UTC().Format(time.RFC3339), throttle.Failures)),
+	})
+}
+
+// clearLoginFailures forgets the failures of a username after a successful sign-in.
+// The IP address counter is left alone so one valid account cannot be used to reset it.
+func (s *service) clearLoginFailures(ctx context.Context, username string) {
+	if _, err := s.loginThrottleRepo.Clear(ctx, entity.LoginThrottleScopeUsername, username); err != nil {
+		logger.FromCtx(ctx).Error("failed to clear sign-in failures", zap.Error(err))
+	}
+}
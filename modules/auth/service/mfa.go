@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/constants"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/recoverycode"
+	"github.com/PhantomX7/dhamma/utility/totp"
+)
+
+// errInvalidMFACode is returned for wrong, expired and replayed authenticator and recovery codes alike.
+func errInvalidMFACode() error {
+	return errors.NewAuthenticationError("invalid authentication code", nil)
+}
+
+// startMFASetup gives the user a new pending authenticator secret. A confirmed authenticator
+// has to be disabled first, so a stolen password or token cannot replace it.
+func (s *service) startMFASetup(ctx context.Context, user entity.User) (res response.MFASetupResponse, err error) {
+	userMFA, found, err := s.userMFARepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return
+	}
+
+	if found && userMFA.IsEnabled() {
+		err = &errors.AppError{
+			Message: "two-factor authentication is already enabled",
+			Status:  http.StatusConflict,
+		}
+		return
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return
+	}
+
+	userMFA.UserID = user.ID
+	userMFA.Secret = secret
+	if found {
+		err = s.userMFARepo.Update(ctx, &userMFA, nil)
+	} else {
+		err = s.userMFARepo.Create(ctx, &userMFA, nil)
+	}
+	if err != nil {
+		return
+	}
+
+	res = response.MFASetupResponse{
+		Secret: secret,
+		URI:    totp.URI(constants.MFAIssuer, user.Username, secret),
+	}
+	return
+}
+
+// confirmMFA enables a pending authenticator with its first code and issues the recovery codes.
+// Every session of the user but currentSessionID is signed out, as none of them passed the authenticator.
+func (s *service) confirmMFA(ctx context.Context, user entity.User, userMFA entity.UserMFA, code string, currentSessionID string, device request.Device) (recoveryCodes []string, err error) {
+	if userMFA.IsEnabled() {
+		err = &errors.AppError{
+			Message: "two-factor authentication is already enabled",
+			Status:  http.StatusConflict,
+		}
+		return
+	}
+
+	err = s.verifySecondFactor(ctx, user, userMFA, code, "", device)
+	if err != nil {
+		return
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		enabled, err := s.userMFARepo.Enable(ctx, userMFA.ID, time.Now(), tx)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			return &errors.AppError{
+				Message: "two-factor authentication is already enabled",
+				Status:  http.StatusConflict,
+			}
+		}
+
+		recoveryCodes, err = s.replaceRecoveryCodes(ctx, user.ID, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.revokeOtherSessions(ctx, user.ID, currentSessionID, tx)
+	})
+	if err != nil {
+		return
+	}
+
+	s.recordSecurityEvent(ctx, entity.SecurityEvent{
+		Type:      entity.SecurityEventTypeMFAEnabled,
+		UserID:    &user.ID,
+		IPAddress: device.IPAddress,
+		UserAgent: device.UserAgent,
+	})
+	return
+}
+
+// verifySecondFactor checks an authenticator code, or a recovery code when one is given, under the login throttle.
+// Accepted codes are used up: an authenticator code cannot be replayed and a recovery code works once.
+func (s *service) verifySecondFactor(ctx context.Context, user entity.User, userMFA entity.UserMFA, code, recoveryCode string, device request.Device) (err error) {
+	now := time.Now()
+
+	throttles, err := s.beginLoginAttempt(ctx, user.Username, device, now)
+	if err != nil {
+		return
+	}
+
+	var accepted bool
+	if recoveryCode != "" {
+		accepted, err = s.userRecoveryCodeRepo.Consume(ctx, user.ID, recoverycode.Hash(recoveryCode), now)
+	} else if step, verifyErr := totp.Verify(userMFA.Secret, code, now); verifyErr == nil {
+		accepted, err = s.userMFARepo.UseStep(ctx, userMFA.ID, step)
+	}
+	if err != nil {
+		s.forgiveLoginAttempt(ctx, throttles)
+		return
+	}
+
+	if !accepted {
+		s.failLoginAttempt(ctx, throttles, device, now)
+		return errInvalidMFACode()
+	}
+	s.forgiveLoginAttempt(ctx, throttles)
+
+	if recoveryCode != "" {
+		s.recordSecurityEvent(ctx, entity.SecurityEvent{
+			Type:      entity.SecurityEventTypeMFARecoveryCodeUsed,
+			UserID:    &user.ID,
+			IPAddress: device.IPAddress,
+			UserAgent: device.UserAgent,
+		})
+	}
+	return
+}
+
+// replaceRecoveryCodes issues a new set of recovery codes, invalidating the previous ones.
+func (s *service) replaceRecoveryCodes(ctx context.Context, userID uint64, tx *gorm.DB) (recoveryCodes []string, err error) {
+	recoveryCodes, err = recoverycode.Generate()
+	if err != nil {
+		return
+	}
+
+	err = s.userRecoveryCodeRepo.DeleteByUserID(ctx, userID, tx)
+	if err != nil {
+		return
+	}
+
+	for _, recoveryCode := range recoveryCodes {
+		err = s.userRecoveryCodeRepo.Create(ctx, &entity.UserRecoveryCode{
+			UserID:   userID,
+			CodeHash: recoverycode.Hash(recoveryCode),
+		}, tx)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// revokeOtherSessions signs the user out of every session but currentSessionID after a change to how they sign in,
+// so a session opened with the old sign-in cannot outlive it. An empty currentSessionID signs out every session.
+func (s *service) revokeOtherSessions(ctx context.Context, userID uint64, currentSessionID string, tx *gorm.DB) error {
+	currentFamilyID, err := uuid.Parse(currentSessionID)
+	if err != nil {
+		// Tokens issued before sessions existed have no session to keep
+		currentFamilyID = uuid.Nil
+	}
+
+	return s.refreshTokenRepo.InvalidateAllByUserIDExceptFamily(ctx, userID, currentFamilyID, tx)
+}
+
+// currentSessionID returns the session the signed-in user's access token was issued for.
+func currentSessionID(ctx context.Context) string {
+	contextValues, _ := utility.ValuesFromContext(ctx)
+	return contextValues.SessionID
+}
+
+// currentUserMFA loads the signed-in user and their authenticator.
+func (s *service) currentUserMFA(ctx context.Context) (user entity.User, userMFA entity.UserMFA, found bool, err error) {
+	contextValues, err := utility.ValuesFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	user, err = s.userRepo.FindByID(ctx, contextValues.UserID)
+	if err != nil {
+		return
+	}
+
+	userMFA, found, err = s.userMFARepo.FindByUserID(ctx, user.ID)
+	return
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+)
+
+// MFAStatus describes the authenticator of the signed-in user.
+func (s *service) MFAStatus(ctx context.Context) (res response.MFAStatusResponse, err error) {
+	user, userMFA, found, err := s.currentUserMFA(ctx)
+	if err != nil || !found || !userMFA.IsEnabled() {
+		return
+	}
+
+	count, err := s.userRecoveryCodeRepo.CountUnused(ctx, user.ID)
+	if err != nil {
+		return
+	}
+
+	res = response.MFAStatusResponse{
+		Enabled:            true,
+		EnabledAt:          userMFA.EnabledAt,
+		RecoveryCodesCount: count,
+	}
+	return
+}
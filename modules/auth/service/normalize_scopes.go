@@ -0,0 +1,34 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/PhantomX7/dhamma/constants/permissions"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// normalizeScopes checks that each requested scope is a permission object or code and drops duplicates.
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, nil
+	}
+
+	known := make(map[string]bool)
+	for _, permission := range permissions.ApiPermissions {
+		known[permission.Object] = true
+		known[fmt.Sprintf("%s/%s", permission.Object, permission.Action)] = true
+	}
+
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !known[scope] {
+			return nil, errors.NewServiceError(fmt.Sprintf("unknown scope %q", scope), nil)
+		}
+		if !slices.Contains(normalized, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+
+	return normalized, nil
+}
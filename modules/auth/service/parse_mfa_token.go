@@ -0,0 +1,27 @@
+package service
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/constants"
+	"github.com/PhantomX7/dhamma/entity"
+	customErrors "github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// parseMFAToken validates the challenge token of a two-step sign-in.
+func parseMFAToken(tokenString string) (claims entity.MFAClaims, err error) {
+	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("invalid signing method")
+		}
+		return []byte(config.JWT_SECRET), nil
+	})
+	if err != nil || !token.Valid || !slices.Contains(claims.Audience, constants.MFATokenAudience) {
+		err = customErrors.NewAuthenticationError("invalid or expired sign-in challenge, sign in again", nil)
+	}
+	return
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+)
+
+// parseRefreshToken validates a signed refresh token and returns its stored record if it can still be used.
+// A token that was already rotated revokes its session, see revokeReusedSession.
+func (s *service) parseRefreshToken(ctx context.Context, tokenString string, device request.Device) (refreshTokenM entity.RefreshToken, err error) {
+	// Parse refresh token and validate
+	claims := &entity.RefreshClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		// Validate signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("invalid signing method")
+		}
+		return []byte(config.JWT_SECRET), nil
+	})
+	if err != nil {
+		err = errors.New("invalid or expired token")
+		return
+	}
+
+	if !token.Valid {
+		err = errors.New("invalid token")
+		return
+	}
+
+	refreshTokenM, err = s.refreshTokenRepo.FindIssuedByID(ctx, claims.RefreshToken)
+	if err != nil {
+		err = errors.New("invalid refresh token")
+		return
+	}
+
+	if !refreshTokenM.IsValid {
+		if refreshTokenM.ReplacedByID != nil {
+			return refreshTokenM, s.revokeReusedSession(ctx, refreshTokenM, device)
+		}
+		// Revoked by signing out, not rotated
+		return refreshTokenM, errors.New("invalid refresh token")
+	}
+
+	return
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// recordSecurityEvent writes an event to the audit log. Failures are only logged,
+// since the action being audited has already happened.
+func (s *service) recordSecurityEvent(ctx context.Context, securityEvent entity.SecurityEvent) {
+	if err := s.securityEventRepo.Create(ctx, &securityEvent, nil); err != nil {
+		logger.FromCtx(ctx).Error("failed to record security event", zap.Error(err))
+	}
+}
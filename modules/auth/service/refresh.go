@@ -2,45 +2,22 @@ package service
 
 import (
 	"context"
-	"errors"
 
-	"github.com/PhantomX7/dhamma/config"
 	"github.com/PhantomX7/dhamma/constants"
 	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
 	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
-	"github.com/golang-jwt/jwt/v4"
-	"gorm.io/gorm"
+	"github.com/PhantomX7/dhamma/utility/errors"
 )
 
+// Refresh rotates a refresh token into new tokens of the same session, bound to the same domain and scopes.
 func (s *service) Refresh(ctx context.Context, request request.RefreshRequest) (res response.AuthResponse, err error) {
-	// Parse refresh token and validate
-	claims := &entity.RefreshClaims{}
-	token, err := jwt.ParseWithClaims(request.RefreshToken, claims, func(token *jwt.Token) (interface{}, error) {
-		// Validate signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("invalid signing method")
-		}
-		return []byte(config.JWT_SECRET), nil
-	})
+	current, err := s.parseRefreshToken(ctx, request.RefreshToken, request.Device)
 	if err != nil {
-		err = errors.New("invalid or expired token")
-		return
-	}
-
-	if !token.Valid {
-		err = errors.New("invalid token")
 		return
 	}
 
-	// Check if refresh token is valid
-	refreshTokenM, err := s.refreshTokenRepo.FindByID(ctx, claims.RefreshToken)
-	if err != nil {
-		err = errors.New("invalid refresh token")
-		return
-	}
-
-	user, err := s.userRepo.FindByID(ctx, refreshTokenM.UserID)
+	user, err := s.userRepo.FindByID(ctx, current.UserID)
 	if err != nil {
 		return
 	}
@@ -50,31 +27,33 @@ func (s *service) Refresh(ctx context.Context, request request.RefreshRequest) (
 		role = constants.EnumRoleRoot
 	}
 
-	accessToken, err := s.GenerateAccessToken(refreshTokenM.UserID, role)
-	if err != nil {
-		return
-	}
-
-	//invalidate current refresh token
-	refreshTokenM.IsValid = false
-
-	var refreshToken string
-	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
-
-		err = s.refreshTokenRepo.Update(ctx, &refreshTokenM, tx)
+	// The user may have lost access to the domain since signing in
+	if current.DomainID != nil {
+		hasAccess, err := s.userDomainRepo.HasDomain(ctx, user.ID, *current.DomainID)
 		if err != nil {
-			return err
+			return res, errors.NewServiceError("error checking domain access", err)
+		}
+		if !hasAccess {
+			return res, errors.NewServiceError("access denied: user does not have access to this domain", nil)
 		}
 
-		refreshToken, err = s.GenerateRefreshToken(refreshTokenM.UserID, tx)
-		if err != nil {
-			return err
+		// A role of the domain may have started requiring an authenticator since signing in
+		if err := s.checkMFANotRequired(ctx, user.ID, *current.DomainID); err != nil {
+			return res, err
 		}
+	}
 
-		res.RefreshToken = refreshToken
+	next := entity.RefreshToken{
+		UserID:   current.UserID,
+		DomainID: current.DomainID,
+		Scopes:   current.Scopes,
+	}
+	refreshToken, err := s.rotateRefreshToken(ctx, current, &next, request.Device)
+	if err != nil {
+		return
+	}
 
-		return nil
-	})
+	accessToken, err := s.GenerateAccessToken(next, role)
 	if err != nil {
 		return
 	}
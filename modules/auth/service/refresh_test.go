@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/auth"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	domainRepo "github.com/PhantomX7/dhamma/modules/domain/repository"
+	"github.com/PhantomX7/dhamma/modules/login_throttle"
+	loginThrottleRepo "github.com/PhantomX7/dhamma/modules/login_throttle/repository"
+	passwordHistoryRepo "github.com/PhantomX7/dhamma/modules/password_history/repository"
+	passwordResetTokenRepo "github.com/PhantomX7/dhamma/modules/password_reset_token/repository"
+	"github.com/PhantomX7/dhamma/modules/refresh_token"
+	refreshTokenRepo "github.com/PhantomX7/dhamma/modules/refresh_token/repository"
+	securityEventRepo "github.com/PhantomX7/dhamma/modules/security_event/repository"
+	userRepo "github.com/PhantomX7/dhamma/modules/user/repository"
+	userDomainRepo "github.com/PhantomX7/dhamma/modules/user_domain/repository"
+	userMFARepo "github.com/PhantomX7/dhamma/modules/user_mfa/repository"
+	userRecoveryCodeRepo "github.com/PhantomX7/dhamma/modules/user_recovery_code/repository"
+	userRoleRepo "github.com/PhantomX7/dhamma/modules/user_role/repository"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// barrierRefreshTokenRepo holds every FindIssuedByID until all expected callers have read the token,
+// so each concurrent refresh finds it still valid.
+type barrierRefreshTokenRepo struct {
+	refresh_token.Repository
+	readers *sync.WaitGroup
+}
+
+func (r barrierRefreshTokenRepo) FindIssuedByID(ctx context.Context, id string) (entity.RefreshToken, error) {
+	refreshToken, err := r.Repository.FindIssuedByID(ctx, id)
+	r.readers.Done()
+	r.readers.Wait()
+	return refreshToken, err
+}
+
+// authFixture is an active root user signing in with authPassword.
+type authFixture struct {
+	db      *gorm.DB
+	service auth.Service
+	user    entity.User
+}
+
+const authPassword = "correct horse battery staple"
+
+func newAuthFixture(t *testing.T) authFixture {
+	db := testdb.New(t,
+		&entity.Domain{},
+		&entity.User{},
+		&entity.UserDomain{},
+		&entity.Role{},
+		&entity.UserRole{},
+		&entity.RefreshToken{},
+		&entity.SecurityEvent{},
+		&entity.LoginThrottle{},
+		&entity.UserMFA{},
+		&entity.UserRecoveryCode{},
+		&entity.PasswordHistory{},
+		&entity.PasswordResetToken{},
+	)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(authPassword), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	user := entity.User{Username: "root", Password: string(hash), IsActive: true, IsSuperAdmin: true}
+	require.NoError(t, db.Create(&user).Error)
+
+	f := authFixture{db: db, user: user}
+	f.service = f.serviceWith(refreshTokenRepo.New(db), loginThrottleRepo.New(db))
+	return f
+}
+
+func (f authFixture) serviceWith(refreshTokens refresh_token.Repository, loginThrottles login_throttle.Repository) auth.Service {
+	return New(
+		userRepo.New(f.db),
+		userRoleRepo.New(f.db),
+		refreshTokens,
+		domainRepo.New(f.db),
+		userDomainRepo.New(f.db),
+		securityEventRepo.New(f.db),
+		loginThrottles,
+		userMFARepo.New(f.db),
+		userRecoveryCodeRepo.New(f.db),
+		passwordHistoryRepo.New(f.db),
+		passwordResetTokenRepo.New(f.db),
+		nil,
+		transaction_manager.New(f.db),
+		nil,
+	)
+}
+
+// signIn signs the user in and returns the refresh token of the new session.
+func (f authFixture) signIn(t *testing.T) string {
+	t.Helper()
+
+	res, err := f.service.SignIn(context.Background(), request.SignInRequest{Username: f.user.Username, Password: authPassword})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.RefreshToken)
+	return res.RefreshToken
+}
+
+func (f authFixture) refresh(refreshToken string) (string, error) {
+	res, err := f.service.Refresh(context.Background(), request.RefreshRequest{RefreshToken: refreshToken})
+	return res.RefreshToken, err
+}
+
+// validTokens returns how many refresh tokens of the user can still be used.
+func (f authFixture) validTokens(t *testing.T) int64 {
+	t.Helper()
+
+	var count int64
+	require.NoError(t, f.db.Model(&entity.RefreshToken{}).Where("user_id = ? AND is_valid = ?", f.user.ID, true).Count(&count).Error)
+	return count
+}
+
+func assertUnauthorized(t *testing.T, err error) {
+	t.Helper()
+
+	var appErr *errors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusUnauthorized, appErr.Status)
+}
+
+func TestRefresh_RotatesOnce(t *testing.T) {
+	f := newAuthFixture(t)
+	first := f.signIn(t)
+
+	second, err := f.refresh(first)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	third, err := f.refresh(second)
+	require.NoError(t, err)
+	assert.NotEmpty(t, third)
+
+	// Every rotation replaces its token within the same session
+	var tokens []entity.RefreshToken
+	require.NoError(t, f.db.Order("created_at asc").Find(&tokens).Error)
+	require.Len(t, tokens, 3)
+	assert.Equal(t, tokens[0].FamilyID, tokens[2].FamilyID)
+	assert.False(t, tokens[0].IsValid)
+	assert.Equal(t, tokens[1].ID, *tokens[0].ReplacedByID)
+	assert.Equal(t, int64(1), f.validTokens(t))
+}
+
+func TestRefresh_ReuseRevokesTheSession(t *testing.T) {
+	f := newAuthFixture(t)
+	other := f.signIn(t)
+	first := f.signIn(t)
+
+	second, err := f.refresh(first)
+	require.NoError(t, err)
+
+	_, err = f.refresh(first)
+	assertUnauthorized(t, err)
+
+	// The thief's copy and the rotated token are both revoked, the other session is left alone
+	_, err = f.refresh(second)
+	assert.Error(t, err)
+	_, err = f.refresh(other)
+	require.NoError(t, err)
+
+	var events int64
+	require.NoError(t, f.db.Model(&entity.SecurityEvent{}).Where("type = ?", entity.SecurityEventTypeRefreshTokenReuse).Count(&events).Error)
+	assert.Equal(t, int64(1), events)
+}
+
+func TestRefresh_ConcurrentRotationsOnlyOneWins(t *testing.T) {
+	const refreshes = 2
+
+	f := newAuthFixture(t)
+	first := f.signIn(t)
+
+	readers := &sync.WaitGroup{}
+	readers.Add(refreshes)
+	s := f.serviceWith(barrierRefreshTokenRepo{Repository: refreshTokenRepo.New(f.db), readers: readers}, loginThrottleRepo.New(f.db))
+
+	var wg sync.WaitGroup
+	errs := make([]error, refreshes)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.Refresh(context.Background(), request.RefreshRequest{RefreshToken: first})
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assertUnauthorized(t, err)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	// The loser's token was rolled back and, as a reuse, it revoked the winner's session too
+	var tokens int64
+	require.NoError(t, f.db.Model(&entity.RefreshToken{}).Count(&tokens).Error)
+	assert.Equal(t, int64(2), tokens)
+	assert.Zero(t, f.validTokens(t))
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// RegenerateRecoveryCodes replaces the recovery codes of the signed-in user after checking an authenticator code.
+// The user's other sessions are signed out, in case one of them was opened with a leaked recovery code.
+func (s *service) RegenerateRecoveryCodes(ctx context.Context, request request.MFACodeRequest) (res response.RecoveryCodesResponse, err error) {
+	user, userMFA, found, err := s.currentUserMFA(ctx)
+	if err != nil {
+		return
+	}
+
+	if !found || !userMFA.IsEnabled() {
+		err = &errors.AppError{
+			Message: "two-factor authentication is not enabled",
+			Status:  http.StatusBadRequest,
+		}
+		return
+	}
+
+	err = s.verifySecondFactor(ctx, user, userMFA, request.Code, "", request.Device)
+	if err != nil {
+		return
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) (err error) {
+		res.RecoveryCodes, err = s.replaceRecoveryCodes(ctx, user.ID, tx)
+		if err != nil {
+			return
+		}
+
+		return s.revokeOtherSessions(ctx, user.ID, currentSessionID(ctx), tx)
+	})
+	return
+}
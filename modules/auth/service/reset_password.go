@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// ResetPassword sets a new password with a reset token from ForgotPassword. The token works once,
+// and every session of the user is signed out since whoever forgot the password may not be the only one holding it.
+func (s *service) ResetPassword(ctx context.Context, request request.ResetPasswordRequest) (err error) {
+	invalidToken := &errors.AppError{
+		Message: "invalid or expired password reset token",
+		Status:  http.StatusBadRequest,
+	}
+
+	now := time.Now()
+	resetToken, err := s.passwordResetTokenRepo.FindOneByField(ctx, "token_hash", hashResetToken(request.Token))
+	if err != nil || !resetToken.IsUsable(now) {
+		return invalidToken
+	}
+
+	user, err := s.userRepo.FindByID(ctx, resetToken.UserID)
+	if err != nil {
+		return
+	}
+
+	// Checked before the token is used up, so a rejected password can be retried with the same token
+	err = s.checkNewPassword(ctx, user, request.Password)
+	if err != nil {
+		return
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		consumed, err := s.passwordResetTokenRepo.Consume(ctx, resetToken.ID, now, tx)
+		if err != nil {
+			return err
+		}
+		if !consumed {
+			return invalidToken
+		}
+
+		return s.setPassword(ctx, &user, request.Password, tx)
+	})
+	if err != nil {
+		return
+	}
+
+	err = s.refreshTokenRepo.InvalidateAllByUserID(ctx, user.ID)
+	if err != nil {
+		return
+	}
+
+	// The user proved they own the account, so earlier failed guesses no longer hold them back
+	s.clearLoginFailures(ctx, user.Username)
+
+	s.recordSecurityEvent(ctx, entity.SecurityEvent{
+		Type:      entity.SecurityEventTypePasswordReset,
+		UserID:    &user.ID,
+		IPAddress: request.IPAddress,
+		UserAgent: request.UserAgent,
+	})
+	return
+}
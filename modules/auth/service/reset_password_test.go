@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	loginThrottleRepo "github.com/PhantomX7/dhamma/modules/login_throttle/repository"
+	"github.com/PhantomX7/dhamma/modules/refresh_token"
+	refreshTokenRepo "github.com/PhantomX7/dhamma/modules/refresh_token/repository"
+)
+
+const newAuthPassword = "NewPassw0rd!"
+
+// countingRefreshTokenRepo counts how often every session of a user is signed out.
+type countingRefreshTokenRepo struct {
+	refresh_token.Repository
+	invalidated *atomic.Int32
+}
+
+func (r countingRefreshTokenRepo) InvalidateAllByUserID(ctx context.Context, userID uint64) error {
+	r.invalidated.Add(1)
+	return r.Repository.InvalidateAllByUserID(ctx, userID)
+}
+
+// resetToken stores a password reset token of the user expiring at expiresAt and returns it.
+func (f authFixture) resetToken(t *testing.T, expiresAt time.Time) string {
+	t.Helper()
+
+	token := "reset-" + time.Now().Format(time.RFC3339Nano)
+	require.NoError(t, f.db.Create(&entity.PasswordResetToken{
+		UserID:    f.user.ID,
+		TokenHash: hashResetToken(token),
+		ExpiresAt: expiresAt,
+	}).Error)
+	return token
+}
+
+func (f authFixture) resetPassword(token, password string) error {
+	return f.service.ResetPassword(context.Background(), request.ResetPasswordRequest{Token: token, Password: password})
+}
+
+// assertPassword checks which password the user signs in with.
+func (f authFixture) assertPassword(t *testing.T, password string) {
+	t.Helper()
+
+	var user entity.User
+	require.NoError(t, f.db.First(&user, f.user.ID).Error)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)))
+}
+
+func TestResetPassword_TokenWorksOnce(t *testing.T) {
+	f := newAuthFixture(t)
+	token := f.resetToken(t, time.Now().Add(entity.PasswordResetTokenExpiry))
+
+	require.NoError(t, f.resetPassword(token, newAuthPassword))
+	f.assertPassword(t, newAuthPassword)
+
+	assertStatus(t, http.StatusBadRequest, f.resetPassword(token, "Another0ne!"))
+	f.assertPassword(t, newAuthPassword)
+
+	var events int64
+	require.NoError(t, f.db.Model(&entity.SecurityEvent{}).Where("type = ?", entity.SecurityEventTypePasswordReset).Count(&events).Error)
+	assert.Equal(t, int64(1), events)
+}
+
+func TestResetPassword_ExpiredTokenIsRefused(t *testing.T) {
+	f := newAuthFixture(t)
+	token := f.resetToken(t, time.Now().Add(-time.Second))
+
+	assertStatus(t, http.StatusBadRequest, f.resetPassword(token, newAuthPassword))
+	f.assertPassword(t, authPassword)
+}
+
+func TestResetPassword_RejectedPasswordKeepsTheToken(t *testing.T) {
+	minLength := config.PASSWORD_MIN_LENGTH
+	config.PASSWORD_MIN_LENGTH = 8
+	t.Cleanup(func() { config.PASSWORD_MIN_LENGTH = minLength })
+
+	f := newAuthFixture(t)
+	token := f.resetToken(t, time.Now().Add(entity.PasswordResetTokenExpiry))
+
+	assertStatus(t, http.StatusBadRequest, f.resetPassword(token, "short"))
+	f.assertPassword(t, authPassword)
+
+	// The same token can be retried with a password the policy accepts
+	require.NoError(t, f.resetPassword(token, newAuthPassword))
+	f.assertPassword(t, newAuthPassword)
+}
+
+func TestResetPassword_SignsOutEverySession(t *testing.T) {
+	f := newAuthFixture(t)
+	invalidated := &atomic.Int32{}
+	f.service = f.serviceWith(countingRefreshTokenRepo{Repository: refreshTokenRepo.New(f.db), invalidated: invalidated}, loginThrottleRepo.New(f.db))
+
+	first := f.signIn(t)
+	second := f.signIn(t)
+	require.Equal(t, int64(2), f.validTokens(t))
+
+	require.NoError(t, f.resetPassword(f.resetToken(t, time.Now().Add(entity.PasswordResetTokenExpiry)), newAuthPassword))
+
+	assert.Equal(t, int32(1), invalidated.Load())
+	assert.Zero(t, f.validTokens(t))
+	_, err := f.refresh(first)
+	assert.Error(t, err)
+	_, err = f.refresh(second)
+	assert.Error(t, err)
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// RevokeSession signs one of the signed-in user's sessions out. Its refresh tokens are invalidated
+// and the access tokens issued for it stop working.
+func (s *service) RevokeSession(ctx context.Context, sessionID string) (err error) {
+	contextValues, err := utility.ValuesFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	familyID, err := uuid.Parse(sessionID)
+	if err != nil {
+		return &errors.AppError{
+			Message: "invalid session id",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	revoked, err := s.refreshTokenRepo.InvalidateFamily(ctx, contextValues.UserID, familyID, nil)
+	if err != nil {
+		return
+	}
+
+	if !revoked {
+		return &errors.AppError{
+			Message: "session not found",
+			Status:  http.StatusNotFound,
+		}
+	}
+
+	return
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	customErrors "errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// errRefreshTokenRotated is returned inside the rotation transaction when another request rotated the token first.
+var errRefreshTokenRotated = customErrors.New("refresh token already rotated")
+
+// rotateRefreshToken replaces the valid token current with next, a new token of the same session
+// issued to device. Of two requests racing with the same token only one rotates it; the other
+// is treated as reuse.
+func (s *service) rotateRefreshToken(ctx context.Context, current entity.RefreshToken, next *entity.RefreshToken, device request.Device) (refreshToken string, err error) {
+	next.FamilyID = current.FamilyID
+	next.SignedInAt = current.SignedInAt
+	next.UserAgent = device.UserAgent
+	next.IPAddress = device.IPAddress
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		refreshToken, err = s.GenerateRefreshToken(next, tx)
+		if err != nil {
+			return err
+		}
+
+		applied, err := s.refreshTokenRepo.Rotate(ctx, current.ID, next.ID, tx)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			return errRefreshTokenRotated
+		}
+		return nil
+	})
+	if customErrors.Is(err, errRefreshTokenRotated) {
+		return "", s.revokeReusedSession(ctx, current, device)
+	}
+
+	return
+}
+
+// revokeReusedSession handles a rotated refresh token being presented again. Only one party should
+// hold a session's tokens, so this means one was copied; as it is unknown which holder is legitimate,
+// every token of the session is revoked and the event is recorded.
+func (s *service) revokeReusedSession(ctx context.Context, reused entity.RefreshToken, device request.Device) error {
+	// Tokens issued before sessions existed have no family to revoke
+	if reused.FamilyID != uuid.Nil {
+		if _, err := s.refreshTokenRepo.InvalidateFamily(ctx, reused.UserID, reused.FamilyID, nil); err != nil {
+			return err
+		}
+	}
+
+	logger.FromCtx(ctx).Warn("refresh token reuse detected, session revoked",
+		zap.Uint64("user_id", reused.UserID),
+		zap.String("session_id", reused.FamilyID.String()),
+		zap.String("refresh_token_id", reused.ID.String()),
+		zap.String("ip_address", device.IPAddress),
+	)
+
+	// The session is already revoked, which is what matters to the user
+	s.recordSecurityEvent(ctx, entity.SecurityEvent{
+		Type:      entity.SecurityEventTypeRefreshTokenReuse,
+		UserID:    &reused.UserID,
+		DomainID:  reused.DomainID,
+		IPAddress: device.IPAddress,
+		UserAgent: device.UserAgent,
+		Detail: utility.PointOf("refresh token " + reused.ID.String() + " of session " + reused.FamilyID.String() +
+			" was used after rotation; the session was revoked"),
+	})
+
+	return &errors.AppError{
+		Message: "refresh token has already been used, the session has been signed out",
+		Status:  http.StatusUnauthorized,
+	}
+}
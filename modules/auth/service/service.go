@@ -2,23 +2,37 @@ package service
 
 import (
 	"github.com/PhantomX7/dhamma/libs/casbin"
+	"github.com/PhantomX7/dhamma/libs/password_reset"
 	"github.com/PhantomX7/dhamma/libs/transaction_manager"
 	"github.com/PhantomX7/dhamma/modules/auth"
 	"github.com/PhantomX7/dhamma/modules/domain"
+	"github.com/PhantomX7/dhamma/modules/login_throttle"
+	"github.com/PhantomX7/dhamma/modules/password_history"
+	"github.com/PhantomX7/dhamma/modules/password_reset_token"
 	"github.com/PhantomX7/dhamma/modules/refresh_token"
+	"github.com/PhantomX7/dhamma/modules/security_event"
 	"github.com/PhantomX7/dhamma/modules/user"
 	"github.com/PhantomX7/dhamma/modules/user_domain"
+	"github.com/PhantomX7/dhamma/modules/user_mfa"
+	"github.com/PhantomX7/dhamma/modules/user_recovery_code"
 	"github.com/PhantomX7/dhamma/modules/user_role"
 )
 
 type service struct {
-	userRepo           user.Repository
-	userRoleRepo       user_role.Repository
-	refreshTokenRepo   refresh_token.Repository
-	domainRepo         domain.Repository      // Add domain repository
-	userDomainRepo     user_domain.Repository // Add user domain repository
-	transactionManager transaction_manager.Client
-	casbin             casbin.Client
+	userRepo               user.Repository
+	userRoleRepo           user_role.Repository
+	refreshTokenRepo       refresh_token.Repository
+	domainRepo             domain.Repository      // Add domain repository
+	userDomainRepo         user_domain.Repository // Add user domain repository
+	securityEventRepo      security_event.Repository
+	loginThrottleRepo      login_throttle.Repository
+	userMFARepo            user_mfa.Repository
+	userRecoveryCodeRepo   user_recovery_code.Repository
+	passwordHistoryRepo    password_history.Repository
+	passwordResetTokenRepo password_reset_token.Repository
+	passwordResetNotifier  password_reset.Notifier
+	transactionManager     transaction_manager.Client
+	casbin                 casbin.Client
 }
 
 func New(
@@ -27,16 +41,30 @@ func New(
 	refreshTokenRepo refresh_token.Repository,
 	domainRepo domain.Repository, // Add domain repository parameter
 	userDomainRepo user_domain.Repository, // Add user domain repository parameter
+	securityEventRepo security_event.Repository,
+	loginThrottleRepo login_throttle.Repository,
+	userMFARepo user_mfa.Repository,
+	userRecoveryCodeRepo user_recovery_code.Repository,
+	passwordHistoryRepo password_history.Repository,
+	passwordResetTokenRepo password_reset_token.Repository,
+	passwordResetNotifier password_reset.Notifier,
 	transactionManager transaction_manager.Client,
 	casbin casbin.Client,
 ) auth.Service {
 	return &service{
-		userRepo:           userRepo,
-		userRoleRepo:       userRoleRepo,
-		refreshTokenRepo:   refreshTokenRepo,
-		domainRepo:         domainRepo,
-		userDomainRepo:     userDomainRepo,
-		transactionManager: transactionManager,
-		casbin:             casbin,
+		userRepo:               userRepo,
+		userRoleRepo:           userRoleRepo,
+		refreshTokenRepo:       refreshTokenRepo,
+		domainRepo:             domainRepo,
+		userDomainRepo:         userDomainRepo,
+		securityEventRepo:      securityEventRepo,
+		loginThrottleRepo:      loginThrottleRepo,
+		userMFARepo:            userMFARepo,
+		userRecoveryCodeRepo:   userRecoveryCodeRepo,
+		passwordHistoryRepo:    passwordHistoryRepo,
+		passwordResetTokenRepo: passwordResetTokenRepo,
+		passwordResetNotifier:  passwordResetNotifier,
+		transactionManager:     transactionManager,
+		casbin:                 casbin,
 	}
 }
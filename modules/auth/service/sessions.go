@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	sessionResponse "github.com/PhantomX7/dhamma/modules/refresh_token/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Sessions lists the signed-in user's active sessions on every device, marking the one making the request.
+func (s *service) Sessions(ctx context.Context) (sessions []sessionResponse.Session, err error) {
+	contextValues, err := utility.ValuesFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	refreshTokens, err := s.refreshTokenRepo.FindActiveByUserID(ctx, contextValues.UserID, nil)
+	if err != nil {
+		return
+	}
+
+	return sessionResponse.NewSessions(refreshTokens, contextValues.SessionID), nil
+}
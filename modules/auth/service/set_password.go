@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/passwordpolicy"
+)
+
+// checkNewPassword checks a new password against the password policy and the user's recent passwords.
+func (s *service) checkNewPassword(ctx context.Context, user entity.User, password string) (err error) {
+	policy := passwordpolicy.FromConfig()
+
+	err = policy.Validate(password, user.Username)
+	if err != nil || policy.History <= 0 {
+		return
+	}
+
+	// The current password counts as the most recent one
+	recentHashes := []string{user.Password}
+	histories, err := s.passwordHistoryRepo.FindRecentByUserID(ctx, user.ID, policy.History-1)
+	if err != nil {
+		return
+	}
+	for _, history := range histories {
+		recentHashes = append(recentHashes, history.PasswordHash)
+	}
+
+	for _, hash := range recentHashes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil {
+			return &errors.AppError{
+				Message: "password was used recently, choose a different one",
+				Status:  http.StatusBadRequest,
+			}
+		}
+	}
+	return
+}
+
+// setPassword stores a new password checked with checkNewPassword, keeping the old hash in the password history.
+func (s *service) setPassword(ctx context.Context, user *entity.User, password string, tx *gorm.DB) (err error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return
+	}
+
+	if keep := passwordpolicy.FromConfig().History - 1; keep > 0 {
+		err = s.passwordHistoryRepo.Create(ctx, &entity.PasswordHistory{
+			UserID:       user.ID,
+			PasswordHash: user.Password,
+		}, tx)
+		if err != nil {
+			return
+		}
+
+		err = s.passwordHistoryRepo.PruneByUserID(ctx, user.ID, keep, tx)
+		if err != nil {
+			return
+		}
+	}
+
+	user.Password = string(hash)
+	return s.userRepo.Update(ctx, user, tx)
+}
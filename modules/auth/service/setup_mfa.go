@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// SetupMFA gives the signed-in user a new authenticator secret to confirm with EnableMFA.
+func (s *service) SetupMFA(ctx context.Context) (res response.MFASetupResponse, err error) {
+	contextValues, err := utility.ValuesFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	user, err := s.userRepo.FindByID(ctx, contextValues.UserID)
+	if err != nil {
+		return
+	}
+
+	return s.startMFASetup(ctx, user)
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// SetupMFAChallenge starts setting up an authenticator during a sign-in to a domain that requires one.
+func (s *service) SetupMFAChallenge(ctx context.Context, request request.MFAChallengeSetupRequest) (res response.MFASetupResponse, err error) {
+	claims, err := parseMFAToken(request.MFAToken)
+	if err != nil {
+		return
+	}
+
+	if !claims.EnrollmentRequired {
+		err = &errors.AppError{
+			Message: "two-factor authentication is already enabled",
+			Status:  http.StatusConflict,
+		}
+		return
+	}
+
+	user, err := s.userRepo.FindByID(ctx, claims.UserID)
+	if err != nil {
+		return
+	}
+
+	return s.startMFASetup(ctx, user)
+}
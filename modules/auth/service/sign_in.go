@@ -2,27 +2,18 @@ package service
 
 import (
 	"context"
-	"strings"
 
-	"github.com/PhantomX7/dhamma/constants"
 	"github.com/PhantomX7/dhamma/utility/errors"
 
-	"golang.org/x/crypto/bcrypt"
-
-	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
 	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
 )
 
 // SignIn handles root/admin authentication only
 func (s *service) SignIn(ctx context.Context, request request.SignInRequest) (res response.AuthResponse, err error) {
-	user := entity.User{}
-
-	request.Username = strings.ToLower(strings.TrimSpace(request.Username))
-
-	user, err = s.userRepo.FindOneByField(ctx, "username", request.Username)
+	// The password is checked first so the route check does not reveal which usernames exist
+	user, err := s.authenticate(ctx, request)
 	if err != nil {
-		err = errors.NewServiceError("invalid username or password", nil)
 		return
 	}
 
@@ -32,27 +23,12 @@ func (s *service) SignIn(ctx context.Context, request request.SignInRequest) (re
 		return
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password))
-	if err != nil {
-		err = errors.NewServiceError("invalid username or password", nil)
-		return
-	}
-
-	role := constants.EnumRoleRoot // Root users always get root role
-
-	accessToken, err := s.GenerateAccessToken(user.ID, role)
-	if err != nil {
+	// Root tokens are only accepted on the admin routes, which no scope can cover
+	if len(request.Scopes) > 0 {
+		err = errors.NewServiceError("scopes are only supported when signing in to a domain", nil)
 		return
 	}
 
-	refreshTokenM, err := s.GenerateRefreshToken(user.ID, nil)
-	if err != nil {
-		return
-	}
-
-	res = response.AuthResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshTokenM,
-	}
-	return
+	// Root tokens are not bound to a domain
+	return s.completeSignIn(ctx, user, nil, nil, request.Device)
 }
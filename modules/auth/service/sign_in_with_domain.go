@@ -2,26 +2,17 @@ package service
 
 import (
 	"context"
-	"strings"
 
-	"golang.org/x/crypto/bcrypt"
-
-	"github.com/PhantomX7/dhamma/constants"
-	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
 	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
 	"github.com/PhantomX7/dhamma/utility/errors"
 )
 
-// SignInWithDomain handles domain-specific authentication
+// SignInWithDomain handles domain-specific authentication. The tokens it issues are bound to the domain.
 func (s *service) SignInWithDomain(ctx context.Context, request request.SignInRequest, domainCode string) (res response.AuthResponse, err error) {
-	user := entity.User{}
-
-	request.Username = strings.ToLower(strings.TrimSpace(request.Username))
-
-	user, err = s.userRepo.FindOneByField(ctx, "username", request.Username)
+	// The password is checked first so the route check does not reveal which usernames exist
+	user, err := s.authenticate(ctx, request)
 	if err != nil {
-		err = errors.NewServiceError("invalid username or password", nil)
 		return
 	}
 
@@ -31,12 +22,6 @@ func (s *service) SignInWithDomain(ctx context.Context, request request.SignInRe
 		return
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password))
-	if err != nil {
-		err = errors.NewServiceError("invalid username or password", nil)
-		return
-	}
-
 	// Validate that the domain exists
 	domain, err := s.domainRepo.FindOneByField(ctx, "code", domainCode)
 	if err != nil {
@@ -56,21 +41,11 @@ func (s *service) SignInWithDomain(ctx context.Context, request request.SignInRe
 		return
 	}
 
-	role := constants.EnumRoleAdmin // Domain users get admin role
-
-	accessToken, err := s.GenerateAccessToken(user.ID, role)
-	if err != nil {
-		return
-	}
-
-	refreshTokenM, err := s.GenerateRefreshToken(user.ID, nil)
+	scopes, err := normalizeScopes(request.Scopes)
 	if err != nil {
 		return
 	}
 
-	res = response.AuthResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshTokenM,
-	}
-	return
+	// The tokens only work on the routes of the domain signed in to
+	return s.completeSignIn(ctx, user, &domain.ID, scopes, request.Device)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
 	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+	"github.com/PhantomX7/dhamma/utility/passwordpolicy"
 )
 
 func (s *service) SignUp(ctx context.Context, request request.SignUpRequest) (res response.AuthResponse, err error) {
@@ -23,6 +24,11 @@ func (s *service) SignUp(ctx context.Context, request request.SignUpRequest) (re
 
 	_ = copier.Copy(&user, &request)
 
+	err = passwordpolicy.FromConfig().Validate(request.Password, request.Username)
+	if err != nil {
+		return
+	}
+
 	password, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
 	if err != nil {
 		err = errors.New("failed to hash password")
@@ -42,12 +48,17 @@ func (s *service) SignUp(ctx context.Context, request request.SignUpRequest) (re
 			role = constants.EnumRoleRoot
 		}
 
-		accessToken, err = s.GenerateAccessToken(user.ID, role)
+		session := entity.RefreshToken{
+			UserID:    user.ID,
+			UserAgent: request.UserAgent,
+			IPAddress: request.IPAddress,
+		}
+		refreshToken, err = s.GenerateRefreshToken(&session, tx)
 		if err != nil {
 			return err
 		}
 
-		refreshToken, err = s.GenerateRefreshToken(user.ID, tx)
+		accessToken, err = s.GenerateAccessToken(session, role)
 		if err != nil {
 			return err
 		}
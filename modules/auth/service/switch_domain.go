@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/constants"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// SwitchDomain exchanges the signed-in user's refresh token for tokens bound to another of their domains.
+// The refresh token is rotated like a refresh, so the session no longer works on the domain it was bound to.
+// The scopes of the session carry over to the new tokens.
+func (s *service) SwitchDomain(ctx context.Context, request request.SwitchDomainRequest, domainCode string) (res response.AuthResponse, err error) {
+	contextValues, err := utility.ValuesFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	if contextValues.IsRoot {
+		err = errors.NewServiceError("root users are not bound to a domain", nil)
+		return
+	}
+
+	current, err := s.parseRefreshToken(ctx, request.RefreshToken, request.Device)
+	if err != nil {
+		return
+	}
+
+	if current.UserID != contextValues.UserID {
+		err = errors.NewServiceError("invalid refresh token", nil)
+		return
+	}
+
+	domain, err := s.domainRepo.FindOneByField(ctx, "code", domainCode)
+	if err != nil {
+		err = errors.NewServiceError("invalid domain", nil)
+		return
+	}
+
+	hasAccess, err := s.userDomainRepo.HasDomain(ctx, contextValues.UserID, domain.ID)
+	if err != nil {
+		err = errors.NewServiceError("error checking domain access", err)
+		return
+	}
+
+	if !hasAccess {
+		err = errors.NewServiceError("access denied: user does not have access to this domain", nil)
+		return
+	}
+
+	// A user without an authenticator cannot carry their session into a domain that requires one
+	err = s.checkMFANotRequired(ctx, contextValues.UserID, domain.ID)
+	if err != nil {
+		return
+	}
+
+	// The session carries over to the new domain
+	next := entity.RefreshToken{
+		UserID:   current.UserID,
+		DomainID: &domain.ID,
+		Scopes:   current.Scopes,
+	}
+	refreshToken, err := s.rotateRefreshToken(ctx, current, &next, request.Device)
+	if err != nil {
+		return
+	}
+
+	accessToken, err := s.GenerateAccessToken(next, constants.EnumRoleAdmin)
+	if err != nil {
+		return
+	}
+
+	res = response.AuthResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+	return
+}
@@ -2,15 +2,17 @@ package service
 
 import (
 	"context"
-	"errors"
-
-	"github.com/PhantomX7/dhamma/utility"
+	"net/http"
 
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 
 	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
 )
 
+// UpdatePassword changes the signed-in user's password and signs out their other sessions.
 func (s *service) UpdatePassword(
 	ctx context.Context,
 	request request.UpdatePasswordRequest,
@@ -28,18 +30,24 @@ func (s *service) UpdatePassword(
 
 	err = bcrypt.CompareHashAndPassword([]byte(userM.Password), []byte(request.CurrentPassword))
 	if err != nil {
-		err = errors.New("current password is incorrect")
+		err = &errors.AppError{
+			Message: "current password is incorrect",
+			Status:  http.StatusBadRequest,
+		}
 		return
 	}
 
-	password, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	err = s.checkNewPassword(ctx, userM, request.Password)
 	if err != nil {
-		err = errors.New("failed to update password")
 		return
 	}
-	userM.Password = string(password)
 
-	err = s.userRepo.Update(ctx, &userM, nil)
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		if err := s.setPassword(ctx, &userM, request.Password, tx); err != nil {
+			return err
+		}
+		return s.revokeOtherSessions(ctx, userM.ID, currentSessionID(ctx), tx)
+	})
 	if err != nil {
 		return
 	}
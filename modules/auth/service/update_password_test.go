@@ -0,0 +1,44 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+)
+
+func TestUpdatePassword_SignsOutTheOtherSessions(t *testing.T) {
+	f := newAuthFixture(t)
+	current := f.signIn(t)
+	other := f.signIn(t)
+
+	require.NoError(t, f.service.UpdatePassword(f.sessionContext(t, f.user, current), request.UpdatePasswordRequest{
+		CurrentPassword: authPassword,
+		Password:        newAuthPassword,
+	}))
+	f.assertPassword(t, newAuthPassword)
+
+	_, err := f.refresh(current)
+	require.NoError(t, err)
+	_, err = f.refresh(other)
+	assert.Error(t, err)
+}
+
+func TestUpdatePassword_WrongCurrentPasswordIsBadRequest(t *testing.T) {
+	f := newAuthFixture(t)
+	current := f.signIn(t)
+	other := f.signIn(t)
+
+	err := f.service.UpdatePassword(f.sessionContext(t, f.user, current), request.UpdatePasswordRequest{
+		CurrentPassword: "not-" + authPassword,
+		Password:        newAuthPassword,
+	})
+	assertStatus(t, http.StatusBadRequest, err)
+	f.assertPassword(t, authPassword)
+
+	_, err = f.refresh(other)
+	assert.NoError(t, err)
+}
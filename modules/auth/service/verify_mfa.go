@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// VerifyMFA completes a two-step sign-in with a code of the user's authenticator, or with a recovery code.
+// When the sign-in required setting up an authenticator, the first code confirms it and the response
+// carries the recovery codes.
+func (s *service) VerifyMFA(ctx context.Context, request request.MFAVerifyRequest) (res response.AuthResponse, err error) {
+	claims, err := parseMFAToken(request.MFAToken)
+	if err != nil {
+		return
+	}
+
+	user, err := s.userRepo.FindByID(ctx, claims.UserID)
+	if err != nil {
+		return
+	}
+
+	userMFA, found, err := s.userMFARepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return
+	}
+
+	if !found {
+		err = &errors.AppError{
+			Message: "set up an authenticator before verifying the sign-in",
+			Status:  http.StatusBadRequest,
+		}
+		return
+	}
+
+	var recoveryCodes []string
+	switch {
+	case userMFA.IsEnabled():
+		err = s.verifySecondFactor(ctx, user, userMFA, request.Code, request.RecoveryCode, request.Device)
+	case claims.EnrollmentRequired:
+		// The sign-in has no session yet, so every earlier session is signed out
+		recoveryCodes, err = s.confirmMFA(ctx, user, userMFA, request.Code, "", request.Device)
+	default:
+		// The authenticator was removed after the challenge was issued
+		err = errors.NewAuthenticationError("invalid or expired sign-in challenge, sign in again", nil)
+	}
+	if err != nil {
+		return
+	}
+
+	res, err = s.issueSession(ctx, user, claims.DomainID, claims.Scopes, request.Device)
+	if err != nil {
+		return
+	}
+
+	res.RecoveryCodes = recoveryCodes
+	return
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/request"
+	"github.com/PhantomX7/dhamma/modules/auth/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/recoverycode"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+	"github.com/PhantomX7/dhamma/utility/totp"
+)
+
+// enableMFA gives user a confirmed authenticator and returns its secret and recovery codes.
+func (f authFixture) enableMFA(t *testing.T, user entity.User) (secret string, recoveryCodes []string) {
+	t.Helper()
+
+	secret, err := totp.NewSecret()
+	require.NoError(t, err)
+	require.NoError(t, f.db.Create(&entity.UserMFA{UserID: user.ID, Secret: secret, EnabledAt: utility.PointOf(time.Now())}).Error)
+
+	recoveryCodes, err = recoverycode.Generate()
+	require.NoError(t, err)
+	for _, recoveryCode := range recoveryCodes {
+		require.NoError(t, f.db.Create(&entity.UserRecoveryCode{UserID: user.ID, CodeHash: recoverycode.Hash(recoveryCode)}).Error)
+	}
+	return
+}
+
+// domainUser creates a user of a domain holding a role that requires an authenticator or not.
+func (f authFixture) domainUser(t *testing.T, requireMFA bool) (user entity.User, domain entity.Domain, role entity.Role) {
+	t.Helper()
+
+	domain = testdb.Domain(t, f.db)
+
+	user = entity.User{Username: "budi", Password: f.user.Password, IsActive: true}
+	require.NoError(t, f.db.Create(&user).Error)
+	require.NoError(t, f.db.Create(&entity.UserDomain{UserID: user.ID, DomainID: domain.ID}).Error)
+
+	role = entity.Role{DomainID: domain.ID, Name: "Treasurer", IsActive: true, RequireMFA: requireMFA}
+	require.NoError(t, f.db.Create(&role).Error)
+	require.NoError(t, f.db.Create(&entity.UserRole{UserID: user.ID, DomainID: domain.ID, RoleID: role.ID}).Error)
+	return
+}
+
+// challenge signs the root user in and returns the MFA challenge token.
+func (f authFixture) challenge(t *testing.T) string {
+	t.Helper()
+
+	res, err := f.service.SignIn(context.Background(), request.SignInRequest{Username: f.user.Username, Password: authPassword})
+	require.NoError(t, err)
+	require.NotNil(t, res.MFA)
+	assert.Empty(t, res.AccessToken)
+	assert.Empty(t, res.RefreshToken)
+	return res.MFA.Token
+}
+
+// sessionContext returns the context of a request signed in with refreshToken's session.
+func (f authFixture) sessionContext(t *testing.T, user entity.User, refreshToken string) context.Context {
+	t.Helper()
+
+	session, err := f.service.(*service).parseRefreshToken(context.Background(), refreshToken, request.Device{})
+	require.NoError(t, err)
+	return utility.NewContextWithValues(context.Background(), utility.ContextValues{UserID: user.ID, SessionID: session.FamilyID.String()})
+}
+
+func assertStatus(t *testing.T, status int, err error) {
+	t.Helper()
+
+	var appErr *errors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, status, appErr.Status, appErr.Message)
+}
+
+func TestVerifyMFA_AuthenticatorCodeCannotBeReplayed(t *testing.T) {
+	f := newAuthFixture(t)
+	secret, _ := f.enableMFA(t, f.user)
+
+	code, err := totp.Generate(secret, time.Now())
+	require.NoError(t, err)
+
+	res, err := f.service.VerifyMFA(context.Background(), request.MFAVerifyRequest{MFAToken: f.challenge(t), Code: code})
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.AccessToken)
+	assert.NotEmpty(t, res.RefreshToken)
+	assert.Empty(t, res.RecoveryCodes)
+
+	_, err = f.service.VerifyMFA(context.Background(), request.MFAVerifyRequest{MFAToken: f.challenge(t), Code: code})
+	assertStatus(t, http.StatusUnauthorized, err)
+
+	// The code of the next step is still accepted
+	next, err := totp.Generate(secret, time.Now().Add(totp.Period))
+	require.NoError(t, err)
+	_, err = f.service.VerifyMFA(context.Background(), request.MFAVerifyRequest{MFAToken: f.challenge(t), Code: next})
+	require.NoError(t, err)
+}
+
+func TestVerifyMFA_RecoveryCodeWorksOnce(t *testing.T) {
+	f := newAuthFixture(t)
+	_, recoveryCodes := f.enableMFA(t, f.user)
+
+	_, err := f.service.VerifyMFA(context.Background(), request.MFAVerifyRequest{MFAToken: f.challenge(t), RecoveryCode: recoveryCodes[0]})
+	require.NoError(t, err)
+
+	_, err = f.service.VerifyMFA(context.Background(), request.MFAVerifyRequest{MFAToken: f.challenge(t), RecoveryCode: recoveryCodes[0]})
+	assertStatus(t, http.StatusUnauthorized, err)
+
+	var remaining, events int64
+	require.NoError(t, f.db.Model(&entity.UserRecoveryCode{}).Where("user_id = ? AND used_at IS NULL", f.user.ID).Count(&remaining).Error)
+	assert.Equal(t, int64(len(recoveryCodes)-1), remaining)
+	require.NoError(t, f.db.Model(&entity.SecurityEvent{}).Where("type = ?", entity.SecurityEventTypeMFARecoveryCodeUsed).Count(&events).Error)
+	assert.Equal(t, int64(1), events)
+}
+
+func TestVerifyMFA_EnrollmentRequiredByTheDomain(t *testing.T) {
+	f := newAuthFixture(t)
+	user, domain, _ := f.domainUser(t, true)
+
+	res, err := f.service.SignInWithDomain(context.Background(), request.SignInRequest{Username: user.Username, Password: authPassword}, domain.Code)
+	require.NoError(t, err)
+	require.NotNil(t, res.MFA)
+	assert.True(t, res.MFA.EnrollmentRequired)
+	assert.Empty(t, res.RefreshToken)
+
+	// An authenticator has to be set up before the challenge can be answered
+	_, err = f.service.VerifyMFA(context.Background(), request.MFAVerifyRequest{MFAToken: res.MFA.Token, Code: "000000"})
+	assertStatus(t, http.StatusBadRequest, err)
+
+	setup, err := f.service.SetupMFAChallenge(context.Background(), request.MFAChallengeSetupRequest{MFAToken: res.MFA.Token})
+	require.NoError(t, err)
+
+	code, err := totp.Generate(setup.Secret, time.Now())
+	require.NoError(t, err)
+
+	var verified response.AuthResponse
+	verified, err = f.service.VerifyMFA(context.Background(), request.MFAVerifyRequest{MFAToken: res.MFA.Token, Code: code})
+	require.NoError(t, err)
+	assert.NotEmpty(t, verified.RefreshToken)
+	assert.NotEmpty(t, verified.RecoveryCodes)
+
+	var userMFA entity.UserMFA
+	require.NoError(t, f.db.Where("user_id = ?", user.ID).First(&userMFA).Error)
+	assert.True(t, userMFA.IsEnabled())
+}
+
+func TestMFAChanges_SignOutOtherSessions(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(t *testing.T, f authFixture, ctx context.Context) error
+	}{
+		{
+			name: "enable",
+			change: func(t *testing.T, f authFixture, ctx context.Context) error {
+				setup, err := f.service.SetupMFA(ctx)
+				require.NoError(t, err)
+				code, err := totp.Generate(setup.Secret, time.Now())
+				require.NoError(t, err)
+
+				_, err = f.service.EnableMFA(ctx, request.MFACodeRequest{Code: code})
+				return err
+			},
+		},
+		{
+			name: "regenerate recovery codes",
+			change: func(t *testing.T, f authFixture, ctx context.Context) error {
+				secret, _ := f.enableMFA(t, f.user)
+				code, err := totp.Generate(secret, time.Now())
+				require.NoError(t, err)
+
+				_, err = f.service.RegenerateRecoveryCodes(ctx, request.MFACodeRequest{Code: code})
+				return err
+			},
+		},
+		{
+			name: "disable",
+			change: func(t *testing.T, f authFixture, ctx context.Context) error {
+				secret, _ := f.enableMFA(t, f.user)
+				code, err := totp.Generate(secret, time.Now())
+				require.NoError(t, err)
+
+				return f.service.DisableMFA(ctx, request.MFADisableRequest{Password: authPassword, Code: code})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuthFixture(t)
+			current := f.signIn(t)
+			other := f.signIn(t)
+
+			require.NoError(t, tt.change(t, f, f.sessionContext(t, f.user, current)))
+
+			_, err := f.refresh(current)
+			require.NoError(t, err)
+			_, err = f.refresh(other)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRefresh_RefusedOnceTheDomainRequiresMFA(t *testing.T) {
+	f := newAuthFixture(t)
+	user, domain, role := f.domainUser(t, false)
+
+	res, err := f.service.SignInWithDomain(context.Background(), request.SignInRequest{Username: user.Username, Password: authPassword}, domain.Code)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.RefreshToken)
+
+	require.NoError(t, f.db.Model(&role).Update("require_mfa", true).Error)
+
+	_, err = f.refresh(res.RefreshToken)
+	assertStatus(t, http.StatusForbidden, err)
+}
@@ -0,0 +1,44 @@
+package blood_donation
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/blood_donation/dto/request"
+	"github.com/PhantomX7/dhamma/modules/blood_donation/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.BloodDonation]
+	// CountByFollowerBetween counts the follower's donations made strictly between from and to.
+	CountByFollowerBetween(ctx context.Context, followerID uint64, from time.Time, to time.Time, tx *gorm.DB) (int64, error)
+	// FindLatestByFollowerIDs returns the latest donation of each follower, keyed by follower ID.
+	FindLatestByFollowerIDs(ctx context.Context, followerIDs []uint64) (map[uint64]entity.BloodDonation, error)
+	// ReassignFollower moves every donation of fromFollowerID to toFollowerID and returns how many were moved.
+	ReassignFollower(ctx context.Context, fromFollowerID uint64, toFollowerID uint64, tx *gorm.DB) (int64, error)
+	// SoftDelete soft-deletes the donation, reporting false if it was already deleted.
+	SoftDelete(ctx context.Context, bloodDonationID uint64, at time.Time, tx *gorm.DB) (bool, error)
+}
+
+type Service interface {
+	Index(ctx context.Context, pg *pagination.Pagination) ([]entity.BloodDonation, utility.PaginationMeta, error)
+	Show(ctx context.Context, bloodDonationID uint64) (entity.BloodDonation, error)
+	Create(ctx context.Context, req request.BloodDonationCreateRequest) (entity.BloodDonation, error)
+	Delete(ctx context.Context, bloodDonationID uint64) error
+	Eligible(ctx context.Context, pg *pagination.Pagination, req request.BloodDonationEligibleRequest) ([]response.EligibleDonor, utility.PaginationMeta, error)
+}
+
+type Controller interface {
+	Index(ctx *gin.Context)
+	Show(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Eligible(ctx *gin.Context)
+}
@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"github.com/PhantomX7/dhamma/modules/blood_donation"
+)
+
+type controller struct {
+	bloodDonationService blood_donation.Service
+}
+
+func New(bloodDonationService blood_donation.Service) blood_donation.Controller {
+	return &controller{
+		bloodDonationService: bloodDonationService,
+	}
+}
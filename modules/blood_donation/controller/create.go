@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/blood_donation/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+func (c *controller) Create(ctx *gin.Context) {
+	var req request.BloodDonationCreateRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.bloodDonationService.Create(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+func (c *controller) Delete(ctx *gin.Context) {
+	bloodDonationID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid blood donation id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	err = c.bloodDonationService.Delete(ctx.Request.Context(), bloodDonationID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", nil))
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/blood_donation/dto/request"
+	followerRequest "github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Eligible lists the blood donors who may donate again, filtered like the follower list.
+// Expected route: GET /blood-donation/eligible?blood_type=O%2B&limit=20
+func (c *controller) Eligible(ctx *gin.Context) {
+	var req request.BloodDonationEligibleRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, meta, err := c.bloodDonationService.Eligible(ctx.Request.Context(), followerRequest.NewFollowerPagination(ctx.Request.URL.Query()), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildPaginationResponseSuccess("ok", res, meta))
+}
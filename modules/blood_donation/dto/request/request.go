@@ -0,0 +1,74 @@
+package request
+
+import (
+	"time"
+
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// BloodDonationCreateRequest defines the payload for recording a blood donation.
+type BloodDonationCreateRequest struct {
+	FollowerID uint64    `json:"follower_id" form:"follower_id" binding:"required,exist=followers.id"`
+	DonatedAt  time.Time `json:"donated_at" form:"donated_at" binding:"required"`
+	Location   string    `json:"location" form:"location" binding:"required,max=255"`
+	BloodType  string    `json:"blood_type" form:"blood_type" binding:"required,oneof=A+ A- B+ B- AB+ AB- O+ O-"`
+	Points     int       `json:"points" form:"points" binding:"omitempty,min=0,max=1000"` // Points to award for the donation
+	Note       *string   `json:"note" form:"note" binding:"omitempty,max=255"`
+}
+
+// BloodDonationEligibleRequest narrows the eligible donors to a blood type.
+type BloodDonationEligibleRequest struct {
+	BloodType string `json:"blood_type" form:"blood_type" binding:"omitempty,oneof=A+ A- B+ B- AB+ AB- O+ O-"`
+}
+
+func NewBloodDonationPagination(conditions map[string][]string) *pagination.Pagination {
+	filterDef := pagination.NewFilterDefinition().
+		AddFilter("follower_id", pagination.FilterConfig{
+			TableName: "blood_donations",
+			Field:     "follower_id",
+			Type:      pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("blood_type", pagination.FilterConfig{
+			TableName:  "blood_donations",
+			Field:      "blood_type",
+			Type:       pagination.FilterTypeEnum,
+			EnumValues: []string{"A+", "A-", "B+", "B-", "AB+", "AB-", "O+", "O-"},
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorEquals, pagination.OperatorIn,
+			},
+		}).
+		AddFilter("location", pagination.FilterConfig{
+			TableName: "blood_donations",
+			Field:     "location",
+			Type:      pagination.FilterTypeString,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals, pagination.OperatorLike,
+			},
+		}).
+		AddFilter("donated_at", pagination.FilterConfig{
+			TableName: "blood_donations",
+			Field:     "donated_at",
+			Type:      pagination.FilterTypeDate,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorBetween, pagination.OperatorEquals, pagination.OperatorGte, pagination.OperatorLte,
+			},
+		}).
+		AddSort("donated_at", pagination.SortConfig{
+			TableName: "blood_donations",
+			Field:     "donated_at",
+			Allowed:   true,
+		})
+
+	return pagination.NewPagination(
+		conditions,
+		filterDef,
+		pagination.PaginationOptions{
+			DefaultLimit: 20,
+			MaxLimit:     100,
+			DefaultOrder: "donated_at desc",
+		},
+	)
+}
@@ -0,0 +1,9 @@
+package response
+
+import "github.com/PhantomX7/dhamma/entity"
+
+// EligibleDonor is a blood donor who may donate again, with their latest donation if one was recorded.
+type EligibleDonor struct {
+	entity.Follower
+	LastDonation *entity.BloodDonation `json:"last_donation"`
+}
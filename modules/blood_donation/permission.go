@@ -0,0 +1,24 @@
+package blood_donation
+
+type permission struct {
+	Key string
+	// Index all blood donations
+	Index string
+	// View blood donation details
+	Show string
+	// Record a blood donation
+	Create string
+	// Delete a blood donation recorded by mistake
+	Delete string
+	// List the blood donors who may donate again
+	Eligible string
+}
+
+var Permissions = permission{
+	Key:      "blood-donation",
+	Index:    "index",
+	Show:     "show",
+	Create:   "create",
+	Delete:   "delete",
+	Eligible: "eligible",
+}
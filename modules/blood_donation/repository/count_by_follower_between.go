@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// CountByFollowerBetween counts the follower's donations made strictly between from and to.
+func (r *repository) CountByFollowerBetween(ctx context.Context, followerID uint64, from time.Time, to time.Time, tx *gorm.DB) (int64, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	var count int64
+	err := db.WithContext(ctx).
+		Model(&entity.BloodDonation{}).
+		Where("follower_id = ? AND donated_at > ? AND donated_at < ?", followerID, from, to).
+		Count(&count).Error
+
+	return count, err
+}
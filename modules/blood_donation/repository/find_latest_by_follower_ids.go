@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindLatestByFollowerIDs returns the latest donation of each follower, keyed by follower ID.
+// Followers without donations are absent from the map.
+func (r *repository) FindLatestByFollowerIDs(ctx context.Context, followerIDs []uint64) (map[uint64]entity.BloodDonation, error) {
+	latest := make(map[uint64]entity.BloodDonation)
+	if len(followerIDs) == 0 {
+		return latest, nil
+	}
+
+	var donations []entity.BloodDonation
+	err := r.db.WithContext(ctx).
+		Where("follower_id IN ?", followerIDs).
+		Order("donated_at desc, id desc").
+		Find(&donations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, donation := range donations {
+		if _, ok := latest[donation.FollowerID]; !ok {
+			latest[donation.FollowerID] = donation
+		}
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/blood_donation"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.BloodDonation] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new blood_donation repository instance.
+func New(db *gorm.DB) blood_donation.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.BloodDonation](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all blood_donation entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.BloodDonation, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a blood_donation entity by its ID.
+func (r *repository) FindByID(ctx context.Context, bloodDonationID uint64, preloads ...string) (entity.BloodDonation, error) {
+	return r.base.FindByID(ctx, bloodDonationID, preloads...)
+}
+
+// Create creates a new blood_donation entity.
+func (r *repository) Create(ctx context.Context, bloodDonation *entity.BloodDonation, tx *gorm.DB) error {
+	return r.base.Create(ctx, bloodDonation, tx)
+}
+
+// Update updates an existing blood_donation entity.
+func (r *repository) Update(ctx context.Context, bloodDonation *entity.BloodDonation, tx *gorm.DB) error {
+	return r.base.Update(ctx, bloodDonation, tx)
+}
+
+// Delete deletes a blood_donation entity.
+func (r *repository) Delete(ctx context.Context, bloodDonation *entity.BloodDonation, tx *gorm.DB) error {
+	return r.base.Delete(ctx, bloodDonation, tx)
+}
+
+// Count counts blood_donation entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves blood_donation entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.BloodDonation, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single blood_donation entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.BloodDonation, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves blood_donation entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.BloodDonation, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single blood_donation entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.BloodDonation, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any blood_donation records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// SoftDelete soft-deletes the donation with a single conditional UPDATE.
+// It reports false when the donation was already deleted, so concurrent deletes are applied only once.
+func (r *repository) SoftDelete(ctx context.Context, bloodDonationID uint64, at time.Time, tx *gorm.DB) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&entity.BloodDonation{}).
+		Where("id = ? AND deleted_at IS NULL", bloodDonationID).
+		UpdateColumns(map[string]interface{}{
+			"updated_at": at,
+			"deleted_at": at,
+		})
+
+	return result.RowsAffected > 0, result.Error
+}
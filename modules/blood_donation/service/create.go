@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/blood_donation/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Create records a blood donation and flags the follower as a blood donor. The follower becomes
+// eligible again once the domain's donation interval has passed, and a donation within that
+// interval of another one is rejected. Points, when requested, are awarded in the same transaction.
+func (s *service) Create(ctx context.Context, req request.BloodDonationCreateRequest) (donation entity.BloodDonation, err error) {
+	follower, err := s.followerRepo.FindByID(ctx, req.FollowerID, "Domain")
+	if err != nil {
+		return
+	}
+
+	contextValues, err := utility.CheckDomainContext(ctx, follower.DomainID, "blood donation", "create")
+	if err != nil {
+		return
+	}
+
+	if req.DonatedAt.After(time.Now()) {
+		return donation, &errors.AppError{
+			Message: "donation date cannot be in the future",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	intervalDays := entity.DefaultBloodDonationIntervalDays
+	location := utility.LoadLocation(utility.DefaultTimezone)
+	if follower.Domain != nil {
+		location = follower.Domain.Location()
+		if follower.Domain.BloodDonationIntervalDays > 0 {
+			intervalDays = follower.Domain.BloodDonationIntervalDays
+		}
+	}
+
+	// Days are added in the domain's timezone so the interval keeps its length across DST changes
+	donatedAt := req.DonatedAt.In(location)
+	nextEligibleAt := donatedAt.AddDate(0, 0, intervalDays)
+
+	tooSoon := &errors.AppError{
+		Message: fmt.Sprintf("follower has another donation within %d days of this date", intervalDays),
+		Status:  http.StatusBadRequest,
+	}
+
+	donation = entity.BloodDonation{
+		DomainID:       follower.DomainID,
+		FollowerID:     follower.ID,
+		DonatedAt:      req.DonatedAt,
+		Location:       req.Location,
+		BloodType:      req.BloodType,
+		NextEligibleAt: nextEligibleAt,
+		Points:         req.Points,
+		Note:           req.Note,
+		RecordedBy:     contextValues.UserID,
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		// Lock the follower so concurrent donations of the same follower run the interval check one at a time
+		if _, err := s.followerRepo.FindByIDForUpdate(ctx, follower.ID, tx); err != nil {
+			return err
+		}
+
+		nearby, err := s.bloodDonationRepo.CountByFollowerBetween(ctx, follower.ID, donatedAt.AddDate(0, 0, -intervalDays), nextEligibleAt, tx)
+		if err != nil {
+			return err
+		}
+		if nearby > 0 {
+			return tooSoon
+		}
+
+		if err := s.bloodDonationRepo.Create(ctx, &donation, tx); err != nil {
+			return err
+		}
+
+		if !follower.IsBloodDonor {
+			if err := s.followerRepo.MarkBloodDonor(ctx, follower.ID, tx); err != nil {
+				return err
+			}
+		}
+
+		if donation.Points == 0 {
+			return nil
+		}
+
+		if _, err := s.followerRepo.IncrementPoints(ctx, follower.ID, donation.Points, tx); err != nil {
+			return err
+		}
+
+		pointMutation := entity.PointMutation{
+			FollowerID:  follower.ID,
+			Amount:      donation.Points,
+			SourceType:  entity.PointMutationSourceTypeBloodDonation,
+			SourceID:    &donation.ID,
+			Description: utility.PointOf(fmt.Sprintf("Blood donation at %s", donation.Location)),
+		}
+		return s.pointMutationRepo.Create(ctx, &pointMutation, tx)
+	})
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/blood_donation"
+	"github.com/PhantomX7/dhamma/modules/blood_donation/dto/request"
+	bloodDonationRepo "github.com/PhantomX7/dhamma/modules/blood_donation/repository"
+	"github.com/PhantomX7/dhamma/modules/follower"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	pointMutationRepo "github.com/PhantomX7/dhamma/modules/point_mutation/repository"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// barrierFollowerRepo holds every FindByID until all expected callers have read the follower,
+// so each concurrent donation starts before any of them is recorded.
+type barrierFollowerRepo struct {
+	follower.Repository
+	readers *sync.WaitGroup
+}
+
+func (r barrierFollowerRepo) FindByID(ctx context.Context, id uint64, preloads ...string) (entity.Follower, error) {
+	follower, err := r.Repository.FindByID(ctx, id, preloads...)
+	r.readers.Done()
+	r.readers.Wait()
+	return follower, err
+}
+
+// slowBloodDonationRepo holds every insert for a moment, so a concurrent donation checking the interval
+// outside the inserting transaction would not see it yet.
+type slowBloodDonationRepo struct {
+	blood_donation.Repository
+}
+
+func (r slowBloodDonationRepo) Create(ctx context.Context, donation *entity.BloodDonation, tx *gorm.DB) error {
+	time.Sleep(50 * time.Millisecond)
+	return r.Repository.Create(ctx, donation, tx)
+}
+
+// bloodDonationFixture is a follower of a domain with a 60 day donation interval.
+type bloodDonationFixture struct {
+	db       *gorm.DB
+	follower entity.Follower
+	ctx      context.Context
+}
+
+func newBloodDonationFixture(t *testing.T, points int) bloodDonationFixture {
+	db := testdb.New(t, &entity.Domain{}, &entity.Follower{}, &entity.BloodDonation{}, &entity.PointMutation{})
+
+	domain := testdb.Domain(t, db, func(domain *entity.Domain) {
+		domain.BloodDonationIntervalDays = 60
+	})
+
+	return bloodDonationFixture{
+		db:       db,
+		follower: testdb.Follower(t, db, domain.ID, testdb.WithPoints(points)),
+		ctx:      testdb.Context(domain),
+	}
+}
+
+func (f bloodDonationFixture) service(followers follower.Repository) blood_donation.Service {
+	return f.serviceWith(bloodDonationRepo.New(f.db), followers)
+}
+
+func (f bloodDonationFixture) serviceWith(donations blood_donation.Repository, followers follower.Repository) blood_donation.Service {
+	return New(donations, followers, nil, pointMutationRepo.New(f.db), transaction_manager.New(f.db))
+}
+
+func (f bloodDonationFixture) donation(donatedAt time.Time, points int) request.BloodDonationCreateRequest {
+	return request.BloodDonationCreateRequest{
+		FollowerID: f.follower.ID,
+		DonatedAt:  donatedAt,
+		Location:   "PMI",
+		BloodType:  "O+",
+		Points:     points,
+	}
+}
+
+// assertDonationState checks the follower's points and how many donations and mutations it has.
+func assertDonationState(t *testing.T, f bloodDonationFixture, points int, donations int64, mutations int64) {
+	t.Helper()
+
+	var reloaded entity.Follower
+	require.NoError(t, f.db.First(&reloaded, f.follower.ID).Error)
+	assert.Equal(t, points, reloaded.Points)
+
+	var count int64
+	require.NoError(t, f.db.Model(&entity.BloodDonation{}).Where("follower_id = ?", f.follower.ID).Count(&count).Error)
+	assert.Equal(t, donations, count)
+
+	require.NoError(t, f.db.Model(&entity.PointMutation{}).
+		Where("follower_id = ? AND source_type = ?", f.follower.ID, entity.PointMutationSourceTypeBloodDonation).
+		Count(&count).Error)
+	assert.Equal(t, mutations, count)
+}
+
+func assertBadRequest(t *testing.T, err error) {
+	t.Helper()
+
+	var appErr *errors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusBadRequest, appErr.Status)
+}
+
+func TestCreate_AwardsPointsAndMarksDonor(t *testing.T) {
+	f := newBloodDonationFixture(t, 3)
+	s := f.service(followerRepo.New(f.db))
+
+	donatedAt := time.Now().Add(-time.Hour)
+	donation, err := s.Create(f.ctx, f.donation(donatedAt, 10))
+	require.NoError(t, err)
+	assert.True(t, donatedAt.AddDate(0, 0, 60).Equal(donation.NextEligibleAt))
+
+	assertDonationState(t, f, 13, 1, 1)
+
+	var mutation entity.PointMutation
+	require.NoError(t, f.db.Where("source_id = ?", donation.ID).First(&mutation).Error)
+	assert.Equal(t, 10, mutation.Amount)
+
+	var reloaded entity.Follower
+	require.NoError(t, f.db.First(&reloaded, f.follower.ID).Error)
+	assert.True(t, reloaded.IsBloodDonor)
+}
+
+func TestCreate_WithoutPointsRecordsNoMutation(t *testing.T) {
+	f := newBloodDonationFixture(t, 3)
+	s := f.service(followerRepo.New(f.db))
+
+	_, err := s.Create(f.ctx, f.donation(time.Now().Add(-time.Hour), 0))
+	require.NoError(t, err)
+
+	assertDonationState(t, f, 3, 1, 0)
+}
+
+func TestCreate_DonationWithinIntervalIsRefused(t *testing.T) {
+	f := newBloodDonationFixture(t, 0)
+	s := f.service(followerRepo.New(f.db))
+
+	first := time.Now().AddDate(0, 0, -100)
+	_, err := s.Create(f.ctx, f.donation(first, 5))
+	require.NoError(t, err)
+
+	// Too soon after the first donation, and too soon before it
+	_, err = s.Create(f.ctx, f.donation(first.AddDate(0, 0, 59), 5))
+	assertBadRequest(t, err)
+	_, err = s.Create(f.ctx, f.donation(first.AddDate(0, 0, -59), 5))
+	assertBadRequest(t, err)
+
+	assertDonationState(t, f, 5, 1, 1)
+
+	_, err = s.Create(f.ctx, f.donation(first.AddDate(0, 0, 60), 5))
+	require.NoError(t, err)
+
+	assertDonationState(t, f, 10, 2, 2)
+}
+
+func TestCreate_FutureDonationIsRefused(t *testing.T) {
+	f := newBloodDonationFixture(t, 0)
+	s := f.service(followerRepo.New(f.db))
+
+	_, err := s.Create(f.ctx, f.donation(time.Now().Add(time.Hour), 5))
+	assertBadRequest(t, err)
+
+	assertDonationState(t, f, 0, 0, 0)
+}
+
+func TestCreate_ConcurrentDonationsRecordOnce(t *testing.T) {
+	const donations = 5
+
+	f := newBloodDonationFixture(t, 0)
+	readers := &sync.WaitGroup{}
+	readers.Add(donations)
+	s := f.serviceWith(
+		slowBloodDonationRepo{Repository: bloodDonationRepo.New(f.db)},
+		barrierFollowerRepo{Repository: followerRepo.New(f.db), readers: readers},
+	)
+
+	donatedAt := time.Now().Add(-time.Hour)
+	var wg sync.WaitGroup
+	errs := make([]error, donations)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.Create(f.ctx, f.donation(donatedAt.Add(-time.Duration(i)*time.Minute), 5))
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assertBadRequest(t, err)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	assertDonationState(t, f, 5, 1, 1)
+}
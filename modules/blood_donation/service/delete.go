@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Delete removes a donation recorded by mistake. The points it awarded are taken back
+// with a negative point mutation against the same source in the same transaction.
+// The soft delete is conditional, so only one of several concurrent deletes reverses the points.
+func (s *service) Delete(ctx context.Context, bloodDonationID uint64) (err error) {
+	donation, err := s.bloodDonationRepo.FindByID(ctx, bloodDonationID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, donation.DomainID, "blood donation", "delete")
+	if err != nil {
+		return
+	}
+
+	alreadyDeleted := &errors.AppError{
+		Message: "blood donation is already deleted",
+		Status:  http.StatusConflict,
+	}
+	insufficientPoints := &errors.AppError{
+		Message: "follower no longer has enough points to reverse this donation",
+		Status:  http.StatusBadRequest,
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		deleted, err := s.bloodDonationRepo.SoftDelete(ctx, donation.ID, time.Now(), tx)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return alreadyDeleted
+		}
+
+		awarded, err := s.pointMutationRepo.SumBySource(ctx, entity.PointMutationSourceTypeBloodDonation, donation.ID, tx)
+		if err != nil {
+			return err
+		}
+
+		if awarded != 0 {
+			applied, err := s.followerRepo.IncrementPoints(ctx, donation.FollowerID, -awarded, tx)
+			if err != nil {
+				return err
+			}
+			if !applied {
+				return insufficientPoints
+			}
+
+			pointMutation := entity.PointMutation{
+				FollowerID:  donation.FollowerID,
+				Amount:      -awarded,
+				SourceType:  entity.PointMutationSourceTypeBloodDonation,
+				SourceID:    &donation.ID,
+				Description: utility.PointOf("Blood donation deleted"),
+			}
+			if err = s.pointMutationRepo.Create(ctx, &pointMutation, tx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/blood_donation"
+	bloodDonationRepo "github.com/PhantomX7/dhamma/modules/blood_donation/repository"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// barrierBloodDonationRepo holds every FindByID until all expected callers have read the donation,
+// so each concurrent delete starts from the same, not yet deleted, donation.
+type barrierBloodDonationRepo struct {
+	blood_donation.Repository
+	readers *sync.WaitGroup
+}
+
+func (r barrierBloodDonationRepo) FindByID(ctx context.Context, id uint64, preloads ...string) (entity.BloodDonation, error) {
+	donation, err := r.Repository.FindByID(ctx, id, preloads...)
+	r.readers.Done()
+	r.readers.Wait()
+	return donation, err
+}
+
+func TestDelete_ReversesPoints(t *testing.T) {
+	f := newBloodDonationFixture(t, 2)
+	s := f.service(followerRepo.New(f.db))
+
+	donation, err := s.Create(f.ctx, f.donation(time.Now().Add(-time.Hour), 10))
+	require.NoError(t, err)
+
+	require.NoError(t, s.Delete(f.ctx, donation.ID))
+
+	assertDonationState(t, f, 2, 0, 2)
+}
+
+func TestDelete_InsufficientPointsKeepsDonation(t *testing.T) {
+	f := newBloodDonationFixture(t, 0)
+	s := f.service(followerRepo.New(f.db))
+
+	donation, err := s.Create(f.ctx, f.donation(time.Now().Add(-time.Hour), 10))
+	require.NoError(t, err)
+	require.NoError(t, f.db.Model(&entity.Follower{}).Where("id = ?", f.follower.ID).Update("points", 4).Error)
+
+	err = s.Delete(f.ctx, donation.ID)
+	assertBadRequest(t, err)
+
+	assertDonationState(t, f, 4, 1, 1)
+}
+
+func TestDelete_ConcurrentDeletesReverseOnce(t *testing.T) {
+	const deletes = 2
+
+	f := newBloodDonationFixture(t, 30)
+	donation, err := f.service(followerRepo.New(f.db)).Create(f.ctx, f.donation(time.Now().Add(-time.Hour), 10))
+	require.NoError(t, err)
+
+	readers := &sync.WaitGroup{}
+	readers.Add(deletes)
+	s := f.serviceWith(barrierBloodDonationRepo{Repository: bloodDonationRepo.New(f.db), readers: readers}, followerRepo.New(f.db))
+
+	var wg sync.WaitGroup
+	errs := make([]error, deletes)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = s.Delete(f.ctx, donation.ID)
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		var appErr *errors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, http.StatusConflict, appErr.Status)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	assertDonationState(t, f, 30, 0, 2)
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/modules/blood_donation/dto/request"
+	"github.com/PhantomX7/dhamma/modules/blood_donation/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// Eligible lists the blood donors who may donate again: followers flagged as blood donors
+// without a donation whose interval is still running. The follower list filters apply as usual,
+// and req.BloodType keeps the donors who donated that blood type before.
+func (s *service) Eligible(ctx context.Context, pg *pagination.Pagination, req request.BloodDonationEligibleRequest) (
+	donors []response.EligibleDonor, meta utility.PaginationMeta, err error,
+) {
+	now := time.Now()
+	pg.AddCustomScope(func(db *gorm.DB) *gorm.DB {
+		db = db.
+			Where("followers.is_blood_donor = ?", true).
+			Where(
+				"NOT EXISTS (SELECT 1 FROM blood_donations WHERE blood_donations.follower_id = followers.id AND blood_donations.next_eligible_at > ? AND blood_donations.deleted_at IS NULL)",
+				now,
+			)
+		if req.BloodType != "" {
+			db = db.Where(
+				"EXISTS (SELECT 1 FROM blood_donations WHERE blood_donations.follower_id = followers.id AND blood_donations.blood_type = ? AND blood_donations.deleted_at IS NULL)",
+				req.BloodType,
+			)
+		}
+		return db
+	})
+
+	followers, meta, err := s.followerService.Index(ctx, pg)
+	if err != nil {
+		return
+	}
+
+	followerIDs := make([]uint64, 0, len(followers))
+	for _, follower := range followers {
+		followerIDs = append(followerIDs, follower.ID)
+	}
+
+	latest, err := s.bloodDonationRepo.FindLatestByFollowerIDs(ctx, followerIDs)
+	if err != nil {
+		return
+	}
+
+	donors = make([]response.EligibleDonor, 0, len(followers))
+	for _, follower := range followers {
+		donor := response.EligibleDonor{Follower: follower}
+		if donation, ok := latest[follower.ID]; ok {
+			donor.LastDonation = &donation
+		}
+		donors = append(donors, donor)
+	}
+
+	return
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/blood_donation"
+	"github.com/PhantomX7/dhamma/modules/follower"
+	"github.com/PhantomX7/dhamma/modules/point_mutation"
+)
+
+type service struct {
+	bloodDonationRepo  blood_donation.Repository
+	followerRepo       follower.Repository
+	followerService    follower.Service
+	pointMutationRepo  point_mutation.Repository
+	transactionManager transaction_manager.Client
+}
+
+func New(
+	bloodDonationRepo blood_donation.Repository,
+	followerRepo follower.Repository,
+	followerService follower.Service,
+	pointMutationRepo point_mutation.Repository,
+	transactionManager transaction_manager.Client,
+) blood_donation.Service {
+	return &service{
+		bloodDonationRepo:  bloodDonationRepo,
+		followerRepo:       followerRepo,
+		followerService:    followerService,
+		pointMutationRepo:  pointMutationRepo,
+		transactionManager: transactionManager,
+	}
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Show implements blood_donation.Service
+func (s *service) Show(ctx context.Context, bloodDonationID uint64) (donation entity.BloodDonation, err error) {
+	donation, err = s.bloodDonationRepo.FindByID(ctx, bloodDonationID, "Follower")
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, donation.DomainID, "blood donation", "show")
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,41 @@
+package campaign
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/campaign/dto/request"
+	"github.com/PhantomX7/dhamma/modules/campaign/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.Campaign]
+	// FindDue returns the scheduled campaigns whose scheduled time is at or before now.
+	FindDue(ctx context.Context, now time.Time) ([]entity.Campaign, error)
+	// TransitionStatus moves a campaign from one status to another and reports false if it was not in the from status.
+	TransitionStatus(ctx context.Context, campaignID uint64, from string, to string, tx *gorm.DB) (bool, error)
+}
+
+type Service interface {
+	Index(ctx context.Context, pg *pagination.Pagination) ([]entity.Campaign, utility.PaginationMeta, error)
+	Show(ctx context.Context, campaignID uint64) (response.CampaignResponse, error)
+	Create(ctx context.Context, req request.CampaignCreateRequest) (entity.Campaign, error)
+	Update(ctx context.Context, campaignID uint64, req request.CampaignUpdateRequest) (entity.Campaign, error)
+	Cancel(ctx context.Context, campaignID uint64) (entity.Campaign, error)
+	RunDue(ctx context.Context) (int, error)
+}
+
+type Controller interface {
+	Index(ctx *gin.Context)
+	Show(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Cancel(ctx *gin.Context)
+}
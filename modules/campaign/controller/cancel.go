@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Cancel stops a scheduled campaign before it runs.
+// Expected route: POST /campaign/:id/cancel
+func (c *controller) Cancel(ctx *gin.Context) {
+	campaignID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid campaign id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	res, err := c.campaignService.Cancel(ctx.Request.Context(), campaignID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
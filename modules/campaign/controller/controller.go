@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"github.com/PhantomX7/dhamma/modules/campaign"
+)
+
+type controller struct {
+	campaignService campaign.Service
+}
+
+func New(campaignService campaign.Service) campaign.Controller {
+	return &controller{
+		campaignService: campaignService,
+	}
+}
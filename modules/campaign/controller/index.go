@@ -0,0 +1,20 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/campaign/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+func (c *controller) Index(ctx *gin.Context) {
+	res, meta, err := c.campaignService.Index(ctx.Request.Context(), request.NewCampaignPagination(ctx.Request.URL.Query()))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildPaginationResponseSuccess("ok", res, meta))
+}
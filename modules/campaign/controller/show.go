@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+func (c *controller) Show(ctx *gin.Context) {
+	campaignID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid campaign id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	res, err := c.campaignService.Show(ctx.Request.Context(), campaignID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
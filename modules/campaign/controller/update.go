@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/campaign/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+func (c *controller) Update(ctx *gin.Context) {
+	campaignID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid campaign id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req request.CampaignUpdateRequest
+
+	// validate request
+	if err = ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.campaignService.Update(ctx.Request.Context(), campaignID, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -0,0 +1,78 @@
+package request
+
+import (
+	"time"
+
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// CampaignCreateRequest defines the payload for scheduling a campaign.
+type CampaignCreateRequest struct {
+	DomainID       uint64    `json:"domain_id" form:"domain_id" binding:"required,exist=domains.id"`
+	Name           string    `json:"name" form:"name" binding:"required,max=255"`
+	SegmentID      uint64    `json:"segment_id" form:"segment_id" binding:"required,exist=follower_segments.id"`
+	ChatTemplateID uint64    `json:"chat_template_id" form:"chat_template_id" binding:"required,exist=chat_templates.id"`
+	EventID        *uint64   `json:"event_id" form:"event_id" binding:"omitempty,exist=events.id"`
+	ScheduledAt    time.Time `json:"scheduled_at" form:"scheduled_at" binding:"required"`
+}
+
+// CampaignUpdateRequest defines the payload for updating a campaign that has not run yet.
+type CampaignUpdateRequest struct {
+	Name           *string    `json:"name" form:"name" binding:"omitempty,max=255"`
+	SegmentID      *uint64    `json:"segment_id" form:"segment_id" binding:"omitempty,exist=follower_segments.id"`
+	ChatTemplateID *uint64    `json:"chat_template_id" form:"chat_template_id" binding:"omitempty,exist=chat_templates.id"`
+	EventID        *uint64    `json:"event_id" form:"event_id" binding:"omitempty,exist=events.id"`
+	ScheduledAt    *time.Time `json:"scheduled_at" form:"scheduled_at" binding:"omitempty"`
+}
+
+func NewCampaignPagination(conditions map[string][]string) *pagination.Pagination {
+	filterDef := pagination.NewFilterDefinition().
+		AddFilter("name", pagination.FilterConfig{
+			TableName: "campaigns",
+			Field:     "name",
+			Type:      pagination.FilterTypeString,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals, pagination.OperatorLike,
+			},
+		}).
+		AddFilter("status", pagination.FilterConfig{
+			TableName:  "campaigns",
+			Field:      "status",
+			Type:       pagination.FilterTypeEnum,
+			EnumValues: []string{"scheduled", "running", "completed", "failed", "cancelled"},
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorEquals, pagination.OperatorIn,
+			},
+		}).
+		AddFilter("segment_id", pagination.FilterConfig{
+			TableName: "campaigns",
+			Field:     "segment_id",
+			Type:      pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("scheduled_at", pagination.FilterConfig{
+			TableName: "campaigns",
+			Field:     "scheduled_at",
+			Type:      pagination.FilterTypeDate,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorBetween, pagination.OperatorEquals, pagination.OperatorGte, pagination.OperatorLte,
+			},
+		}).
+		AddSort("scheduled_at", pagination.SortConfig{
+			TableName: "campaigns",
+			Field:     "scheduled_at",
+			Allowed:   true,
+		})
+
+	return pagination.NewPagination(
+		conditions,
+		filterDef,
+		pagination.PaginationOptions{
+			DefaultLimit: 20,
+			MaxLimit:     100,
+			DefaultOrder: "id desc",
+		},
+	)
+}
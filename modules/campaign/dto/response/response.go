@@ -0,0 +1,17 @@
+package response
+
+import "github.com/PhantomX7/dhamma/entity"
+
+// CampaignRunResponse is a campaign run with the delivery status of its messages.
+type CampaignRunResponse struct {
+	entity.CampaignRun
+	Sent    int `json:"sent"`
+	Failed  int `json:"failed"`
+	Pending int `json:"pending"` // Messages still waiting in the outbox
+}
+
+// CampaignResponse is a campaign with its runs.
+type CampaignResponse struct {
+	entity.Campaign
+	Runs []CampaignRunResponse `json:"runs"`
+}
@@ -0,0 +1,24 @@
+package campaign
+
+type permission struct {
+	Key string
+	// Index all campaigns
+	Index string
+	// View campaign details and runs
+	Show string
+	// Schedule a new campaign
+	Create string
+	// Update a scheduled campaign
+	Update string
+	// Cancel a scheduled campaign
+	Cancel string
+}
+
+var Permissions = permission{
+	Key:    "campaign",
+	Index:  "index",
+	Show:   "show",
+	Create: "create",
+	Update: "update",
+	Cancel: "cancel",
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindDue returns the scheduled campaigns whose scheduled time is at or before now, earliest first.
+func (r *repository) FindDue(ctx context.Context, now time.Time) ([]entity.Campaign, error) {
+	campaigns := make([]entity.Campaign, 0)
+
+	err := r.db.WithContext(ctx).
+		Where("status = ?", entity.CampaignStatusScheduled).
+		Where("scheduled_at <= ?", now).
+		Order("scheduled_at asc, id asc").
+		Find(&campaigns).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return campaigns, nil
+}
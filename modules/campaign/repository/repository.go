@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/campaign"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.Campaign] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new campaign repository instance.
+func New(db *gorm.DB) campaign.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.Campaign](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all campaign entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.Campaign, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a campaign entity by its ID.
+func (r *repository) FindByID(ctx context.Context, campaignID uint64, preloads ...string) (entity.Campaign, error) {
+	return r.base.FindByID(ctx, campaignID, preloads...)
+}
+
+// Create creates a new campaign entity.
+func (r *repository) Create(ctx context.Context, campaign *entity.Campaign, tx *gorm.DB) error {
+	return r.base.Create(ctx, campaign, tx)
+}
+
+// Update updates an existing campaign entity.
+func (r *repository) Update(ctx context.Context, campaign *entity.Campaign, tx *gorm.DB) error {
+	return r.base.Update(ctx, campaign, tx)
+}
+
+// Delete deletes a campaign entity.
+func (r *repository) Delete(ctx context.Context, campaign *entity.Campaign, tx *gorm.DB) error {
+	return r.base.Delete(ctx, campaign, tx)
+}
+
+// Count counts campaign entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves campaign entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.Campaign, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single campaign entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.Campaign, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves campaign entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.Campaign, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single campaign entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.Campaign, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any campaign records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// TransitionStatus moves a campaign from one status to another in a single conditional update,
+// so concurrent runs or a cancel racing a run cannot both succeed.
+func (r *repository) TransitionStatus(ctx context.Context, campaignID uint64, from string, to string, tx *gorm.DB) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&entity.Campaign{}).
+		Where("id = ? AND status = ?", campaignID, from).
+		Update("status", to)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Cancel implements campaign.Service
+func (s *service) Cancel(ctx context.Context, campaignID uint64) (campaign entity.Campaign, err error) {
+	campaign, err = s.campaignRepo.FindByID(ctx, campaignID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, campaign.DomainID, "campaign", "cancel")
+	if err != nil {
+		return
+	}
+
+	// The transition is conditional so a campaign the cron has just picked up is not cancelled mid-run.
+	cancelled, err := s.campaignRepo.TransitionStatus(ctx, campaign.ID, entity.CampaignStatusScheduled, entity.CampaignStatusCancelled, nil)
+	if err != nil {
+		return
+	}
+	if !cancelled {
+		return campaign, &errors.AppError{
+			Message: "only scheduled campaigns can be cancelled",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	campaign.Status = entity.CampaignStatusCancelled
+	return
+}
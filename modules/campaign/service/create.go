@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/campaign/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Create implements campaign.Service
+func (s *service) Create(ctx context.Context, req request.CampaignCreateRequest) (campaign entity.Campaign, err error) {
+	contextValues, err := utility.CheckDomainContext(ctx, req.DomainID, "campaign", "create")
+	if err != nil {
+		return
+	}
+
+	campaign = entity.Campaign{
+		DomainID:       req.DomainID,
+		Name:           req.Name,
+		SegmentID:      req.SegmentID,
+		ChatTemplateID: req.ChatTemplateID,
+		EventID:        req.EventID,
+		ScheduledAt:    req.ScheduledAt,
+		Status:         entity.CampaignStatusScheduled,
+		CreatedBy:      contextValues.UserID,
+	}
+
+	err = s.validateTargets(ctx, campaign)
+	if err != nil {
+		return
+	}
+
+	err = s.campaignRepo.Create(ctx, &campaign, nil)
+	return
+}
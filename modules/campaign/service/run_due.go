@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	segmentRequest "github.com/PhantomX7/dhamma/modules/follower_segment/dto/request"
+	"github.com/PhantomX7/dhamma/modules/outbound_message/dto/request"
+	"github.com/PhantomX7/dhamma/modules/outbound_message/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	customErrors "github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// maxRunErrorLength matches the size of the CampaignRun.Error column.
+const maxRunErrorLength = 500
+
+// RunDue queues the messages of every scheduled campaign that is due and returns how many campaigns ran.
+// A campaign that fails to queue is marked failed with the reason on its run. An error running one campaign
+// is logged and the other campaigns still run.
+func (s *service) RunDue(ctx context.Context) (ran int, err error) {
+	campaigns, err := s.campaignRepo.FindDue(ctx, time.Now())
+	if err != nil {
+		return
+	}
+
+	for _, campaign := range campaigns {
+		started, runErr := s.run(ctx, campaign)
+		if runErr != nil {
+			logger.FromCtx(ctx).Error("failed to run campaign",
+				zap.Uint64("campaign_id", campaign.ID),
+				zap.Error(runErr),
+			)
+			continue
+		}
+		if started {
+			ran++
+		}
+	}
+
+	return
+}
+
+// run claims the campaign, records a run of it and queues its messages.
+// It reports false if the campaign was claimed by an overlapping run or cancelled in the meantime.
+func (s *service) run(ctx context.Context, campaign entity.Campaign) (started bool, err error) {
+	run := entity.CampaignRun{
+		CampaignID: campaign.ID,
+		DomainID:   campaign.DomainID,
+		Status:     entity.CampaignStatusRunning,
+		StartedAt:  time.Now(),
+	}
+
+	// Claiming the campaign keeps overlapping runs and a concurrent cancel from both acting on it.
+	// The run is recorded with the claim, so a campaign is never left running without one.
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		claimed, err := s.campaignRepo.TransitionStatus(ctx, campaign.ID, entity.CampaignStatusScheduled, entity.CampaignStatusRunning, tx)
+		if err != nil || !claimed {
+			return err
+		}
+
+		if err = s.campaignRunRepo.Create(ctx, &run, tx); err != nil {
+			return err
+		}
+
+		started = true
+		return nil
+	})
+	if err != nil || !started {
+		return false, err
+	}
+
+	res, queueErr := s.queue(ctx, campaign, run.ID)
+
+	run.Recipients = res.Recipients
+	run.Skipped = res.Skipped
+	run.Queued = res.Queued
+	run.FinishedAt = utility.PointOf(time.Now())
+	run.Status = entity.CampaignStatusCompleted
+	if queueErr != nil {
+		run.Status = entity.CampaignStatusFailed
+		run.Error = utility.PointOf(runErrorMessage(queueErr))
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		if err := s.campaignRunRepo.Update(ctx, &run, tx); err != nil {
+			return err
+		}
+
+		_, err := s.campaignRepo.TransitionStatus(ctx, campaign.ID, entity.CampaignStatusRunning, run.Status, tx)
+		return err
+	})
+	return
+}
+
+// queue resolves the campaign's segment and broadcasts the chat template to its followers
+// on behalf of the user who scheduled the campaign.
+func (s *service) queue(ctx context.Context, campaign entity.Campaign, campaignRunID uint64) (
+	res response.OutboundMessageBroadcastResponse, err error,
+) {
+	segment, err := s.followerSegmentRepo.FindByID(ctx, campaign.SegmentID, "Domain")
+	if err != nil {
+		return
+	}
+
+	conditions, err := segment.Conditions()
+	if err != nil {
+		return res, &customErrors.AppError{
+			Message: "invalid follower segment filters",
+			Status:  http.StatusInternalServerError,
+			Err:     err,
+		}
+	}
+
+	values := utility.ContextValues{
+		DomainID: &campaign.DomainID,
+		UserID:   campaign.CreatedBy,
+	}
+	if segment.Domain != nil {
+		values.Location = segment.Domain.Location()
+	}
+
+	return s.outboundMessageService.QueueCampaignRun(
+		utility.NewContextWithValues(ctx, values),
+		segmentRequest.NewSegmentFollowerPagination(conditions, segment.InactiveDays),
+		request.OutboundMessageBroadcastRequest{
+			DomainID:       campaign.DomainID,
+			ChatTemplateID: campaign.ChatTemplateID,
+			EventID:        campaign.EventID,
+		},
+		campaignRunID,
+	)
+}
+
+// runErrorMessage returns the user-facing message of err, cut to fit the run's error column.
+func runErrorMessage(err error) string {
+	message := err.Error()
+
+	var appErr *customErrors.AppError
+	if errors.As(err, &appErr) {
+		message = appErr.Message
+	}
+
+	if len(message) > maxRunErrorLength {
+		message = message[:maxRunErrorLength]
+	}
+	return message
+}
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/campaign"
+	campaignRepo "github.com/PhantomX7/dhamma/modules/campaign/repository"
+	"github.com/PhantomX7/dhamma/modules/campaign_run"
+	campaignRunRepo "github.com/PhantomX7/dhamma/modules/campaign_run/repository"
+	followerSegmentRepo "github.com/PhantomX7/dhamma/modules/follower_segment/repository"
+	"github.com/PhantomX7/dhamma/modules/outbound_message"
+	"github.com/PhantomX7/dhamma/modules/outbound_message/dto/request"
+	"github.com/PhantomX7/dhamma/modules/outbound_message/dto/response"
+	customErrors "github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// fakeOutboundMessageService queues campaign runs with queue, counting the calls per campaign run.
+type fakeOutboundMessageService struct {
+	outbound_message.Service
+	queue func(req request.OutboundMessageBroadcastRequest) (response.OutboundMessageBroadcastResponse, error)
+
+	mu     sync.Mutex
+	queued map[uint64]int
+}
+
+func (s *fakeOutboundMessageService) QueueCampaignRun(ctx context.Context, pg *pagination.Pagination, req request.OutboundMessageBroadcastRequest, campaignRunID uint64) (
+	response.OutboundMessageBroadcastResponse, error,
+) {
+	s.mu.Lock()
+	if s.queued == nil {
+		s.queued = make(map[uint64]int)
+	}
+	s.queued[campaignRunID]++
+	s.mu.Unlock()
+
+	return s.queue(req)
+}
+
+// failingCreateRunRepo fails to record a run of the given campaign.
+type failingCreateRunRepo struct {
+	campaign_run.Repository
+	campaignID uint64
+}
+
+func (r failingCreateRunRepo) Create(ctx context.Context, run *entity.CampaignRun, tx *gorm.DB) error {
+	if run.CampaignID == r.campaignID {
+		return errors.New("cannot record run")
+	}
+	return r.Repository.Create(ctx, run, tx)
+}
+
+// barrierCampaignRepo holds every FindDue until all expected callers have read the due campaigns,
+// so each concurrent RunDue starts from the same, not yet claimed, campaigns.
+type barrierCampaignRepo struct {
+	campaign.Repository
+	readers *sync.WaitGroup
+}
+
+func (r barrierCampaignRepo) FindDue(ctx context.Context, now time.Time) ([]entity.Campaign, error) {
+	campaigns, err := r.Repository.FindDue(ctx, now)
+	r.readers.Done()
+	r.readers.Wait()
+	return campaigns, err
+}
+
+// campaignFixture is a domain with a segment and chat template to schedule campaigns with.
+type campaignFixture struct {
+	db           *gorm.DB
+	domain       entity.Domain
+	segment      entity.FollowerSegment
+	chatTemplate entity.ChatTemplate
+}
+
+func newCampaignFixture(t *testing.T) campaignFixture {
+	db := testdb.New(t,
+		&entity.Domain{},
+		&entity.FollowerSegment{},
+		&entity.ChatTemplate{},
+		&entity.Campaign{},
+		&entity.CampaignRun{},
+	)
+	domain := testdb.Domain(t, db)
+
+	segment := entity.FollowerSegment{DomainID: domain.ID, Name: "Youth", Filters: `{"is_youth":["true"]}`}
+	require.NoError(t, db.Create(&segment).Error)
+
+	chatTemplate := entity.ChatTemplate{DomainID: domain.ID, Name: "Reminder", Content: "Hi", IsActive: true}
+	require.NoError(t, db.Create(&chatTemplate).Error)
+
+	return campaignFixture{db: db, domain: domain, segment: segment, chatTemplate: chatTemplate}
+}
+
+func (f campaignFixture) schedule(t *testing.T, name string, scheduledAt time.Time) entity.Campaign {
+	campaign := entity.Campaign{
+		DomainID:       f.domain.ID,
+		Name:           name,
+		SegmentID:      f.segment.ID,
+		ChatTemplateID: f.chatTemplate.ID,
+		ScheduledAt:    scheduledAt,
+		Status:         entity.CampaignStatusScheduled,
+		CreatedBy:      1,
+	}
+	require.NoError(t, f.db.Create(&campaign).Error)
+	return campaign
+}
+
+func (f campaignFixture) service(campaigns campaign.Repository, runs campaign_run.Repository, outboundMessageService outbound_message.Service) campaign.Service {
+	return New(campaigns, runs, followerSegmentRepo.New(f.db), nil, nil, nil, outboundMessageService, transaction_manager.New(f.db))
+}
+
+func (f campaignFixture) status(t *testing.T, campaignID uint64) string {
+	t.Helper()
+
+	var campaign entity.Campaign
+	require.NoError(t, f.db.First(&campaign, campaignID).Error)
+	return campaign.Status
+}
+
+func (f campaignFixture) runs(t *testing.T, campaignID uint64) []entity.CampaignRun {
+	t.Helper()
+
+	var runs []entity.CampaignRun
+	require.NoError(t, f.db.Where("campaign_id = ?", campaignID).Find(&runs).Error)
+	return runs
+}
+
+func queueing(recipients int) func(request.OutboundMessageBroadcastRequest) (response.OutboundMessageBroadcastResponse, error) {
+	return func(request.OutboundMessageBroadcastRequest) (response.OutboundMessageBroadcastResponse, error) {
+		return response.OutboundMessageBroadcastResponse{Recipients: recipients, Skipped: 1, Queued: recipients - 1}, nil
+	}
+}
+
+func TestRunDue_QueuesDueCampaigns(t *testing.T) {
+	f := newCampaignFixture(t)
+	due := f.schedule(t, "Due", time.Now().Add(-time.Minute))
+	later := f.schedule(t, "Later", time.Now().Add(time.Hour))
+
+	outbound := &fakeOutboundMessageService{queue: queueing(3)}
+	s := f.service(campaignRepo.New(f.db), campaignRunRepo.New(f.db), outbound)
+
+	ran, err := s.RunDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, ran)
+
+	assert.Equal(t, entity.CampaignStatusCompleted, f.status(t, due.ID))
+	runs := f.runs(t, due.ID)
+	require.Len(t, runs, 1)
+	assert.Equal(t, entity.CampaignStatusCompleted, runs[0].Status)
+	assert.Equal(t, 3, runs[0].Recipients)
+	assert.Equal(t, 1, runs[0].Skipped)
+	assert.Equal(t, 2, runs[0].Queued)
+	assert.NotNil(t, runs[0].FinishedAt)
+	assert.Equal(t, 1, outbound.queued[runs[0].ID])
+
+	assert.Equal(t, entity.CampaignStatusScheduled, f.status(t, later.ID))
+	assert.Empty(t, f.runs(t, later.ID))
+}
+
+func TestRunDue_QueueFailureMarksCampaignFailed(t *testing.T) {
+	f := newCampaignFixture(t)
+	failing := f.schedule(t, "Failing", time.Now().Add(-2*time.Minute))
+	other := f.schedule(t, "Other", time.Now().Add(-time.Minute))
+
+	// Campaigns run earliest first, so only the first one queued fails
+	calls := 0
+	outbound := &fakeOutboundMessageService{queue: func(req request.OutboundMessageBroadcastRequest) (response.OutboundMessageBroadcastResponse, error) {
+		calls++
+		if calls == 1 {
+			return response.OutboundMessageBroadcastResponse{}, &customErrors.AppError{
+				Message: "too many recipients for a single broadcast, narrow the filters",
+				Status:  http.StatusBadRequest,
+			}
+		}
+		return queueing(2)(req)
+	}}
+	s := f.service(campaignRepo.New(f.db), campaignRunRepo.New(f.db), outbound)
+
+	ran, err := s.RunDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, ran)
+
+	assert.Equal(t, entity.CampaignStatusFailed, f.status(t, failing.ID))
+	runs := f.runs(t, failing.ID)
+	require.Len(t, runs, 1)
+	assert.Equal(t, entity.CampaignStatusFailed, runs[0].Status)
+	require.NotNil(t, runs[0].Error)
+	assert.Equal(t, "too many recipients for a single broadcast, narrow the filters", *runs[0].Error)
+
+	assert.Equal(t, entity.CampaignStatusCompleted, f.status(t, other.ID))
+}
+
+func TestRunDue_RunNotRecordedLeavesCampaignScheduled(t *testing.T) {
+	f := newCampaignFixture(t)
+	broken := f.schedule(t, "Broken", time.Now().Add(-2*time.Minute))
+	other := f.schedule(t, "Other", time.Now().Add(-time.Minute))
+
+	outbound := &fakeOutboundMessageService{queue: queueing(2)}
+	s := f.service(
+		campaignRepo.New(f.db),
+		failingCreateRunRepo{Repository: campaignRunRepo.New(f.db), campaignID: broken.ID},
+		outbound,
+	)
+
+	ran, err := s.RunDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, ran)
+
+	// The claim is rolled back with the run, so the next pass tries the campaign again
+	assert.Equal(t, entity.CampaignStatusScheduled, f.status(t, broken.ID))
+	assert.Empty(t, f.runs(t, broken.ID))
+
+	assert.Equal(t, entity.CampaignStatusCompleted, f.status(t, other.ID))
+	assert.Len(t, outbound.queued, 1)
+}
+
+func TestRunDue_CancelledCampaignIsSkipped(t *testing.T) {
+	f := newCampaignFixture(t)
+	cancelled := f.schedule(t, "Cancelled", time.Now().Add(-time.Minute))
+
+	outbound := &fakeOutboundMessageService{queue: queueing(2)}
+	s := f.service(campaignRepo.New(f.db), campaignRunRepo.New(f.db), outbound)
+
+	// The campaign is cancelled after it was found due but before it is claimed
+	found, err := campaignRepo.New(f.db).FindDue(context.Background(), time.Now())
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.NoError(t, f.db.Model(&cancelled).Update("status", entity.CampaignStatusCancelled).Error)
+
+	started, err := s.(*service).run(context.Background(), found[0])
+	require.NoError(t, err)
+	assert.False(t, started)
+
+	assert.Equal(t, entity.CampaignStatusCancelled, f.status(t, cancelled.ID))
+	assert.Empty(t, f.runs(t, cancelled.ID))
+	assert.Empty(t, outbound.queued)
+}
+
+func TestRunDue_ConcurrentRunsQueueOnce(t *testing.T) {
+	const passes = 4
+
+	f := newCampaignFixture(t)
+	first := f.schedule(t, "First", time.Now().Add(-2*time.Minute))
+	second := f.schedule(t, "Second", time.Now().Add(-time.Minute))
+
+	readers := &sync.WaitGroup{}
+	readers.Add(passes)
+	outbound := &fakeOutboundMessageService{queue: queueing(2)}
+	s := f.service(barrierCampaignRepo{Repository: campaignRepo.New(f.db), readers: readers}, campaignRunRepo.New(f.db), outbound)
+
+	var wg sync.WaitGroup
+	ran := make([]int, passes)
+	errs := make([]error, passes)
+	for i := range passes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ran[i], errs[i] = s.RunDue(context.Background())
+		}(i)
+	}
+	wg.Wait()
+
+	total := 0
+	for i := range passes {
+		require.NoError(t, errs[i])
+		total += ran[i]
+	}
+	assert.Equal(t, 2, total)
+
+	for _, campaign := range []entity.Campaign{first, second} {
+		assert.Equal(t, entity.CampaignStatusCompleted, f.status(t, campaign.ID))
+		assert.Len(t, f.runs(t, campaign.ID), 1)
+	}
+	assert.Len(t, outbound.queued, 2)
+	for _, calls := range outbound.queued {
+		assert.Equal(t, 1, calls)
+	}
+}
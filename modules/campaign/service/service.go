@@ -0,0 +1,44 @@
+package service
+
+import (
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/campaign"
+	"github.com/PhantomX7/dhamma/modules/campaign_run"
+	"github.com/PhantomX7/dhamma/modules/chat_template"
+	"github.com/PhantomX7/dhamma/modules/event"
+	"github.com/PhantomX7/dhamma/modules/follower_segment"
+	"github.com/PhantomX7/dhamma/modules/outbound_message"
+)
+
+type service struct {
+	campaignRepo           campaign.Repository
+	campaignRunRepo        campaign_run.Repository
+	followerSegmentRepo    follower_segment.Repository
+	chatTemplateRepo       chat_template.Repository
+	eventRepo              event.Repository
+	outboundMessageRepo    outbound_message.Repository
+	outboundMessageService outbound_message.Service
+	transactionManager     transaction_manager.Client
+}
+
+func New(
+	campaignRepo campaign.Repository,
+	campaignRunRepo campaign_run.Repository,
+	followerSegmentRepo follower_segment.Repository,
+	chatTemplateRepo chat_template.Repository,
+	eventRepo event.Repository,
+	outboundMessageRepo outbound_message.Repository,
+	outboundMessageService outbound_message.Service,
+	transactionManager transaction_manager.Client,
+) campaign.Service {
+	return &service{
+		campaignRepo:           campaignRepo,
+		campaignRunRepo:        campaignRunRepo,
+		followerSegmentRepo:    followerSegmentRepo,
+		chatTemplateRepo:       chatTemplateRepo,
+		eventRepo:              eventRepo,
+		outboundMessageRepo:    outboundMessageRepo,
+		outboundMessageService: outboundMessageService,
+		transactionManager:     transactionManager,
+	}
+}
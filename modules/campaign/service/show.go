@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/campaign/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Show implements campaign.Service
+func (s *service) Show(ctx context.Context, campaignID uint64) (res response.CampaignResponse, err error) {
+	campaign, err := s.campaignRepo.FindByID(ctx, campaignID, "Segment", "ChatTemplate")
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, campaign.DomainID, "campaign", "show")
+	if err != nil {
+		return
+	}
+
+	runs, err := s.campaignRunRepo.FindByCampaignID(ctx, campaign.ID)
+	if err != nil {
+		return
+	}
+
+	runIDs := make([]uint64, 0, len(runs))
+	for _, run := range runs {
+		runIDs = append(runIDs, run.ID)
+	}
+	counts, err := s.outboundMessageRepo.CountStatusByCampaignRun(ctx, runIDs)
+	if err != nil {
+		return
+	}
+
+	res.Campaign = campaign
+	res.Runs = make([]response.CampaignRunResponse, 0, len(runs))
+	for _, run := range runs {
+		statuses := counts[run.ID]
+		res.Runs = append(res.Runs, response.CampaignRunResponse{
+			CampaignRun: run,
+			Sent:        statuses[entity.OutboundMessageStatusSent],
+			Failed:      statuses[entity.OutboundMessageStatusFailed],
+			Pending:     statuses[entity.OutboundMessageStatusPending] + statuses[entity.OutboundMessageStatusSending],
+		})
+	}
+
+	return
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/campaign/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Update implements campaign.Service. Only campaigns that have not run yet can be changed.
+func (s *service) Update(ctx context.Context, campaignID uint64, req request.CampaignUpdateRequest) (campaign entity.Campaign, err error) {
+	campaign, err = s.campaignRepo.FindByID(ctx, campaignID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, campaign.DomainID, "campaign", "update")
+	if err != nil {
+		return
+	}
+
+	if campaign.Status != entity.CampaignStatusScheduled {
+		return campaign, &errors.AppError{
+			Message: "only scheduled campaigns can be updated",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	if req.Name != nil {
+		campaign.Name = *req.Name
+	}
+	if req.SegmentID != nil {
+		campaign.SegmentID = *req.SegmentID
+	}
+	if req.ChatTemplateID != nil {
+		campaign.ChatTemplateID = *req.ChatTemplateID
+	}
+	if req.EventID != nil {
+		campaign.EventID = req.EventID
+	}
+	if req.ScheduledAt != nil {
+		campaign.ScheduledAt = *req.ScheduledAt
+	}
+
+	err = s.validateTargets(ctx, campaign)
+	if err != nil {
+		return
+	}
+
+	err = s.campaignRepo.Update(ctx, &campaign, nil)
+	return
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// validateTargets checks that the segment, chat template and event of a campaign belong to its domain.
+func (s *service) validateTargets(ctx context.Context, campaign entity.Campaign) error {
+	segment, err := s.followerSegmentRepo.FindByID(ctx, campaign.SegmentID)
+	if err != nil {
+		return err
+	}
+	if segment.DomainID != campaign.DomainID {
+		return &errors.AppError{
+			Message: "follower segment does not belong to the domain",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	template, err := s.chatTemplateRepo.FindByID(ctx, campaign.ChatTemplateID)
+	if err != nil {
+		return err
+	}
+	if template.DomainID != campaign.DomainID {
+		return &errors.AppError{
+			Message: "chat template does not belong to the domain",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	if campaign.EventID != nil {
+		event, err := s.eventRepo.FindByID(ctx, *campaign.EventID)
+		if err != nil {
+			return err
+		}
+		if event.DomainID != campaign.DomainID {
+			return &errors.AppError{
+				Message: "event does not belong to the domain",
+				Status:  http.StatusBadRequest,
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package campaign_run
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.CampaignRun]
+	// FindByCampaignID returns the runs of a campaign, latest first.
+	FindByCampaignID(ctx context.Context, campaignID uint64) ([]entity.CampaignRun, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindByCampaignID returns the runs of a campaign, latest first.
+func (r *repository) FindByCampaignID(ctx context.Context, campaignID uint64) ([]entity.CampaignRun, error) {
+	runs := make([]entity.CampaignRun, 0)
+
+	err := r.db.WithContext(ctx).
+		Where("campaign_id = ?", campaignID).
+		Order("id desc").
+		Find(&runs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/campaign_run"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.CampaignRun] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new campaign run repository instance.
+func New(db *gorm.DB) campaign_run.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.CampaignRun](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all campaign run entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.CampaignRun, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a campaign run entity by its ID.
+func (r *repository) FindByID(ctx context.Context, campaignRunID uint64, preloads ...string) (entity.CampaignRun, error) {
+	return r.base.FindByID(ctx, campaignRunID, preloads...)
+}
+
+// Create creates a new campaign run entity.
+func (r *repository) Create(ctx context.Context, campaignRun *entity.CampaignRun, tx *gorm.DB) error {
+	return r.base.Create(ctx, campaignRun, tx)
+}
+
+// Update updates an existing campaign run entity.
+func (r *repository) Update(ctx context.Context, campaignRun *entity.CampaignRun, tx *gorm.DB) error {
+	return r.base.Update(ctx, campaignRun, tx)
+}
+
+// Delete deletes a campaign run entity.
+func (r *repository) Delete(ctx context.Context, campaignRun *entity.CampaignRun, tx *gorm.DB) error {
+	return r.base.Delete(ctx, campaignRun, tx)
+}
+
+// Count counts campaign run entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves campaign run entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.CampaignRun, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single campaign run entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.CampaignRun, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves campaign run entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.CampaignRun, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single campaign run entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.CampaignRun, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any campaign run records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
@@ -1,10 +1,21 @@
 package card
 
 import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
 	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/utility/repository"
 )
 
 type Repository interface {
 	repository.BaseRepositoryInterface[entity.Card]
+	// ReassignFollower moves every card of fromFollowerID to toFollowerID and returns how many were moved.
+	ReassignFollower(ctx context.Context, fromFollowerID uint64, toFollowerID uint64, tx *gorm.DB) (int64, error)
+	// ExpireDue marks the active cards whose expiry date has passed as expired and returns how many were marked.
+	ExpireDue(ctx context.Context, now time.Time) (int64, error)
+	// Replace revokes the card in favour of replacedByID and reports false if it was already replaced.
+	Replace(ctx context.Context, cardID uint64, replacedByID uint64, tx *gorm.DB) (bool, error)
 }
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// ExpireDue marks the active cards whose expiry date has passed as expired and returns how many were marked.
+func (r *repository) ExpireDue(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.Card{}).
+		Where("status = ? AND expires_at IS NOT NULL AND expires_at <= ?", entity.CardStatusActive, now).
+		Update("status", entity.CardStatusExpired)
+
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Replace revokes the card and links it to the card replacing it in a single conditional update,
+// so a card can only be replaced once. It reports false if the card was already replaced.
+func (r *repository) Replace(ctx context.Context, cardID uint64, replacedByID uint64, tx *gorm.DB) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&entity.Card{}).
+		Where("id = ? AND replaced_by_id IS NULL", cardID).
+		Updates(map[string]any{
+			"status":         entity.CardStatusRevoked,
+			"replaced_by_id": replacedByID,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
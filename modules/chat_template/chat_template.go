@@ -8,6 +8,7 @@ import (
 
 	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/chat_template/dto/request"
+	"github.com/PhantomX7/dhamma/modules/chat_template/dto/response"
 	"github.com/PhantomX7/dhamma/utility"
 	"github.com/PhantomX7/dhamma/utility/pagination"
 	"github.com/PhantomX7/dhamma/utility/repository"
@@ -15,7 +16,10 @@ import (
 
 type Repository interface {
 	repository.BaseRepositoryInterface[entity.ChatTemplate]
-	SetAsDefault(ctx context.Context, templateID uint64, domainID uint64, tx *gorm.DB) error
+	// FindByIDForUpdate reads the chat template and locks its row until tx ends.
+	FindByIDForUpdate(ctx context.Context, templateID uint64, tx *gorm.DB) (entity.ChatTemplate, error)
+	// SetAsDefault makes the template the domain's default, pinned to the given version.
+	SetAsDefault(ctx context.Context, templateID uint64, domainID uint64, versionID uint64, tx *gorm.DB) error
 	GetDefaultByDomain(ctx context.Context, domainID uint64) (entity.ChatTemplate, error)
 }
 
@@ -24,8 +28,13 @@ type Service interface {
 	Show(ctx context.Context, templateID uint64) (entity.ChatTemplate, error)
 	Create(ctx context.Context, request request.ChatTemplateCreateRequest) (entity.ChatTemplate, error)
 	Update(ctx context.Context, templateID uint64, request request.ChatTemplateUpdateRequest) (entity.ChatTemplate, error)
-	SetAsDefault(ctx context.Context, templateID uint64) (entity.ChatTemplate, error)
+	SetAsDefault(ctx context.Context, templateID uint64, request request.ChatTemplateSetDefaultRequest) (entity.ChatTemplate, error)
 	GetDefaultByDomain(ctx context.Context, domainID uint64) (entity.ChatTemplate, error)
+	Render(ctx context.Context, templateID uint64, request request.ChatTemplateRenderRequest) (response.ChatTemplateRenderResponse, error)
+	RenderForFollowers(ctx context.Context, templateID uint64, eventID *uint64, followers []entity.Follower) ([]string, error)
+	Versions(ctx context.Context, templateID uint64, paginationConfig *pagination.Pagination) ([]entity.ChatTemplateVersion, utility.PaginationMeta, error)
+	DiffVersions(ctx context.Context, templateID uint64, request request.ChatTemplateVersionDiffRequest) (response.ChatTemplateVersionDiffResponse, error)
+	RestoreVersion(ctx context.Context, templateID uint64, version int) (entity.ChatTemplate, error)
 }
 
 type Controller interface {
@@ -35,4 +44,8 @@ type Controller interface {
 	Update(c *gin.Context)
 	SetAsDefault(c *gin.Context)
 	GetDefaultByDomain(c *gin.Context)
+	Render(c *gin.Context)
+	Versions(c *gin.Context)
+	DiffVersions(c *gin.Context)
+	RestoreVersion(c *gin.Context)
 }
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/chat_template/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// DiffVersions compares two versions of a chat template
+// Expected route: GET /chat-template/:id/versions/diff?from=1&to=3
+func (c *controller) DiffVersions(ctx *gin.Context) {
+	templateID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid chat template id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req request.ChatTemplateVersionDiffRequest
+
+	// validate request
+	if err = ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.chatTemplateService.DiffVersions(ctx.Request.Context(), templateID, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// RestoreVersion writes an old version of a chat template back as its latest version
+// Expected route: POST /chat-template/:id/versions/:version/restore
+func (c *controller) RestoreVersion(ctx *gin.Context) {
+	templateID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid chat template id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	version, err := strconv.Atoi(ctx.Param("version"))
+	if err != nil || version < 1 {
+		ctx.Error(&errors.AppError{
+			Message: "invalid chat template version",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	res, err := c.chatTemplateService.RestoreVersion(ctx.Request.Context(), templateID, version)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("chat template version restored successfully", res))
+}
@@ -4,12 +4,14 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/PhantomX7/dhamma/modules/chat_template/dto/request"
 	"github.com/PhantomX7/dhamma/utility"
 	"github.com/PhantomX7/dhamma/utility/errors"
 	"github.com/gin-gonic/gin"
 )
 
 // SetAsDefault sets a chat template as the default for its domain
+// Expected route: POST /chat-template/:id/set-default?version=3
 func (c *controller) SetAsDefault(ctx *gin.Context) {
 	templateID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
 	if err != nil {
@@ -20,7 +22,15 @@ func (c *controller) SetAsDefault(ctx *gin.Context) {
 		return
 	}
 
-	res, err := c.chatTemplateService.SetAsDefault(ctx.Request.Context(), templateID)
+	var req request.ChatTemplateSetDefaultRequest
+
+	// validate request, the version is read from the query so the request may have no body
+	if err = ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.chatTemplateService.SetAsDefault(ctx.Request.Context(), templateID, req)
 	if err != nil {
 		ctx.Error(err)
 		return
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/chat_template/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Versions lists the versions of a chat template, latest first
+func (c *controller) Versions(ctx *gin.Context) {
+	templateID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid chat template id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	res, meta, err := c.chatTemplateService.Versions(ctx.Request.Context(), templateID, request.NewChatTemplateVersionPagination(ctx.Request.URL.Query()))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildPaginationResponseSuccess("ok", res, meta))
+}
@@ -19,6 +19,25 @@ type ChatTemplateUpdateRequest struct {
 	IsActive    *bool   `json:"is_active" form:"is_active"`
 }
 
+// ChatTemplateRenderRequest selects the follower and event whose details fill in the template.
+// Either may be omitted when the template does not use its variables.
+type ChatTemplateRenderRequest struct {
+	FollowerID *uint64 `json:"follower_id" form:"follower_id" binding:"omitempty"`
+	EventID    *uint64 `json:"event_id" form:"event_id" binding:"omitempty"`
+}
+
+// ChatTemplateSetDefaultRequest selects the version the default template is pinned to.
+// The latest version is pinned when none is given.
+type ChatTemplateSetDefaultRequest struct {
+	Version *int `json:"version" form:"version" binding:"omitempty,min=1"`
+}
+
+// ChatTemplateVersionDiffRequest selects the two versions to compare.
+type ChatTemplateVersionDiffRequest struct {
+	From int `json:"from" form:"from" binding:"required,min=1"`
+	To   int `json:"to" form:"to" binding:"required,min=1"`
+}
+
 func NewChatTemplatePagination(conditions map[string][]string) *pagination.Pagination {
 	filterDef := pagination.NewFilterDefinition().
 		AddFilter("name", pagination.FilterConfig{
@@ -60,3 +79,36 @@ func NewChatTemplatePagination(conditions map[string][]string) *pagination.Pagin
 		},
 	)
 }
+
+func NewChatTemplateVersionPagination(conditions map[string][]string) *pagination.Pagination {
+	filterDef := pagination.NewFilterDefinition().
+		AddFilter("created_by", pagination.FilterConfig{
+			TableName: "chat_template_versions",
+			Field:     "created_by",
+			Type:      pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("created_at", pagination.FilterConfig{
+			TableName: "chat_template_versions",
+			Field:     "created_at",
+			Type:      pagination.FilterTypeDateTime,
+			Operators: []pagination.FilterOperator{pagination.OperatorBetween, pagination.OperatorEquals},
+		}).
+		AddSort("version", pagination.SortConfig{
+			TableName: "chat_template_versions",
+			Field:     "version",
+			Allowed:   true,
+		})
+
+	return pagination.NewPagination(
+		conditions,
+		filterDef,
+		pagination.PaginationOptions{
+			DefaultLimit: 10,
+			MaxLimit:     100,
+			DefaultOrder: "version desc",
+		},
+	)
+}
@@ -0,0 +1,19 @@
+package response
+
+import "github.com/PhantomX7/dhamma/utility/textdiff"
+
+// ChatTemplateRenderResponse is a chat template filled in for a follower and/or event.
+type ChatTemplateRenderResponse struct {
+	TemplateID uint64   `json:"template_id"`
+	Content    string   `json:"content"`
+	Variables  []string `json:"variables"` // Variables used by the template
+}
+
+// ChatTemplateVersionDiffResponse compares two versions of a chat template.
+type ChatTemplateVersionDiffResponse struct {
+	TemplateID uint64          `json:"template_id"`
+	From       int             `json:"from"`
+	To         int             `json:"to"`
+	Fields     []string        `json:"fields"` // Fields that differ: name, description and/or content
+	Lines      []textdiff.Line `json:"lines"`  // Line by line diff of the content
+}
@@ -16,15 +16,24 @@ type permission struct {
 	SetAsDefault string
 	// Get default chat template
 	GetDefault string
+	// Render a chat template for a follower or event
+	Render string
+	// List and compare the versions of a chat template
+	Versions string
+	// Restore a previous version of a chat template
+	RestoreVersion string
 }
 
 var Permissions = permission{
-	Key:          "chat-template",
-	Index:        "index",
-	Show:         "show",
-	Create:       "create",
-	Update:       "update",
-	Delete:       "delete",
-	SetAsDefault: "set-as-default",
-	GetDefault:   "get-default",
+	Key:            "chat-template",
+	Index:          "index",
+	Show:           "show",
+	Create:         "create",
+	Update:         "update",
+	Delete:         "delete",
+	SetAsDefault:   "set-as-default",
+	GetDefault:     "get-default",
+	Render:         "render",
+	Versions:       "versions",
+	RestoreVersion: "restore-version",
 }
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindByIDForUpdate reads the chat template and locks its row until tx ends, so versions written
+// inside tx are numbered after those of any other transaction doing the same.
+func (r *repository) FindByIDForUpdate(ctx context.Context, templateID uint64, tx *gorm.DB) (template entity.ChatTemplate, err error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	err = db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", templateID).
+		Take(&template).Error
+	return
+}
@@ -13,6 +13,7 @@ import (
 func (r *repository) GetDefaultByDomain(ctx context.Context, domainID uint64) (entity.ChatTemplate, error) {
 	var template entity.ChatTemplate
 	err := r.db.WithContext(ctx).
+		Preload("PinnedVersion").
 		Where("domain_id = ? AND is_default = ? AND is_active = ?", domainID, true, true).
 		First(&template).Error
 
@@ -8,8 +8,8 @@ import (
 	"gorm.io/gorm"
 )
 
-// SetAsDefault sets a chat template as default and unsets others in the same domain.
-func (r *repository) SetAsDefault(ctx context.Context, templateID uint64, domainID uint64, tx *gorm.DB) error {
+// SetAsDefault sets a chat template as default, pinned to the given version, and unsets others in the same domain.
+func (r *repository) SetAsDefault(ctx context.Context, templateID uint64, domainID uint64, versionID uint64, tx *gorm.DB) error {
 	// Start a transaction
 	db := r.db
 	if tx != nil {
@@ -19,14 +19,14 @@ func (r *repository) SetAsDefault(ctx context.Context, templateID uint64, domain
 	// First, unset all default templates in the domain
 	if err := db.Model(&entity.ChatTemplate{}).
 		Where("domain_id = ?", domainID).
-		Update("is_default", false).Error; err != nil {
+		Updates(map[string]any{"is_default": false, "pinned_version_id": nil}).Error; err != nil {
 		return errors.WrapError(err, "failed to unset default templates")
 	}
 
 	// Then, set the specified template as default
 	if err := db.Model(&entity.ChatTemplate{}).
 		Where("id = ? AND domain_id = ?", templateID, domainID).
-		Update("is_default", true).Error; err != nil {
+		Updates(map[string]any{"is_default": true, "pinned_version_id": versionID}).Error; err != nil {
 		return errors.WrapError(err, "failed to set template as default")
 	}
 
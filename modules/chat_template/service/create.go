@@ -12,11 +12,15 @@ import (
 )
 
 func (s *service) Create(ctx context.Context, req request.ChatTemplateCreateRequest) (template entity.ChatTemplate, err error) {
-	_, err = utility.CheckDomainContext(ctx, req.DomainID, "chat_template", "create")
+	contextValues, err := utility.CheckDomainContext(ctx, req.DomainID, "chat_template", "create")
 	if err != nil {
 		return
 	}
 
+	if err = validateContent(req.Content); err != nil {
+		return
+	}
+
 	err = copier.Copy(&template, &req)
 	if err != nil {
 		return
@@ -30,20 +34,24 @@ func (s *service) Create(ctx context.Context, req request.ChatTemplateCreateRequ
 		template.IsDefault = false
 	}
 
-	// If this template is being set as default, handle the logic
-	if template.IsDefault {
-		err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
-			// Create the template first
-			if err = s.chatTemplateRepo.Create(ctx, &template, tx); err != nil {
-				return err
-			}
-
-			// Set it as default (this will unset others)
-			return s.chatTemplateRepo.SetAsDefault(ctx, template.ID, template.DomainID, tx)
-		})
-	} else {
-		err = s.chatTemplateRepo.Create(ctx, &template, nil)
-	}
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		// Create the template first
+		if err := s.chatTemplateRepo.Create(ctx, &template, tx); err != nil {
+			return err
+		}
+
+		version := newVersion(template, 1, &contextValues.UserID, nil)
+		if err := s.chatTemplateVersionRepo.Create(ctx, &version, tx); err != nil {
+			return err
+		}
+
+		// If this template is being set as default, pin its first version (this will unset others)
+		if template.IsDefault {
+			template.PinnedVersionID = &version.ID
+			return s.chatTemplateRepo.SetAsDefault(ctx, template.ID, template.DomainID, version.ID, tx)
+		}
+		return nil
+	})
 
 	if err != nil {
 		return
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/modules/chat_template/dto/request"
+	"github.com/PhantomX7/dhamma/modules/chat_template/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/textdiff"
+)
+
+// DiffVersions implements chat_template.Service
+func (s *service) DiffVersions(ctx context.Context, templateID uint64, req request.ChatTemplateVersionDiffRequest) (
+	res response.ChatTemplateVersionDiffResponse, err error,
+) {
+	template, err := s.chatTemplateRepo.FindByID(ctx, templateID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, template.DomainID, "chat template", "compare versions of")
+	if err != nil {
+		return
+	}
+
+	from, err := s.chatTemplateVersionRepo.FindByVersion(ctx, template.ID, req.From)
+	if err != nil {
+		return
+	}
+
+	to, err := s.chatTemplateVersionRepo.FindByVersion(ctx, template.ID, req.To)
+	if err != nil {
+		return
+	}
+
+	res = response.ChatTemplateVersionDiffResponse{
+		TemplateID: template.ID,
+		From:       from.Version,
+		To:         to.Version,
+		Fields:     make([]string, 0),
+		Lines:      textdiff.Lines(from.Content, to.Content),
+	}
+	if from.Name != to.Name {
+		res.Fields = append(res.Fields, "name")
+	}
+	if !equalStringPointers(from.Description, to.Description) {
+		res.Fields = append(res.Fields, "description")
+	}
+	if from.Content != to.Content {
+		res.Fields = append(res.Fields, "content")
+	}
+
+	return
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/chat_template"
+	"github.com/PhantomX7/dhamma/modules/chat_template/dto/request"
+	"github.com/PhantomX7/dhamma/modules/chat_template/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/placeholder"
+)
+
+// Layouts of the event date and time variables.
+const (
+	eventDateLayout = "Monday, 02 January 2006"
+	eventTimeLayout = "15:04"
+)
+
+// Render fills in the template's placeholders with the details of its domain and of the requested follower and event.
+// Variables of a follower or event that was not requested are reported as missing.
+// A template pinned to a version renders that version's content.
+func (s *service) Render(ctx context.Context, templateID uint64, req request.ChatTemplateRenderRequest) (res response.ChatTemplateRenderResponse, err error) {
+	prepared, err := s.prepareRender(ctx, templateID, req.EventID)
+	if err != nil {
+		return
+	}
+
+	values := prepared.values
+	if req.FollowerID != nil {
+		var follower entity.Follower
+		follower, err = s.followerRepo.FindByID(ctx, *req.FollowerID)
+		if err != nil {
+			return
+		}
+
+		values, err = prepared.followerValues(follower)
+		if err != nil {
+			return
+		}
+	}
+
+	content, err := prepared.render(values)
+	if err != nil {
+		return
+	}
+
+	variables, _ := placeholder.Variables(prepared.source)
+	res = response.ChatTemplateRenderResponse{
+		TemplateID: prepared.template.ID,
+		Content:    content,
+		Variables:  variables,
+	}
+
+	return
+}
+
+// RenderForFollowers renders the template once per follower, in order, like Render with each follower in turn.
+// The template, its domain and the event are only loaded once, so large broadcasts do not query them per recipient.
+func (s *service) RenderForFollowers(ctx context.Context, templateID uint64, eventID *uint64, followers []entity.Follower) (contents []string, err error) {
+	prepared, err := s.prepareRender(ctx, templateID, eventID)
+	if err != nil {
+		return
+	}
+
+	contents = make([]string, 0, len(followers))
+	for _, follower := range followers {
+		var values map[string]string
+		values, err = prepared.followerValues(follower)
+		if err != nil {
+			return nil, err
+		}
+
+		var content string
+		content, err = prepared.render(values)
+		if err != nil {
+			return nil, err
+		}
+		contents = append(contents, content)
+	}
+
+	return
+}
+
+// preparedRender is a template with the values shared by every follower it is rendered for.
+type preparedRender struct {
+	template entity.ChatTemplate
+	source   string
+	values   map[string]string
+}
+
+// prepareRender loads the template and fills in the values of its domain and, if given, of the event.
+func (s *service) prepareRender(ctx context.Context, templateID uint64, eventID *uint64) (prepared preparedRender, err error) {
+	template, err := s.chatTemplateRepo.FindByID(ctx, templateID, "Domain", "PinnedVersion")
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, template.DomainID, "chat template", "render")
+	if err != nil {
+		return
+	}
+
+	source := template.Content
+	if template.PinnedVersion != nil {
+		source = template.PinnedVersion.Content
+	}
+
+	if err = validateContent(source); err != nil {
+		return
+	}
+
+	location := utility.LoadLocation(utility.DefaultTimezone)
+	values := make(map[string]string)
+	if template.Domain != nil {
+		location = template.Domain.Location()
+		values[chat_template.VariableDomainName] = template.Domain.Name
+	}
+
+	if eventID != nil {
+		var event entity.Event
+		event, err = s.eventRepo.FindByID(ctx, *eventID)
+		if err != nil {
+			return
+		}
+		if event.DomainID != template.DomainID {
+			return prepared, &errors.AppError{
+				Message: "event does not belong to the template's domain",
+				Status:  http.StatusBadRequest,
+			}
+		}
+
+		values[chat_template.VariableEventName] = event.Name
+		if startAt, ok := eventStart(event, time.Now()); ok {
+			values[chat_template.VariableEventDate] = startAt.In(location).Format(eventDateLayout)
+			values[chat_template.VariableEventTime] = startAt.In(location).Format(eventTimeLayout)
+		}
+	}
+
+	return preparedRender{template: template, source: source, values: values}, nil
+}
+
+// followerValues returns the shared values together with those of the follower.
+func (p preparedRender) followerValues(follower entity.Follower) (map[string]string, error) {
+	if follower.DomainID != p.template.DomainID {
+		return nil, &errors.AppError{
+			Message: "follower does not belong to the template's domain",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	values := make(map[string]string, len(p.values)+2)
+	for name, value := range p.values {
+		values[name] = value
+	}
+	values[chat_template.VariableFollowerName] = follower.Name
+	values[chat_template.VariableFollowerPoints] = strconv.Itoa(follower.Points)
+
+	return values, nil
+}
+
+// render fills in the template's placeholders with values.
+func (p preparedRender) render(values map[string]string) (string, error) {
+	content, err := placeholder.Render(p.source, values)
+	if err != nil {
+		return "", &errors.AppError{
+			Message: "cannot render template: " + err.Error(),
+			Status:  http.StatusBadRequest,
+			Err:     err,
+		}
+	}
+
+	return content, nil
+}
+
+// eventStart returns the start of the event's next occurrence, or of its first occurrence once all have passed.
+// Unscheduled events have no start.
+func eventStart(event entity.Event, now time.Time) (time.Time, bool) {
+	if !event.IsScheduled() {
+		return time.Time{}, false
+	}
+
+	if next := event.Occurrences(now, now.AddDate(10, 0, 0), 1); len(next) > 0 {
+		return next[0].StartAt, true
+	}
+
+	return *event.StartAt, true
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// RestoreVersion implements chat_template.Service. The old version's name, description and content
+// are written to the template as a new version, so the history in between is kept.
+func (s *service) RestoreVersion(ctx context.Context, templateID uint64, version int) (template entity.ChatTemplate, err error) {
+	template, err = s.chatTemplateRepo.FindByID(ctx, templateID)
+	if err != nil {
+		return
+	}
+
+	contextValues, err := utility.CheckDomainContext(ctx, template.DomainID, "chat template", "restore")
+	if err != nil {
+		return
+	}
+
+	restored, err := s.chatTemplateVersionRepo.FindByVersion(ctx, template.ID, version)
+	if err != nil {
+		return
+	}
+
+	// The version may use variables that have since been removed
+	if err = validateContent(restored.Content); err != nil {
+		return
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		// Lock the template so a concurrent update cannot take the version number
+		var err error
+		template, err = s.chatTemplateRepo.FindByIDForUpdate(ctx, template.ID, tx)
+		if err != nil {
+			return err
+		}
+
+		latest, err := s.latestVersion(ctx, template, tx)
+		if err != nil {
+			return err
+		}
+
+		template.Name = restored.Name
+		template.Description = restored.Description
+		template.Content = restored.Content
+
+		next := newVersion(template, latest.Version+1, &contextValues.UserID, &restored.Version)
+		if err = s.chatTemplateVersionRepo.Create(ctx, &next, tx); err != nil {
+			return err
+		}
+
+		return s.chatTemplateRepo.Update(ctx, &template, tx)
+	})
+	if err != nil {
+		return
+	}
+
+	return
+}
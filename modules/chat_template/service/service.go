@@ -3,20 +3,32 @@ package service
 import (
 	"github.com/PhantomX7/dhamma/libs/transaction_manager"
 	"github.com/PhantomX7/dhamma/modules/chat_template"
+	"github.com/PhantomX7/dhamma/modules/chat_template_version"
+	"github.com/PhantomX7/dhamma/modules/event"
+	"github.com/PhantomX7/dhamma/modules/follower"
 )
 
 type service struct {
-	chatTemplateRepo   chat_template.Repository
-	transactionManager transaction_manager.Client
+	chatTemplateRepo        chat_template.Repository
+	chatTemplateVersionRepo chat_template_version.Repository
+	followerRepo            follower.Repository
+	eventRepo               event.Repository
+	transactionManager      transaction_manager.Client
 }
 
 // New creates a new chat template service instance.
 func New(
 	chatTemplateRepo chat_template.Repository,
+	chatTemplateVersionRepo chat_template_version.Repository,
+	followerRepo follower.Repository,
+	eventRepo event.Repository,
 	transactionManager transaction_manager.Client,
 ) chat_template.Service {
 	return &service{
-		chatTemplateRepo:   chatTemplateRepo,
-		transactionManager: transactionManager,
+		chatTemplateRepo:        chatTemplateRepo,
+		chatTemplateVersionRepo: chatTemplateVersionRepo,
+		followerRepo:            followerRepo,
+		eventRepo:               eventRepo,
+		transactionManager:      transactionManager,
 	}
 }
@@ -3,12 +3,16 @@ package service
 import (
 	"context"
 
+	"gorm.io/gorm"
+
 	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/chat_template/dto/request"
 	"github.com/PhantomX7/dhamma/utility"
 )
 
-// SetAsDefault implements chat_template.Service
-func (s *service) SetAsDefault(ctx context.Context, templateID uint64) (template entity.ChatTemplate, err error) {
+// SetAsDefault implements chat_template.Service. The template is pinned to the requested version,
+// or to its latest one, and renders that version until it is set as default again.
+func (s *service) SetAsDefault(ctx context.Context, templateID uint64, req request.ChatTemplateSetDefaultRequest) (template entity.ChatTemplate, err error) {
 	template, err = s.chatTemplateRepo.FindByID(ctx, templateID)
 	if err != nil {
 		return
@@ -19,13 +23,38 @@ func (s *service) SetAsDefault(ctx context.Context, templateID uint64) (template
 		return
 	}
 
-	err = s.chatTemplateRepo.SetAsDefault(ctx, templateID, template.DomainID, nil)
+	var version entity.ChatTemplateVersion
+	if req.Version != nil {
+		version, err = s.chatTemplateVersionRepo.FindByVersion(ctx, template.ID, *req.Version)
+		if err != nil {
+			return
+		}
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		if req.Version == nil {
+			// Lock the template, since a template written before versioning gets its first version here
+			locked, err := s.chatTemplateRepo.FindByIDForUpdate(ctx, template.ID, tx)
+			if err != nil {
+				return err
+			}
+			if version, err = s.latestVersion(ctx, locked, tx); err != nil {
+				return err
+			}
+		}
+
+		if err := validateContent(version.Content); err != nil {
+			return err
+		}
+
+		return s.chatTemplateRepo.SetAsDefault(ctx, templateID, template.DomainID, version.ID, tx)
+	})
 	if err != nil {
 		return
 	}
 
 	// Refresh the template to get updated default status
-	template, err = s.chatTemplateRepo.FindByID(ctx, templateID)
+	template, err = s.chatTemplateRepo.FindByID(ctx, templateID, "PinnedVersion")
 	if err != nil {
 		return
 	}
@@ -9,7 +9,7 @@ import (
 
 // Show implements chat_template.Service
 func (s *service) Show(ctx context.Context, templateID uint64) (template entity.ChatTemplate, err error) {
-	template, err = s.chatTemplateRepo.FindByID(ctx, templateID, "Domain")
+	template, err = s.chatTemplateRepo.FindByID(ctx, templateID, "Domain", "PinnedVersion")
 	if err != nil {
 		return
 	}
@@ -11,39 +11,67 @@ import (
 	"github.com/PhantomX7/dhamma/utility"
 )
 
+// Update changes a chat template and records a new version when its name, description or content changes.
+// The default template keeps rendering its pinned version until it is set as default again,
+// which an update with is_default does by pinning the version it wrote.
 func (s *service) Update(ctx context.Context, templateID uint64, req request.ChatTemplateUpdateRequest) (template entity.ChatTemplate, err error) {
 	template, err = s.chatTemplateRepo.FindByID(ctx, templateID)
 	if err != nil {
 		return
 	}
 
-	_, err = utility.CheckDomainContext(ctx, template.DomainID, "chat template", "update")
+	contextValues, err := utility.CheckDomainContext(ctx, template.DomainID, "chat template", "update")
 	if err != nil {
 		return
 	}
 
-	// Store original default status
-	originalIsDefault := template.IsDefault
-
-	err = copier.Copy(&template, &req)
-	if err != nil {
-		return
+	if req.Content != nil {
+		if err = validateContent(*req.Content); err != nil {
+			return
+		}
 	}
 
-	// If IsDefault is being changed to true, handle the logic
-	if req.IsDefault != nil && *req.IsDefault && !originalIsDefault {
-		err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
-			// Update the template first
-			if err = s.chatTemplateRepo.Update(ctx, &template, tx); err != nil {
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		// Lock the template so concurrent updates number their versions one after the other,
+		// and keep the locked original to compare the versioned fields against
+		original, err := s.chatTemplateRepo.FindByIDForUpdate(ctx, templateID, tx)
+		if err != nil {
+			return err
+		}
+
+		template = original
+		if err = copier.Copy(&template, &req); err != nil {
+			return err
+		}
+
+		if !template.IsDefault {
+			template.PinnedVersionID = nil
+		}
+
+		version, err := s.latestVersion(ctx, original, tx)
+		if err != nil {
+			return err
+		}
+
+		if versionChanged(original, template) {
+			version = newVersion(template, version.Version+1, &contextValues.UserID, nil)
+			if err = s.chatTemplateVersionRepo.Create(ctx, &version, tx); err != nil {
 				return err
 			}
+		}
 
-			// Set it as default (this will unset others)
-			return s.chatTemplateRepo.SetAsDefault(ctx, template.ID, template.DomainID, tx)
-		})
-	} else {
-		err = s.chatTemplateRepo.Update(ctx, &template, nil)
-	}
+		// Update the template first
+		if err = s.chatTemplateRepo.Update(ctx, &template, tx); err != nil {
+			return err
+		}
+
+		// Set it as default pinned to the latest version (this will unset others)
+		if req.IsDefault != nil && *req.IsDefault {
+			template.PinnedVersionID = &version.ID
+			return s.chatTemplateRepo.SetAsDefault(ctx, template.ID, template.DomainID, version.ID, tx)
+		}
+		return nil
+	})
 
 	if err != nil {
 		return
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/chat_template"
+	"github.com/PhantomX7/dhamma/modules/chat_template/dto/request"
+	chatTemplateRepo "github.com/PhantomX7/dhamma/modules/chat_template/repository"
+	chatTemplateVersionRepo "github.com/PhantomX7/dhamma/modules/chat_template_version/repository"
+	eventRepo "github.com/PhantomX7/dhamma/modules/event/repository"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// chatTemplateFixture is a domain with a follower to render templates for.
+type chatTemplateFixture struct {
+	db       *gorm.DB
+	service  chat_template.Service
+	ctx      context.Context
+	domain   entity.Domain
+	follower entity.Follower
+}
+
+func newChatTemplateFixture(t *testing.T) chatTemplateFixture {
+	db := testdb.New(t, &entity.Domain{}, &entity.Follower{}, &entity.Event{}, &entity.ChatTemplate{}, &entity.ChatTemplateVersion{})
+	domain := testdb.Domain(t, db)
+
+	return chatTemplateFixture{
+		db:       db,
+		service:  New(chatTemplateRepo.New(db), chatTemplateVersionRepo.New(db), followerRepo.New(db), eventRepo.New(db), transaction_manager.New(db)),
+		ctx:      testdb.Context(domain),
+		domain:   domain,
+		follower: testdb.Follower(t, db, domain.ID),
+	}
+}
+
+func (f chatTemplateFixture) create(t *testing.T, content string, isDefault bool) entity.ChatTemplate {
+	t.Helper()
+
+	template, err := f.service.Create(f.ctx, request.ChatTemplateCreateRequest{
+		DomainID:  f.domain.ID,
+		Name:      "Reminder",
+		Content:   content,
+		IsDefault: &isDefault,
+	})
+	require.NoError(t, err)
+	return template
+}
+
+// versions returns the template's versions, oldest first.
+func (f chatTemplateFixture) versions(t *testing.T, templateID uint64) []entity.ChatTemplateVersion {
+	t.Helper()
+
+	var versions []entity.ChatTemplateVersion
+	require.NoError(t, f.db.Where("chat_template_id = ?", templateID).Order("version asc").Find(&versions).Error)
+	return versions
+}
+
+func (f chatTemplateFixture) render(t *testing.T, templateID uint64) string {
+	t.Helper()
+
+	res, err := f.service.Render(f.ctx, templateID, request.ChatTemplateRenderRequest{FollowerID: &f.follower.ID})
+	require.NoError(t, err)
+	return res.Content
+}
+
+func TestUpdate_ChangeRecordsVersion(t *testing.T) {
+	f := newChatTemplateFixture(t)
+	template := f.create(t, "Hi {{follower.name}}", false)
+
+	_, err := f.service.Update(f.ctx, template.ID, request.ChatTemplateUpdateRequest{Content: utility.PointOf("Hello {{follower.name}}")})
+	require.NoError(t, err)
+
+	versions := f.versions(t, template.ID)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "Hi {{follower.name}}", versions[0].Content)
+	assert.Equal(t, 2, versions[1].Version)
+	assert.Equal(t, "Hello {{follower.name}}", versions[1].Content)
+	assert.Equal(t, uint64(1), *versions[1].CreatedBy)
+}
+
+func TestUpdate_UnchangedFieldsRecordNoVersion(t *testing.T) {
+	f := newChatTemplateFixture(t)
+	template := f.create(t, "Hi {{follower.name}}", false)
+
+	// Neither the same content nor a field that is not versioned makes a new version
+	_, err := f.service.Update(f.ctx, template.ID, request.ChatTemplateUpdateRequest{
+		Name:     utility.PointOf("Reminder"),
+		Content:  utility.PointOf("Hi {{follower.name}}"),
+		IsActive: utility.PointOf(false),
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, f.versions(t, template.ID), 1)
+}
+
+func TestRestoreVersion_WritesTheOldContentAsNewVersion(t *testing.T) {
+	f := newChatTemplateFixture(t)
+	template := f.create(t, "Hi {{follower.name}}", false)
+
+	_, err := f.service.Update(f.ctx, template.ID, request.ChatTemplateUpdateRequest{Content: utility.PointOf("Hello {{follower.name}}")})
+	require.NoError(t, err)
+
+	restored, err := f.service.RestoreVersion(f.ctx, template.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Hi {{follower.name}}", restored.Content)
+
+	// The version in between is kept
+	versions := f.versions(t, template.ID)
+	require.Len(t, versions, 3)
+	assert.Equal(t, "Hello {{follower.name}}", versions[1].Content)
+	assert.Equal(t, "Hi {{follower.name}}", versions[2].Content)
+	require.NotNil(t, versions[2].RestoredFrom)
+	assert.Equal(t, 1, *versions[2].RestoredFrom)
+
+	assert.Equal(t, "Hi Budi", f.render(t, template.ID))
+}
+
+func TestRender_DefaultUsesItsPinnedVersion(t *testing.T) {
+	f := newChatTemplateFixture(t)
+	template := f.create(t, "Hi {{follower.name}}", true)
+
+	// Editing the default does not change what it renders until it is set as default again
+	_, err := f.service.Update(f.ctx, template.ID, request.ChatTemplateUpdateRequest{Content: utility.PointOf("Bye {{follower.name}}")})
+	require.NoError(t, err)
+	assert.Equal(t, "Hi Budi", f.render(t, template.ID))
+
+	_, err = f.service.SetAsDefault(f.ctx, template.ID, request.ChatTemplateSetDefaultRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "Bye Budi", f.render(t, template.ID))
+
+	// An older version can be pinned too
+	_, err = f.service.SetAsDefault(f.ctx, template.ID, request.ChatTemplateSetDefaultRequest{Version: utility.PointOf(1)})
+	require.NoError(t, err)
+	assert.Equal(t, "Hi Budi", f.render(t, template.ID))
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/modules/chat_template"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/placeholder"
+)
+
+// validateContent rejects template content with malformed placeholders or unsupported variables.
+func validateContent(content string) error {
+	if err := placeholder.Validate(content, chat_template.Variables); err != nil {
+		return &errors.AppError{
+			Message: "invalid template content: " + err.Error(),
+			Status:  http.StatusBadRequest,
+			Err:     err,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// latestVersion returns the template's latest version. A template written before versioning
+// has none, so its current state is recorded as version 1 first.
+func (s *service) latestVersion(ctx context.Context, template entity.ChatTemplate, tx *gorm.DB) (entity.ChatTemplateVersion, error) {
+	version, found, err := s.chatTemplateVersionRepo.FindLatest(ctx, template.ID, tx)
+	if err != nil || found {
+		return version, err
+	}
+
+	version = newVersion(template, 1, nil, nil)
+	version.CreatedAt = template.UpdatedAt
+	err = s.chatTemplateVersionRepo.Create(ctx, &version, tx)
+	return version, err
+}
+
+// newVersion snapshots the template's name, description and content.
+func newVersion(template entity.ChatTemplate, number int, createdBy *uint64, restoredFrom *int) entity.ChatTemplateVersion {
+	return entity.ChatTemplateVersion{
+		ChatTemplateID: template.ID,
+		DomainID:       template.DomainID,
+		Version:        number,
+		Name:           template.Name,
+		Description:    template.Description,
+		Content:        template.Content,
+		RestoredFrom:   restoredFrom,
+		CreatedBy:      createdBy,
+	}
+}
+
+// versionChanged reports whether any versioned field differs between the two templates.
+func versionChanged(before entity.ChatTemplate, after entity.ChatTemplate) bool {
+	return before.Name != after.Name ||
+		before.Content != after.Content ||
+		!equalStringPointers(before.Description, after.Description)
+}
+
+func equalStringPointers(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// Versions implements chat_template.Service
+func (s *service) Versions(ctx context.Context, templateID uint64, pg *pagination.Pagination) (
+	versions []entity.ChatTemplateVersion, meta utility.PaginationMeta, err error,
+) {
+	template, err := s.chatTemplateRepo.FindByID(ctx, templateID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, template.DomainID, "chat template", "list versions of")
+	if err != nil {
+		return
+	}
+
+	pg.AddCustomScope(func(db *gorm.DB) *gorm.DB {
+		return db.Where("chat_template_versions.chat_template_id = ?", template.ID)
+	})
+
+	versions, err = s.chatTemplateVersionRepo.FindAll(ctx, pg)
+	if err != nil {
+		return
+	}
+
+	count, err := s.chatTemplateVersionRepo.Count(ctx, pg)
+	if err != nil {
+		return
+	}
+
+	meta.Limit = pg.Limit
+	meta.Offset = pg.Offset
+	meta.Total = count
+
+	return
+}
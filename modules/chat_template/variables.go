@@ -0,0 +1,21 @@
+package chat_template
+
+// Variables available to chat template placeholders, written as {{follower.name}}.
+const (
+	VariableFollowerName   = "follower.name"
+	VariableFollowerPoints = "follower.points"
+	VariableEventName      = "event.name"
+	VariableEventDate      = "event.date" // Date of the next occurrence in the domain's timezone
+	VariableEventTime      = "event.time" // Start time of the next occurrence in the domain's timezone
+	VariableDomainName     = "domain.name"
+)
+
+// Variables lists every variable a chat template may use.
+var Variables = []string{
+	VariableFollowerName,
+	VariableFollowerPoints,
+	VariableEventName,
+	VariableEventDate,
+	VariableEventTime,
+	VariableDomainName,
+}
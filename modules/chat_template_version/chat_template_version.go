@@ -0,0 +1,18 @@
+package chat_template_version
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.ChatTemplateVersion]
+	// FindLatest returns the latest version of a chat template and reports false if it has none yet.
+	FindLatest(ctx context.Context, chatTemplateID uint64, tx *gorm.DB) (entity.ChatTemplateVersion, bool, error)
+	// FindByVersion returns a version of a chat template by its number.
+	FindByVersion(ctx context.Context, chatTemplateID uint64, version int) (entity.ChatTemplateVersion, error)
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	customErrors "github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// FindByVersion returns a version of a chat template by its number.
+func (r *repository) FindByVersion(ctx context.Context, chatTemplateID uint64, version int) (entity.ChatTemplateVersion, error) {
+	var templateVersion entity.ChatTemplateVersion
+	err := r.db.WithContext(ctx).
+		Where("chat_template_id = ? AND version = ?", chatTemplateID, version).
+		Take(&templateVersion).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return templateVersion, &customErrors.AppError{
+			Message: "chat template version not found",
+			Err:     customErrors.ErrNotFound,
+			Status:  http.StatusNotFound,
+		}
+	}
+	if err != nil {
+		return templateVersion, err
+	}
+
+	return templateVersion, nil
+}
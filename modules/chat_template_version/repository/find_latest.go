@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindLatest returns the latest version of a chat template and reports false if it has none yet.
+func (r *repository) FindLatest(ctx context.Context, chatTemplateID uint64, tx *gorm.DB) (entity.ChatTemplateVersion, bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	var version entity.ChatTemplateVersion
+	err := db.WithContext(ctx).
+		Where("chat_template_id = ?", chatTemplateID).
+		Order("version desc").
+		Take(&version).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return version, false, nil
+	}
+	if err != nil {
+		return version, false, err
+	}
+
+	return version, true, nil
+}
@@ -4,15 +4,22 @@ import (
 	"go.uber.org/fx"
 
 	authController "github.com/PhantomX7/dhamma/modules/auth/controller"
+	bloodDonationController "github.com/PhantomX7/dhamma/modules/blood_donation/controller"
+	campaignController "github.com/PhantomX7/dhamma/modules/campaign/controller"
 	chatTemplateController "github.com/PhantomX7/dhamma/modules/chat_template/controller"
 	cronController "github.com/PhantomX7/dhamma/modules/cron/controller"
 	domainController "github.com/PhantomX7/dhamma/modules/domain/controller"
 	eventController "github.com/PhantomX7/dhamma/modules/event/controller"
 	eventAttendanceController "github.com/PhantomX7/dhamma/modules/event_attendance/controller"
 	followerController "github.com/PhantomX7/dhamma/modules/follower/controller"
+	followerSegmentController "github.com/PhantomX7/dhamma/modules/follower_segment/controller"
 	healthController "github.com/PhantomX7/dhamma/modules/health/controller"
+	outboundMessageController "github.com/PhantomX7/dhamma/modules/outbound_message/controller"
 	permissionController "github.com/PhantomX7/dhamma/modules/permission/controller"
 	pointMutationController "github.com/PhantomX7/dhamma/modules/point_mutation/controller"
+	portalController "github.com/PhantomX7/dhamma/modules/portal/controller"
+	rewardController "github.com/PhantomX7/dhamma/modules/reward/controller"
+	rewardRedemptionController "github.com/PhantomX7/dhamma/modules/reward_redemption/controller"
 	roleController "github.com/PhantomX7/dhamma/modules/role/controller"
 	userController "github.com/PhantomX7/dhamma/modules/user/controller"
 )
@@ -20,15 +27,22 @@ import (
 var ControllerModule = fx.Options(
 	fx.Provide(
 		authController.New,
+		bloodDonationController.New,
+		campaignController.New,
 		chatTemplateController.New,
 		cronController.New,
 		domainController.New,
 		eventController.New,
 		eventAttendanceController.New,
 		followerController.New,
+		followerSegmentController.New,
 		healthController.New,
+		outboundMessageController.New,
 		permissionController.New,
 		pointMutationController.New,
+		portalController.New,
+		rewardController.New,
+		rewardRedemptionController.New,
 		roleController.New,
 		userController.New,
 	),
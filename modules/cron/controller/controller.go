@@ -25,6 +25,40 @@ func New(cronService cron.Service) gocron.Scheduler {
 	if err != nil {
 		logger.Get().Panic("error creating cron job for clear refresh token", zap.Error(err))
 	}
+
+	_, err = s.NewJob(
+		gocron.DailyJob(1, gocron.NewAtTimes(gocron.NewAtTime(2, 0, 0))),
+		gocron.NewTask(cronService.ReconcilePoints),
+	)
+	if err != nil {
+		logger.Get().Panic("error creating cron job for reconcile points", zap.Error(err))
+	}
+
+	_, err = s.NewJob(
+		gocron.DurationJob(30*time.Second),
+		gocron.NewTask(cronService.ProcessOutbox),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+	if err != nil {
+		logger.Get().Panic("error creating cron job for process outbox", zap.Error(err))
+	}
+
+	_, err = s.NewJob(
+		gocron.DurationJob(time.Minute),
+		gocron.NewTask(cronService.RunCampaigns),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+	if err != nil {
+		logger.Get().Panic("error creating cron job for run campaigns", zap.Error(err))
+	}
+
+	_, err = s.NewJob(
+		gocron.DailyJob(1, gocron.NewAtTimes(gocron.NewAtTime(0, 5, 0))),
+		gocron.NewTask(cronService.ExpireCards),
+	)
+	if err != nil {
+		logger.Get().Panic("error creating cron job for expire cards", zap.Error(err))
+	}
 	// each job has a unique id
 
 	return s
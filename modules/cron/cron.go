@@ -2,4 +2,8 @@ package cron
 
 type Service interface {
 	ClearRefreshToken() error
+	ReconcilePoints() error
+	ProcessOutbox() error
+	RunCampaigns() error
+	ExpireCards() error
 }
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/utility/logger"
+	"go.uber.org/zap"
+)
+
+// ExpireCards marks the cards past their expiry date as expired so card lists show their real status.
+// Check-ins already reject such cards by their expiry date.
+func (u *service) ExpireCards() (err error) {
+	expired, err := u.cardRepo.ExpireDue(context.Background(), time.Now())
+	if err != nil {
+		logger.Get().Error("failed to expire cards", zap.Error(err))
+		return
+	}
+
+	if expired > 0 {
+		logger.Get().Info("cards expired", zap.Int64("cards", expired))
+	}
+	return
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/utility/logger"
+	"go.uber.org/zap"
+)
+
+// ProcessOutbox sends the outbound messages that are due.
+func (u *service) ProcessOutbox() (err error) {
+	result, err := u.outboundMessageService.ProcessOutbox(context.Background())
+	if err != nil {
+		logger.Get().Error("failed to process outbox", zap.Error(err))
+		return
+	}
+
+	if result.Sent+result.Retried+result.Failed > 0 {
+		logger.Get().Info("outbox processed",
+			zap.Int("sent", result.Sent),
+			zap.Int("retried", result.Retried),
+			zap.Int("failed", result.Failed),
+		)
+	}
+	return
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/request"
+	"github.com/PhantomX7/dhamma/utility/logger"
+	"go.uber.org/zap"
+)
+
+// ReconcilePoints checks the points ledger of every domain and logs the drift it finds.
+// It only reports; repairing is left to root through the admin reconcile route.
+func (u *service) ReconcilePoints() (err error) {
+	report, err := u.pointMutationService.Reconcile(context.Background(), request.PointReconcileRequest{})
+	if err != nil {
+		return
+	}
+
+	for _, domain := range report.Domains {
+		logger.Get().Warn("points ledger drift detected",
+			zap.Uint64("domain_id", domain.DomainID),
+			zap.Int("followers", domain.Followers),
+			zap.Int("total_drift", domain.TotalDrift),
+		)
+	}
+	return
+}
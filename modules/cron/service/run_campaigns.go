@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/utility/logger"
+	"go.uber.org/zap"
+)
+
+// RunCampaigns queues the messages of the campaigns that are due.
+func (u *service) RunCampaigns() (err error) {
+	ran, err := u.campaignService.RunDue(context.Background())
+	if err != nil {
+		logger.Get().Error("failed to run campaigns", zap.Error(err))
+		return
+	}
+
+	if ran > 0 {
+		logger.Get().Info("campaigns run", zap.Int("campaigns", ran))
+	}
+	return
+}
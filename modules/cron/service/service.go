@@ -1,18 +1,34 @@
 package service
 
 import (
+	"github.com/PhantomX7/dhamma/modules/campaign"
+	"github.com/PhantomX7/dhamma/modules/card"
 	"github.com/PhantomX7/dhamma/modules/cron"
+	"github.com/PhantomX7/dhamma/modules/outbound_message"
+	"github.com/PhantomX7/dhamma/modules/point_mutation"
 	"github.com/PhantomX7/dhamma/modules/refresh_token"
 )
 
 type service struct {
-	refreshTokenRepo refresh_token.Repository
+	refreshTokenRepo       refresh_token.Repository
+	pointMutationService   point_mutation.Service
+	outboundMessageService outbound_message.Service
+	campaignService        campaign.Service
+	cardRepo               card.Repository
 }
 
 func New(
 	refreshTokenRepo refresh_token.Repository,
+	pointMutationService point_mutation.Service,
+	outboundMessageService outbound_message.Service,
+	campaignService campaign.Service,
+	cardRepo card.Repository,
 ) cron.Service {
 	return &service{
-		refreshTokenRepo: refreshTokenRepo,
+		refreshTokenRepo:       refreshTokenRepo,
+		pointMutationService:   pointMutationService,
+		outboundMessageService: outboundMessageService,
+		campaignService:        campaignService,
+		cardRepo:               cardRepo,
 	}
 }
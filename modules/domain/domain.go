@@ -14,6 +14,8 @@ import (
 
 type Repository interface {
 	repository.BaseRepositoryInterface[entity.Domain]
+	// NextCardSequence increments the domain's card sequence and returns the new value.
+	NextCardSequence(ctx context.Context, domainID uint64) (uint64, error)
 }
 
 type Service interface {
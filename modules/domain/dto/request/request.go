@@ -7,13 +7,21 @@ type DomainCreateRequest struct {
 	Code        string `json:"code" form:"code" binding:"required,unique=domains.code"`
 	Description string `json:"description" form:"description"`
 	IsActive    *bool  `json:"is_active" form:"is_active" binding:"required"`
+	Timezone    string `json:"timezone" form:"timezone" binding:"omitempty,timezone"`
+	// BloodDonationIntervalDays defaults to entity.DefaultBloodDonationIntervalDays
+	BloodDonationIntervalDays int `json:"blood_donation_interval_days" form:"blood_donation_interval_days" binding:"omitempty,min=1,max=365"`
+	// CardCodeFormat defaults to cardcode.DefaultFormat
+	CardCodeFormat string `json:"card_code_format" form:"card_code_format" binding:"omitempty,card_code_format"`
 }
 
 type DomainUpdateRequest struct {
-	Name        *string `json:"name" form:"name" binding:"omitempty,unique=domains.name"`
-	Code        *string `json:"code" form:"code" binding:"omitempty,unique=domains.code"`
-	Description *string `json:"description" form:"description"`
-	IsActive    *bool   `json:"is_active" form:"is_active"`
+	Name                      *string `json:"name" form:"name" binding:"omitempty,unique=domains.name"`
+	Code                      *string `json:"code" form:"code" binding:"omitempty,unique=domains.code"`
+	Description               *string `json:"description" form:"description"`
+	IsActive                  *bool   `json:"is_active" form:"is_active"`
+	Timezone                  *string `json:"timezone" form:"timezone" binding:"omitempty,timezone"`
+	BloodDonationIntervalDays *int    `json:"blood_donation_interval_days" form:"blood_donation_interval_days" binding:"omitempty,min=1,max=365"`
+	CardCodeFormat            *string `json:"card_code_format" form:"card_code_format" binding:"omitempty,card_code_format"`
 }
 
 func NewDomainPagination(conditions map[string][]string) *pagination.Pagination {
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// NextCardSequence increments the domain's card sequence and returns the new value.
+// The increment and the read share a transaction so concurrent callers never get the same number.
+func (r *repository) NextCardSequence(ctx context.Context, domainID uint64) (sequence uint64, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("UPDATE domains SET card_sequence = card_sequence + 1 WHERE id = ?", domainID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Raw("SELECT card_sequence FROM domains WHERE id = ?", domainID).Scan(&sequence).Error
+	})
+	return
+}
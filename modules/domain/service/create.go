@@ -6,6 +6,7 @@ import (
 
 	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/domain/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
 )
 
 func (s *service) Create(ctx context.Context, request request.DomainCreateRequest) (domain entity.Domain, err error) {
@@ -18,6 +19,13 @@ func (s *service) Create(ctx context.Context, request request.DomainCreateReques
 		return
 	}
 
+	if domain.Timezone == "" {
+		domain.Timezone = utility.DefaultTimezone
+	}
+	if domain.BloodDonationIntervalDays == 0 {
+		domain.BloodDonationIntervalDays = entity.DefaultBloodDonationIntervalDays
+	}
+
 	err = s.domainRepo.Create(ctx, &domain, nil)
 	if err != nil {
 		return
@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// AttendBulk handles the HTTP POST request uploading a batch of offline check-ins.
+// Expected route: POST /events/:id/attend-bulk
+func (ctrl *controller) AttendBulk(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid event id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req request.EventBulkAttendRequest
+	if err = ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := ctrl.eventService.AttendBulk(ctx.Request.Context(), eventID, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
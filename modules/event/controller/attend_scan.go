@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/gin-gonic/gin"
+)
+
+// AttendScan handles the HTTP POST request checking in a follower by the QR code printed on their card.
+// Expected route: POST /events/:event_id/attend-scan
+func (ctrl *controller) AttendScan(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var req request.EventAttendScanRequest
+	if err = ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := ctrl.eventService.AttendScan(ctx.Request.Context(), eventID, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -0,0 +1,27 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/gin-gonic/gin"
+)
+
+// CheckInCode handles the HTTP GET request returning the current self check-in code of an event.
+// Expected route: GET /events/:event_id/check-in-code
+func (ctrl *controller) CheckInCode(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := ctrl.eventService.CheckInCode(ctx.Request.Context(), eventID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
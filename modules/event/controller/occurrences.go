@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Occurrences handles the HTTP GET request listing the occurrences of an event in a date range.
+// Expected route: GET /events/:id/occurrences?from=2006-01-02&to=2006-01-31
+func (c *controller) Occurrences(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid event id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req request.EventOccurrenceRequest
+	if err = ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.eventService.Occurrences(ctx.Request.Context(), eventID, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
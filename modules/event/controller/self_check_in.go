@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/gin-gonic/gin"
+)
+
+// SelfCheckIn handles the HTTP POST request a follower sends to check themselves in to an event.
+// Expected route: POST /:domain_code/events/:event_id/self-check-in
+func (ctrl *controller) SelfCheckIn(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var req request.EventSelfCheckInRequest
+	if err = ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.IPAddress = ctx.ClientIP()
+
+	res, err := ctrl.eventService.SelfCheckIn(ctx.Request.Context(), ctx.Param("domain_code"), eventID, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -1,18 +1,40 @@
 package request
 
-import "github.com/PhantomX7/dhamma/utility/pagination"
+import (
+	"time"
+
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
 
 type EventCreateRequest struct {
-	DomainID      uint64 `json:"domain_id" form:"domain_id" binding:"required,exist=domains.id"`
-	Name          string `json:"name" form:"name" binding:"required"`
-	Description   string `json:"description" form:"description"`
-	PointsAwarded int    `json:"points_awarded" form:"points_awarded" binding:"required"`
+	DomainID           uint64     `json:"domain_id" form:"domain_id" binding:"required,exist=domains.id"`
+	Name               string     `json:"name" form:"name" binding:"required"`
+	Description        string     `json:"description" form:"description"`
+	PointsAwarded      int        `json:"points_awarded" form:"points_awarded" binding:"required"`
+	StartAt            *time.Time `json:"start_at" form:"start_at" binding:"omitempty"` // Leave start_at and end_at empty for an unscheduled event
+	EndAt              *time.Time `json:"end_at" form:"end_at" binding:"omitempty"`
+	Recurrence         *string    `json:"recurrence" form:"recurrence" binding:"omitempty,oneof=daily weekly monthly"`
+	RecurrenceUntil    *time.Time `json:"recurrence_until" form:"recurrence_until" binding:"omitempty"`
+	CheckInOpensBefore int        `json:"check_in_opens_before" form:"check_in_opens_before" binding:"omitempty,min=0"`
+	CheckInClosesAfter int        `json:"check_in_closes_after" form:"check_in_closes_after" binding:"omitempty,min=0"`
 }
 
 type EventUpdateRequest struct {
-	Name          *string `json:"name" form:"name" binding:"omitempty"`
-	Description   *string `json:"description" form:"description" binding:"omitempty"`
-	PointsAwarded *int    `json:"points_awarded" form:"points_awarded" binding:"omitempty"`
+	Name               *string    `json:"name" form:"name" binding:"omitempty"`
+	Description        *string    `json:"description" form:"description" binding:"omitempty"`
+	PointsAwarded      *int       `json:"points_awarded" form:"points_awarded" binding:"omitempty"`
+	StartAt            *time.Time `json:"start_at" form:"start_at" binding:"omitempty"`
+	EndAt              *time.Time `json:"end_at" form:"end_at" binding:"omitempty"`
+	Recurrence         *string    `json:"recurrence" form:"recurrence" binding:"omitempty,oneof=daily weekly monthly none"` // "none" removes the recurrence
+	RecurrenceUntil    *time.Time `json:"recurrence_until" form:"recurrence_until" binding:"omitempty"`
+	CheckInOpensBefore *int       `json:"check_in_opens_before" form:"check_in_opens_before" binding:"omitempty,min=0"`
+	CheckInClosesAfter *int       `json:"check_in_closes_after" form:"check_in_closes_after" binding:"omitempty,min=0"`
+}
+
+// EventOccurrenceRequest defines the date range for listing the occurrences of an event.
+type EventOccurrenceRequest struct {
+	From time.Time `json:"from" form:"from" binding:"required" time_format:"2006-01-02"`
+	To   time.Time `json:"to" form:"to" binding:"required,gtefield=From" time_format:"2006-01-02"`
 }
 
 // EventAttendRequest defines the payload for a follower attending an event.
@@ -20,11 +42,37 @@ type EventAttendRequest struct {
 	CardCode string `json:"card_code" form:"card_code" binding:"required,exist=cards.code"`
 }
 
+// EventAttendScanRequest defines the payload for a follower attending an event by scanning their card's QR code.
+type EventAttendScanRequest struct {
+	Payload string `json:"payload" form:"payload" binding:"required,max=255"`
+}
+
+// EventSelfCheckInRequest defines the payload for a follower checking themselves in with the event's displayed code.
+// The last digits of the follower's phone number prove the card is theirs.
+type EventSelfCheckInRequest struct {
+	Code            string `json:"code" form:"code" binding:"required,max=64"`
+	CardCode        string `json:"card_code" form:"card_code" binding:"required,max=100"`
+	PhoneLastDigits string `json:"phone_last_digits" form:"phone_last_digits" binding:"required,len=4,numeric"`
+	IPAddress       string `json:"-" form:"-"` // Set by the controller, never bound from the request
+}
+
 // EventAttendRequest defines the payload for a follower attending an event.
 type EventAttendByIDRequest struct {
 	FollowerID uint64 `json:"follower_id" form:"follower_id" binding:"required,exist=followers.id"`
 }
 
+// EventBulkAttendRequest defines the payload for uploading a batch of offline check-ins.
+type EventBulkAttendRequest struct {
+	Items []EventBulkAttendItem `json:"items" form:"items" binding:"required,min=1,max=500,dive"`
+}
+
+// EventBulkAttendItem is a single offline check-in, identified by either a card code or a follower ID.
+type EventBulkAttendItem struct {
+	CardCode   *string   `json:"card_code" form:"card_code" binding:"required_without=FollowerID"`
+	FollowerID *uint64   `json:"follower_id" form:"follower_id" binding:"required_without=CardCode"`
+	AttendedAt time.Time `json:"attended_at" form:"attended_at" binding:"required"`
+}
+
 func NewEventPagination(conditions map[string][]string) *pagination.Pagination {
 	filterDef := pagination.NewFilterDefinition().
 		AddFilter("name", pagination.FilterConfig{
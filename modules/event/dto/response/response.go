@@ -0,0 +1,45 @@
+package response
+
+import (
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Statuses reported for each item of a bulk attendance upload.
+const (
+	BulkAttendStatusCreated         = "created"
+	BulkAttendStatusDuplicate       = "duplicate"
+	BulkAttendStatusUnknownCard     = "unknown_card"
+	BulkAttendStatusUnknownFollower = "unknown_follower"
+	BulkAttendStatusWrongDomain     = "wrong_domain"
+	BulkAttendStatusOutsideWindow   = "outside_window"
+	BulkAttendStatusInactiveCard    = "inactive_card"
+	BulkAttendStatusInvalid         = "invalid"
+	BulkAttendStatusFailed          = "failed"
+)
+
+// EventBulkAttendResult is the outcome of a single bulk attendance item.
+type EventBulkAttendResult struct {
+	Index           int                     `json:"index"`
+	CardCode        *string                 `json:"card_code,omitempty"`
+	FollowerID      *uint64                 `json:"follower_id,omitempty"`
+	Status          string                  `json:"status"`
+	Message         string                  `json:"message,omitempty"`
+	EventAttendance *entity.EventAttendance `json:"event_attendance,omitempty"`
+}
+
+// EventBulkAttendResponse summarises a bulk attendance upload.
+type EventBulkAttendResponse struct {
+	Total   int                     `json:"total"`
+	Created int                     `json:"created"`
+	Results []EventBulkAttendResult `json:"results"`
+}
+
+// EventCheckInCode is the rotating code an event displays as a QR code for self check-in.
+type EventCheckInCode struct {
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// Period is how many seconds each code is displayed for
+	Period int `json:"period"`
+}
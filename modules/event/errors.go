@@ -0,0 +1,13 @@
+package event
+
+import "errors"
+
+// Attendance rule violations. They are wrapped in an AppError so callers can tell them apart with errors.Is.
+var (
+	ErrAttendanceWrongDomain   = errors.New("cannot attend event in another domain")
+	ErrAttendanceOutsideWindow = errors.New("event is not open for check-in at this time")
+	ErrAttendanceDuplicate     = errors.New("follower has already attended the event")
+	ErrAttendanceInactiveCard  = errors.New("card is not active")
+	ErrSelfCheckInReplayed     = errors.New("check-in code has already been used")
+	ErrSelfCheckInMismatch     = errors.New("card code and phone number do not match")
+)
@@ -2,9 +2,11 @@ package event
 
 import (
 	"context"
+	"time"
 
 	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	"github.com/PhantomX7/dhamma/modules/event/dto/response"
 	"github.com/PhantomX7/dhamma/utility"
 	"github.com/PhantomX7/dhamma/utility/pagination"
 	"github.com/PhantomX7/dhamma/utility/repository"
@@ -13,7 +15,8 @@ import (
 
 type Repository interface {
 	repository.BaseRepositoryInterface[entity.Event]
-	// Add other repository methods if needed
+	// FindScheduled returns the scheduled events of a domain that may have an occurrence starting between from and to.
+	FindScheduled(ctx context.Context, domainID uint64, from time.Time, to time.Time) ([]entity.Event, error)
 }
 
 type Service interface {
@@ -22,7 +25,12 @@ type Service interface {
 	Create(ctx context.Context, request request.EventCreateRequest) (entity.Event, error)
 	Update(ctx context.Context, eventID uint64, request request.EventUpdateRequest) (entity.Event, error)
 	Attend(ctx context.Context, eventID uint64, req request.EventAttendRequest) (entity.EventAttendance, error)
+	AttendScan(ctx context.Context, eventID uint64, req request.EventAttendScanRequest) (entity.EventAttendance, error)
 	AttendById(ctx context.Context, eventID uint64, req request.EventAttendByIDRequest) (entity.EventAttendance, error)
+	CheckInCode(ctx context.Context, eventID uint64) (response.EventCheckInCode, error)
+	SelfCheckIn(ctx context.Context, domainCode string, eventID uint64, req request.EventSelfCheckInRequest) (entity.EventAttendance, error)
+	AttendBulk(ctx context.Context, eventID uint64, req request.EventBulkAttendRequest) (response.EventBulkAttendResponse, error)
+	Occurrences(ctx context.Context, eventID uint64, req request.EventOccurrenceRequest) ([]entity.EventOccurrence, error)
 }
 
 type Controller interface {
@@ -31,5 +39,10 @@ type Controller interface {
 	Create(c *gin.Context)
 	Update(c *gin.Context)
 	Attend(c *gin.Context)
+	AttendScan(c *gin.Context)
 	AttendById(c *gin.Context)
+	CheckInCode(c *gin.Context)
+	SelfCheckIn(c *gin.Context)
+	AttendBulk(c *gin.Context)
+	Occurrences(c *gin.Context)
 }
@@ -12,13 +12,19 @@ type permission struct {
 	Update string
 	// Attend an event
 	Attend string
+	// Upload a batch of offline event check-ins
+	AttendBulk string
+	// Display the rotating self check-in code of an event
+	CheckInCode string
 }
 
 var Permissions = permission{
-	Key:    "event",
-	Index:  "index",
-	Show:   "show",
-	Create: "create",
-	Update: "update",
-	Attend: "attend",
+	Key:         "event",
+	Index:       "index",
+	Show:        "show",
+	Create:      "create",
+	Update:      "update",
+	Attend:      "attend",
+	AttendBulk:  "attend-bulk",
+	CheckInCode: "check-in-code",
 }
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindScheduled returns the scheduled events of a domain that may have an occurrence starting between from and to.
+// One-off events must not have ended before from; recurring events must not have stopped recurring before it.
+// Callers expand the candidates with entity.Event.Occurrences to get the exact occurrences.
+func (r *repository) FindScheduled(ctx context.Context, domainID uint64, from time.Time, to time.Time) ([]entity.Event, error) {
+	var events []entity.Event
+	err := r.db.WithContext(ctx).
+		Where("domain_id = ? AND start_at IS NOT NULL AND end_at IS NOT NULL AND start_at < ?", domainID, to).
+		Where(
+			r.db.Where("recurrence IS NULL AND end_at >= ?", from).
+				Or("recurrence IS NOT NULL AND (recurrence_until IS NULL OR recurrence_until >= ?)", from),
+		).
+		Order("start_at").
+		Find(&events).Error
+
+	return events, err
+}
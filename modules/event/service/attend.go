@@ -2,15 +2,13 @@ package service
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 	"time"
 
+	"gorm.io/gorm"
+
 	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/event/dto/request"
 	"github.com/PhantomX7/dhamma/utility"
-	"github.com/PhantomX7/dhamma/utility/errors"
-	"gorm.io/gorm"
 )
 
 // Attend handles the logic for a follower attending an event and receiving points.
@@ -30,77 +28,27 @@ func (s *service) Attend(ctx context.Context, eventID uint64, req request.EventA
 		return
 	}
 
-	follower, err := s.followerRepo.FindByID(ctx, card.FollowerID)
-	if err != nil {
-		return
-	}
+	return s.attendWithCard(ctx, event, card, time.Now(), nil)
+}
 
-	// 4. Check if follower has already attended this event
-	atendded, err := s.eventAttendanceRepo.HasAttendedOnDate(
-		ctx,
-		follower.ID,
-		event.ID,
-		time.Now(),
-	)
-	if err != nil {
+// attendWithCard checks in the owner of card, rejecting cards that are not active.
+// It is shared by the staff and self check-in flows, which authorise the request in their own way first.
+// beforeRecord is passed on to recordAttendance.
+func (s *service) attendWithCard(
+	ctx context.Context,
+	event entity.Event,
+	card entity.Card,
+	at time.Time,
+	beforeRecord func(tx *gorm.DB) error,
+) (eventAttendance entity.EventAttendance, err error) {
+	if err = checkCardActive(card, at); err != nil {
 		return
 	}
 
-	if atendded {
-		return eventAttendance, &errors.AppError{
-			Message: fmt.Sprintf("follower has already attended the event"),
-			Status:  http.StatusForbidden,
-		}
-	}
-
-	if follower.DomainID != event.DomainID {
-		return eventAttendance, &errors.AppError{
-			Message: "cannot attend event in another domain",
-			Status:  http.StatusForbidden,
-		}
-	}
-
-	// Start a new transaction
-	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
-		// 5. Create EventAttendance record
-		eventAttendance = entity.EventAttendance{
-			FollowerID: follower.ID,
-			EventID:    event.ID,
-			AttendedAt: time.Now(),
-		}
-		err = s.eventAttendanceRepo.Create(ctx, &eventAttendance, tx)
-		if err != nil {
-			return err
-		}
-
-		follower.Points = follower.Points + event.PointsAwarded
-		err = s.followerRepo.Update(ctx, &follower, tx) // Assuming Update uses the transaction
-		if err != nil {
-			return err
-		}
-
-		// 7. Create PointMutation record
-		pointMutation := entity.PointMutation{
-			FollowerID:  follower.ID,
-			Amount:      event.PointsAwarded,
-			SourceType:  entity.PointMutationSourceTypeEventAttendance,
-			SourceID:    &eventAttendance.ID, // Link to the EventAttendance record
-			Description: utility.PointOf(fmt.Sprintf("Points awarded for attending event: %s", event.Name)),
-		}
-		err = s.pointMutationRepo.Create(ctx, &pointMutation, tx)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	})
+	follower, err := s.followerRepo.FindByID(ctx, card.FollowerID)
 	if err != nil {
-		return eventAttendance, &errors.AppError{
-			Message: "failed to attend event",
-			Status:  http.StatusInternalServerError,
-			Err:     err,
-		}
+		return
 	}
 
-	return
+	return s.recordAttendance(ctx, event, follower, at, beforeRecord)
 }
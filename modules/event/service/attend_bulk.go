@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event"
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	"github.com/PhantomX7/dhamma/modules/event/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	customErrors "github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// AttendBulk records a batch of offline check-ins for an event.
+// Every item goes through the same rules as Attend and is committed on its own,
+// so a bad item is reported in its result instead of failing the whole upload.
+func (s *service) AttendBulk(ctx context.Context, eventID uint64, req request.EventBulkAttendRequest) (res response.EventBulkAttendResponse, err error) {
+	eventM, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, eventM.DomainID, "event", "attend")
+	if err != nil {
+		return
+	}
+
+	res.Total = len(req.Items)
+	res.Results = make([]response.EventBulkAttendResult, 0, len(req.Items))
+	for i, item := range req.Items {
+		result := s.attendBulkItem(ctx, eventM, item)
+		result.Index = i
+		if result.Status == response.BulkAttendStatusCreated {
+			res.Created++
+		}
+		res.Results = append(res.Results, result)
+	}
+
+	return
+}
+
+// attendBulkItem resolves the follower of a single bulk item and records the attendance.
+func (s *service) attendBulkItem(ctx context.Context, eventM entity.Event, item request.EventBulkAttendItem) (result response.EventBulkAttendResult) {
+	result.CardCode = item.CardCode
+	result.FollowerID = item.FollowerID
+
+	if item.AttendedAt.After(time.Now()) {
+		result.Status = response.BulkAttendStatusInvalid
+		result.Message = "attended_at cannot be in the future"
+		return
+	}
+
+	var followerID uint64
+	if item.CardCode != nil {
+		card, err := s.cardRepo.FindOneByField(ctx, "code", *item.CardCode)
+		if err != nil {
+			result.Status, result.Message = bulkErrorStatus(err, response.BulkAttendStatusUnknownCard)
+			return
+		}
+		if err = checkCardActive(card, item.AttendedAt); err != nil {
+			result.Status, result.Message = bulkErrorStatus(err, response.BulkAttendStatusFailed)
+			return
+		}
+		followerID = card.FollowerID
+	} else {
+		followerID = *item.FollowerID
+	}
+
+	follower, err := s.followerRepo.FindByID(ctx, followerID)
+	if err != nil {
+		result.Status, result.Message = bulkErrorStatus(err, response.BulkAttendStatusUnknownFollower)
+		return
+	}
+	result.FollowerID = &follower.ID
+
+	eventAttendance, err := s.recordAttendance(ctx, eventM, follower, item.AttendedAt, nil)
+	if err != nil {
+		result.Status, result.Message = bulkErrorStatus(err, response.BulkAttendStatusFailed)
+		return
+	}
+
+	result.Status = response.BulkAttendStatusCreated
+	result.EventAttendance = &eventAttendance
+	return
+}
+
+// bulkErrorStatus maps an attendance error to a bulk item status.
+// notFoundStatus is used when the error reports a missing record.
+func bulkErrorStatus(err error, notFoundStatus string) (string, string) {
+	switch {
+	case customErrors.IsNotFound(err):
+		return notFoundStatus, err.Error()
+	case errors.Is(err, event.ErrAttendanceDuplicate):
+		return response.BulkAttendStatusDuplicate, event.ErrAttendanceDuplicate.Error()
+	case errors.Is(err, event.ErrAttendanceWrongDomain):
+		return response.BulkAttendStatusWrongDomain, event.ErrAttendanceWrongDomain.Error()
+	case errors.Is(err, event.ErrAttendanceOutsideWindow):
+		return response.BulkAttendStatusOutsideWindow, event.ErrAttendanceOutsideWindow.Error()
+	}
+
+	var appErr *customErrors.AppError
+	if errors.As(err, &appErr) {
+		// The message tells whether the card was lost, revoked or expired
+		if errors.Is(err, event.ErrAttendanceInactiveCard) {
+			return response.BulkAttendStatusInactiveCard, appErr.Message
+		}
+		return response.BulkAttendStatusFailed, appErr.Message
+	}
+	return response.BulkAttendStatusFailed, err.Error()
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	"github.com/PhantomX7/dhamma/modules/event/dto/response"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+func TestAttendBulk_ReportsEveryItem(t *testing.T) {
+	db := setupAttendanceTestDB(t)
+	domain := testdb.Domain(t, db)
+	other := testdb.Domain(t, db, func(domain *entity.Domain) {
+		domain.Name, domain.Code = "Other", "other"
+	})
+
+	budi := testdb.Follower(t, db, domain.ID)
+	ani := testdb.Follower(t, db, domain.ID, func(follower *entity.Follower) { follower.Name = "Ani" })
+	outsider := testdb.Follower(t, db, other.ID, func(follower *entity.Follower) { follower.Name = "Citra" })
+	for _, card := range []entity.Card{
+		{DomainID: domain.ID, FollowerID: budi.ID, Code: "CARD-BUDI"},
+		{DomainID: domain.ID, FollowerID: ani.ID, Code: "CARD-ANI-LOST", Status: entity.CardStatusLost},
+		{DomainID: other.ID, FollowerID: outsider.ID, Code: "CARD-CITRA"},
+	} {
+		require.NoError(t, db.Create(&card).Error)
+	}
+
+	event := entity.Event{DomainID: domain.ID, Name: "Puja", PointsAwarded: 5}
+	require.NoError(t, db.Create(&event).Error)
+
+	s := newAttendanceService(db, followerRepo.New(db))
+
+	// The bad items in between do not stop the items after them
+	attendedAt := time.Now().Add(-time.Hour)
+	res, err := s.AttendBulk(testdb.Context(domain), event.ID, request.EventBulkAttendRequest{Items: []request.EventBulkAttendItem{
+		{CardCode: utility.PointOf("CARD-BUDI"), AttendedAt: attendedAt},
+		{FollowerID: &budi.ID, AttendedAt: attendedAt.Add(time.Minute)},
+		{CardCode: utility.PointOf("CARD-NOBODY"), AttendedAt: attendedAt},
+		{CardCode: utility.PointOf("CARD-CITRA"), AttendedAt: attendedAt},
+		{CardCode: utility.PointOf("CARD-ANI-LOST"), AttendedAt: attendedAt},
+		{FollowerID: &ani.ID, AttendedAt: time.Now().Add(time.Hour)},
+		{FollowerID: &ani.ID, AttendedAt: attendedAt},
+	}})
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, res.Total)
+	assert.Equal(t, 2, res.Created)
+	require.Len(t, res.Results, 7)
+
+	statuses := make([]string, len(res.Results))
+	for i, result := range res.Results {
+		assert.Equal(t, i, result.Index)
+		statuses[i] = result.Status
+	}
+	assert.Equal(t, []string{
+		response.BulkAttendStatusCreated,
+		response.BulkAttendStatusDuplicate,
+		response.BulkAttendStatusUnknownCard,
+		response.BulkAttendStatusWrongDomain,
+		response.BulkAttendStatusInactiveCard,
+		response.BulkAttendStatusInvalid,
+		response.BulkAttendStatusCreated,
+	}, statuses)
+
+	// The card is resolved to its follower, and only the created items award points
+	require.NotNil(t, res.Results[0].FollowerID)
+	assert.Equal(t, budi.ID, *res.Results[0].FollowerID)
+	require.NotNil(t, res.Results[0].EventAttendance)
+	assert.Nil(t, res.Results[1].EventAttendance)
+
+	var attendances int64
+	require.NoError(t, db.Model(&entity.EventAttendance{}).Count(&attendances).Error)
+	assert.Equal(t, int64(2), attendances)
+
+	for _, follower := range []entity.Follower{budi, ani, outsider} {
+		var reloaded entity.Follower
+		require.NoError(t, db.First(&reloaded, follower.ID).Error)
+		if follower.ID == outsider.ID {
+			assert.Zero(t, reloaded.Points)
+			continue
+		}
+		assert.Equal(t, 5, reloaded.Points, follower.Name)
+	}
+}
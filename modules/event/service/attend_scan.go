@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	customErrors "errors"
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/cardqr"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// AttendScan checks in the follower whose printed card QR code was scanned.
+// The payload's signature and domain are verified before Attend runs, so tampered codes and
+// cards of another domain never reach the card lookup.
+func (s *service) AttendScan(ctx context.Context, eventID uint64, req request.EventAttendScanRequest) (eventAttendance entity.EventAttendance, err error) {
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, event.DomainID, "event", "attend")
+	if err != nil {
+		return
+	}
+
+	code, err := cardqr.Verify([]byte(config.CARD_QR_SECRET), req.Payload, event.DomainID)
+	if err != nil {
+		status := http.StatusBadRequest
+		if customErrors.Is(err, cardqr.ErrWrongDomain) {
+			status = http.StatusForbidden
+		}
+		return eventAttendance, &errors.AppError{
+			Message: err.Error(),
+			Status:  status,
+			Err:     err,
+		}
+	}
+
+	return s.Attend(ctx, eventID, request.EventAttendRequest{CardCode: code})
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// checkCardActive rejects a card that was lost, revoked or expired at the time of the check-in.
+func checkCardActive(card entity.Card, at time.Time) error {
+	status := card.StatusAt(at)
+	if status == entity.CardStatusActive {
+		return nil
+	}
+
+	return &errors.AppError{
+		Message: fmt.Sprintf("%s: card is %s", event.ErrAttendanceInactiveCard.Error(), status),
+		Status:  http.StatusForbidden,
+		Err:     event.ErrAttendanceInactiveCard,
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/modules/event/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/checkintoken"
+)
+
+// CheckInCode returns the event's current self check-in code for display as a QR code.
+// Events created before self check-in existed get their secret the first time a code is requested.
+func (s *service) CheckInCode(ctx context.Context, eventID uint64) (res response.EventCheckInCode, err error) {
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, event.DomainID, "event", "show check-in code of")
+	if err != nil {
+		return
+	}
+
+	if event.CheckInSecret == "" {
+		event.CheckInSecret, err = checkintoken.NewSecret()
+		if err != nil {
+			return
+		}
+		if err = s.eventRepo.Update(ctx, &event, nil); err != nil {
+			return
+		}
+	}
+
+	res.Code, res.ExpiresAt = checkintoken.Generate(event.CheckInSecret, event.ID, time.Now())
+	res.Period = int(checkintoken.Period / time.Second)
+
+	return
+}
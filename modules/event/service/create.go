@@ -8,6 +8,7 @@ import (
 	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/event/dto/request"
 	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/checkintoken"
 )
 
 func (s *service) Create(ctx context.Context, request request.EventCreateRequest) (event entity.Event, err error) {
@@ -21,6 +22,16 @@ func (s *service) Create(ctx context.Context, request request.EventCreateRequest
 		return
 	}
 
+	err = validateSchedule(event)
+	if err != nil {
+		return
+	}
+
+	event.CheckInSecret, err = checkintoken.NewSecret()
+	if err != nil {
+		return
+	}
+
 	err = s.eventRepo.Create(ctx, &event, nil)
 	if err != nil {
 		return
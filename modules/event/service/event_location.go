@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// eventLocation returns the timezone of the domain the event belongs to.
+// Domain-scoped requests already carry it in the context; otherwise the domain is loaded.
+func (s *service) eventLocation(ctx context.Context, eventM entity.Event) (*time.Location, error) {
+	contextValues, err := utility.ValuesFromContext(ctx)
+	if err == nil && contextValues.Location != nil &&
+		contextValues.DomainID != nil && *contextValues.DomainID == eventM.DomainID {
+		return contextValues.Location, nil
+	}
+
+	domain, err := s.domainRepo.FindByID(ctx, eventM.DomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.Location(), nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// maxOccurrences caps how many occurrences a single request can expand.
+const maxOccurrences = 500
+
+// Occurrences expands the event schedule into the occurrences starting between req.From and req.To (inclusive).
+// The dates are interpreted as calendar days in the timezone of the event's domain.
+func (s *service) Occurrences(ctx context.Context, eventID uint64, req request.EventOccurrenceRequest) (occurrences []entity.EventOccurrence, err error) {
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, event.DomainID, "event", "show")
+	if err != nil {
+		return
+	}
+
+	location, err := s.eventLocation(ctx, event)
+	if err != nil {
+		return
+	}
+
+	from := startOfDay(req.From, location)
+	to := startOfDay(req.To, location).AddDate(0, 0, 1)
+	occurrences = event.Occurrences(from, to, maxOccurrences)
+
+	return
+}
+
+// startOfDay returns midnight in location of the calendar date carried by t.
+func startOfDay(t time.Time, location *time.Location) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, location)
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// recordAttendance applies the attendance rules shared by every check-in flow and, when they pass,
+// records the attendance and awards the event points in a single transaction.
+// The duplicate check runs inside that transaction with the follower row locked.
+// Scheduled events only accept check-ins inside an occurrence's check-in window and are
+// de-duplicated per occurrence; unscheduled events are de-duplicated per calendar day.
+// beforeRecord, when given, runs in the transaction once the follower is locked. An error it returns
+// rolls the check-in back and is returned as is.
+func (s *service) recordAttendance(
+	ctx context.Context,
+	eventM entity.Event,
+	follower entity.Follower,
+	attendedAt time.Time,
+	beforeRecord func(tx *gorm.DB) error,
+) (eventAttendance entity.EventAttendance, err error) {
+	if follower.DomainID != eventM.DomainID {
+		return eventAttendance, &errors.AppError{
+			Message: event.ErrAttendanceWrongDomain.Error(),
+			Status:  http.StatusForbidden,
+			Err:     event.ErrAttendanceWrongDomain,
+		}
+	}
+
+	var occurrenceAt *time.Time
+	var location *time.Location
+	if eventM.IsScheduled() {
+		occurrence, ok := eventM.OccurrenceAt(attendedAt)
+		if !ok {
+			return eventAttendance, &errors.AppError{
+				Message: event.ErrAttendanceOutsideWindow.Error(),
+				Status:  http.StatusForbidden,
+				Err:     event.ErrAttendanceOutsideWindow,
+			}
+		}
+		occurrenceAt = &occurrence.StartAt
+	} else {
+		location, err = s.eventLocation(ctx, eventM)
+		if err != nil {
+			return
+		}
+	}
+
+	duplicate := &errors.AppError{
+		Message: event.ErrAttendanceDuplicate.Error(),
+		Status:  http.StatusForbidden,
+		Err:     event.ErrAttendanceDuplicate,
+	}
+
+	var beforeRecordErr error
+
+	// Start a new transaction
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		// Lock the follower so concurrent check-ins of the same follower run the duplicate check one at a time
+		_, err := s.followerRepo.FindByIDForUpdate(ctx, follower.ID, tx)
+		if err != nil {
+			return err
+		}
+
+		if beforeRecord != nil {
+			if beforeRecordErr = beforeRecord(tx); beforeRecordErr != nil {
+				return beforeRecordErr
+			}
+		}
+
+		var attended bool
+		if occurrenceAt != nil {
+			attended, err = s.eventAttendanceRepo.HasAttendedOccurrence(ctx, follower.ID, eventM.ID, *occurrenceAt, tx)
+		} else {
+			attended, err = s.eventAttendanceRepo.HasAttendedOnDate(ctx, follower.ID, eventM.ID, attendedAt, location, tx)
+		}
+		if err != nil {
+			return err
+		}
+		if attended {
+			return duplicate
+		}
+
+		// Create EventAttendance record
+		eventAttendance = entity.EventAttendance{
+			FollowerID:   follower.ID,
+			EventID:      eventM.ID,
+			AttendedAt:   attendedAt,
+			OccurrenceAt: occurrenceAt,
+		}
+		err = s.eventAttendanceRepo.Create(ctx, &eventAttendance, tx)
+		if err != nil {
+			return err
+		}
+
+		// Increment in the database rather than writing back the balance read earlier,
+		// so concurrent check-ins of the same follower do not lose each other's points
+		_, err = s.followerRepo.IncrementPoints(ctx, follower.ID, eventM.PointsAwarded, tx)
+		if err != nil {
+			return err
+		}
+
+		// Create PointMutation record
+		pointMutation := entity.PointMutation{
+			FollowerID:  follower.ID,
+			Amount:      eventM.PointsAwarded,
+			SourceType:  entity.PointMutationSourceTypeEventAttendance,
+			SourceID:    &eventAttendance.ID, // Link to the EventAttendance record
+			Description: utility.PointOf(fmt.Sprintf("Points awarded for attending event: %s", eventM.Name)),
+		}
+		err = s.pointMutationRepo.Create(ctx, &pointMutation, tx)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err == duplicate || (err != nil && err == beforeRecordErr) {
+		return eventAttendance, err
+	}
+	if err != nil {
+		return eventAttendance, &errors.AppError{
+			Message: "failed to attend event",
+			Status:  http.StatusInternalServerError,
+			Err:     err,
+		}
+	}
+
+	return
+}
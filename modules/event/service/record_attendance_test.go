@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	cardRepo "github.com/PhantomX7/dhamma/modules/card/repository"
+	domainRepo "github.com/PhantomX7/dhamma/modules/domain/repository"
+	eventModule "github.com/PhantomX7/dhamma/modules/event"
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	eventRepo "github.com/PhantomX7/dhamma/modules/event/repository"
+	eventAttendanceRepo "github.com/PhantomX7/dhamma/modules/event_attendance/repository"
+	eventSelfCheckInRepo "github.com/PhantomX7/dhamma/modules/event_self_check_in/repository"
+	"github.com/PhantomX7/dhamma/modules/follower"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	loginThrottleRepo "github.com/PhantomX7/dhamma/modules/login_throttle/repository"
+	pointMutationRepo "github.com/PhantomX7/dhamma/modules/point_mutation/repository"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// setupAttendanceTestDB creates a database with the tables checking in to an event writes to.
+func setupAttendanceTestDB(t *testing.T) *gorm.DB {
+	return testdb.New(t,
+		&entity.Domain{},
+		&entity.Follower{},
+		&entity.Card{},
+		&entity.Event{},
+		&entity.EventAttendance{},
+		&entity.PointMutation{},
+		&entity.EventSelfCheckIn{},
+		&entity.LoginThrottle{},
+	)
+}
+
+// newAttendanceService returns the event service on db, reading followers through followers.
+func newAttendanceService(db *gorm.DB, followers follower.Repository) eventModule.Service {
+	return New(
+		eventRepo.New(db),
+		followers,
+		eventAttendanceRepo.New(db),
+		pointMutationRepo.New(db),
+		cardRepo.New(db),
+		domainRepo.New(db),
+		eventSelfCheckInRepo.New(db),
+		loginThrottleRepo.New(db),
+		transaction_manager.New(db),
+	)
+}
+
+// barrierFollowerRepo holds every FindByID until all expected callers have read the follower,
+// so each concurrent check-in starts from the same, soon to be stale, balance.
+type barrierFollowerRepo struct {
+	follower.Repository
+	readers *sync.WaitGroup
+}
+
+func (r barrierFollowerRepo) FindByID(ctx context.Context, id uint64, preloads ...string) (entity.Follower, error) {
+	follower, err := r.Repository.FindByID(ctx, id, preloads...)
+	r.readers.Done()
+	r.readers.Wait()
+	return follower, err
+}
+
+func TestAttendById_ConcurrentCheckInsKeepBalance(t *testing.T) {
+	const (
+		eventCount    = 20
+		pointsAwarded = 5
+		initialPoints = 7
+	)
+
+	db := setupAttendanceTestDB(t)
+
+	domain := testdb.Domain(t, db)
+	follower := testdb.Follower(t, db, domain.ID, testdb.WithPoints(initialPoints))
+
+	eventIDs := make([]uint64, eventCount)
+	for i := range eventIDs {
+		event := entity.Event{DomainID: domain.ID, Name: fmt.Sprintf("Event %d", i), PointsAwarded: pointsAwarded}
+		require.NoError(t, db.Create(&event).Error)
+		eventIDs[i] = event.ID
+	}
+
+	readers := &sync.WaitGroup{}
+	readers.Add(eventCount)
+
+	s := newAttendanceService(db, barrierFollowerRepo{Repository: followerRepo.New(db), readers: readers})
+
+	ctx := testdb.Context(domain)
+
+	var wg sync.WaitGroup
+	errs := make([]error, eventCount)
+	for i, eventID := range eventIDs {
+		wg.Add(1)
+		go func(i int, eventID uint64) {
+			defer wg.Done()
+			_, errs[i] = s.AttendById(ctx, eventID, request.EventAttendByIDRequest{FollowerID: follower.ID})
+		}(i, eventID)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		require.NoError(t, err, "check-in %d", i)
+	}
+
+	var reloaded entity.Follower
+	require.NoError(t, db.First(&reloaded, follower.ID).Error)
+	assert.Equal(t, initialPoints+eventCount*pointsAwarded, reloaded.Points)
+
+	var attendances, mutations int64
+	require.NoError(t, db.Model(&entity.EventAttendance{}).Where("follower_id = ?", follower.ID).Count(&attendances).Error)
+	require.NoError(t, db.Model(&entity.PointMutation{}).Where("follower_id = ?", follower.ID).Count(&mutations).Error)
+	assert.Equal(t, int64(eventCount), attendances)
+	assert.Equal(t, int64(eventCount), mutations)
+}
+
+func TestAttendById_ConcurrentDuplicateCheckInsRecordOnce(t *testing.T) {
+	const (
+		attempts      = 8
+		pointsAwarded = 5
+	)
+
+	db := setupAttendanceTestDB(t)
+
+	domain := testdb.Domain(t, db)
+	follower := testdb.Follower(t, db, domain.ID)
+
+	event := entity.Event{DomainID: domain.ID, Name: "Puja", PointsAwarded: pointsAwarded}
+	require.NoError(t, db.Create(&event).Error)
+
+	readers := &sync.WaitGroup{}
+	readers.Add(attempts)
+
+	s := newAttendanceService(db, barrierFollowerRepo{Repository: followerRepo.New(db), readers: readers})
+
+	ctx := testdb.Context(domain)
+
+	var wg sync.WaitGroup
+	errs := make([]error, attempts)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.AttendById(ctx, event.ID, request.EventAttendByIDRequest{FollowerID: follower.ID})
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.ErrorIs(t, err, eventModule.ErrAttendanceDuplicate)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	var reloaded entity.Follower
+	require.NoError(t, db.First(&reloaded, follower.ID).Error)
+	assert.Equal(t, pointsAwarded, reloaded.Points)
+
+	var attendances int64
+	require.NoError(t, db.Model(&entity.EventAttendance{}).Where("follower_id = ?", follower.ID).Count(&attendances).Error)
+	assert.Equal(t, int64(1), attendances)
+}
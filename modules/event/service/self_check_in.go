@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	customErrors "errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event"
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/checkintoken"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// SelfCheckIn checks a follower in with the code displayed at the event, their card code and
+// the last digits of their phone number. It needs no sign-in, so every failure to match the card
+// or phone number returns the same error, and such failures are throttled per client IP address and
+// per card code. Each code is accepted once per follower; the attendance itself goes through the
+// same card, domain and duplicate checks as Attend.
+func (s *service) SelfCheckIn(ctx context.Context, domainCode string, eventID uint64, req request.EventSelfCheckInRequest) (eventAttendance entity.EventAttendance, err error) {
+	eventM, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return
+	}
+
+	domain, err := s.domainRepo.FindOneByField(ctx, "code", domainCode)
+	if err != nil || domain.ID != eventM.DomainID {
+		return eventAttendance, &errors.AppError{
+			Message: "event not found",
+			Status:  http.StatusNotFound,
+		}
+	}
+
+	// Scope the rest of the request to the event's domain, as ValidateDomain does for staff requests
+	ctx = utility.NewContextWithValues(ctx, utility.ContextValues{
+		DomainID: &domain.ID,
+		Location: domain.Location(),
+	})
+
+	now := time.Now()
+	step, err := checkintoken.Verify(eventM.CheckInSecret, eventM.ID, strings.TrimSpace(req.Code), now)
+	if err != nil || eventM.CheckInSecret == "" {
+		if err == nil {
+			err = checkintoken.ErrInvalid
+		}
+		return eventAttendance, &errors.AppError{
+			Message: err.Error(),
+			Status:  http.StatusBadRequest,
+			Err:     err,
+		}
+	}
+
+	throttles, err := s.beginSelfCheckIn(ctx, selfCheckInThrottleKeys(req), now)
+	if err != nil {
+		return
+	}
+
+	card, follower, err := s.findSelfCheckInCard(ctx, eventM.DomainID, req)
+	if customErrors.Is(err, event.ErrSelfCheckInMismatch) {
+		s.failSelfCheckIn(ctx, throttles, now)
+		return
+	}
+	s.forgiveSelfCheckIn(ctx, throttles)
+	if err != nil {
+		return
+	}
+
+	replayed := &errors.AppError{
+		Message: event.ErrSelfCheckInReplayed.Error(),
+		Status:  http.StatusForbidden,
+		Err:     event.ErrSelfCheckInReplayed,
+	}
+
+	// The code is marked as used in the attendance's transaction, so a check-in that is refused
+	// leaves the code usable. The follower lock taken there serializes replays of the same code,
+	// and the unique index on event, follower and step backs it up.
+	return s.attendWithCard(ctx, eventM, card, now, func(tx *gorm.DB) error {
+		used, err := s.selfCheckInRepo.HasUsedStep(ctx, eventM.ID, follower.ID, step, tx)
+		if err != nil {
+			return err
+		}
+		if used {
+			return replayed
+		}
+
+		return s.selfCheckInRepo.Create(ctx, &entity.EventSelfCheckIn{
+			EventID:    eventM.ID,
+			FollowerID: follower.ID,
+			Step:       step,
+			CardID:     card.ID,
+		}, tx)
+	})
+}
+
+// findSelfCheckInCard returns the domain's card with the requested code and its follower,
+// provided the follower's phone number ends with the requested digits.
+func (s *service) findSelfCheckInCard(ctx context.Context, domainID uint64, req request.EventSelfCheckInRequest) (card entity.Card, follower entity.Follower, err error) {
+	mismatch := &errors.AppError{
+		Message: event.ErrSelfCheckInMismatch.Error(),
+		Status:  http.StatusForbidden,
+		Err:     event.ErrSelfCheckInMismatch,
+	}
+
+	card, err = s.cardRepo.FindOneByFields(ctx, map[string]any{
+		"code":      strings.TrimSpace(req.CardCode),
+		"domain_id": domainID,
+	})
+	if err != nil {
+		if customErrors.Is(err, errors.ErrNotFound) {
+			err = mismatch
+		}
+		return
+	}
+
+	follower, err = s.followerRepo.FindByID(ctx, card.FollowerID)
+	if err != nil {
+		return
+	}
+
+	if follower.Phone == nil || !strings.HasSuffix(digits(*follower.Phone), req.PhoneLastDigits) {
+		return card, follower, mismatch
+	}
+
+	return
+}
+
+// digits returns only the digits of a phone number.
+func digits(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	eventModule "github.com/PhantomX7/dhamma/modules/event"
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	"github.com/PhantomX7/dhamma/utility/checkintoken"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// selfCheckInFixture is an unscheduled event with a follower whose card may be used to check in.
+type selfCheckInFixture struct {
+	db       *gorm.DB
+	service  eventModule.Service
+	domain   entity.Domain
+	event    entity.Event
+	follower entity.Follower
+}
+
+func newSelfCheckInFixture(t *testing.T) selfCheckInFixture {
+	db := setupAttendanceTestDB(t)
+
+	domain := testdb.Domain(t, db)
+	follower := testdb.Follower(t, db, domain.ID, testdb.WithPhone("0812-3456-7890"))
+
+	require.NoError(t, db.Create(&entity.Card{DomainID: domain.ID, FollowerID: follower.ID, Code: "CARD-1"}).Error)
+
+	secret, err := checkintoken.NewSecret()
+	require.NoError(t, err)
+	event := entity.Event{DomainID: domain.ID, Name: "Puja", PointsAwarded: 5, CheckInSecret: secret}
+	require.NoError(t, db.Create(&event).Error)
+
+	return selfCheckInFixture{
+		db:       db,
+		service:  newAttendanceService(db, followerRepo.New(db)),
+		domain:   domain,
+		event:    event,
+		follower: follower,
+	}
+}
+
+// request returns a self check-in with the event's current code.
+func (f selfCheckInFixture) request(phoneLastDigits string) request.EventSelfCheckInRequest {
+	code, _ := checkintoken.Generate(f.event.CheckInSecret, f.event.ID, time.Now())
+	return request.EventSelfCheckInRequest{
+		Code:            code,
+		CardCode:        "CARD-1",
+		PhoneLastDigits: phoneLastDigits,
+		IPAddress:       "203.0.113.7",
+	}
+}
+
+func (f selfCheckInFixture) checkIn(req request.EventSelfCheckInRequest) error {
+	_, err := f.service.SelfCheckIn(context.Background(), f.domain.Code, f.event.ID, req)
+	return err
+}
+
+// failures returns the failures counted against the card and the IP address of request.
+func (f selfCheckInFixture) failures(t *testing.T) (card, ip int) {
+	t.Helper()
+
+	var throttles []entity.LoginThrottle
+	require.NoError(t, f.db.Find(&throttles).Error)
+	for _, throttle := range throttles {
+		switch throttle.Scope {
+		case entity.LoginThrottleScopeSelfCheckInCard:
+			card = throttle.Failures
+		case entity.LoginThrottleScopeSelfCheckInIP:
+			ip = throttle.Failures
+		}
+	}
+	return
+}
+
+func assertStatus(t *testing.T, status int, err error) {
+	t.Helper()
+
+	var appErr *errors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, status, appErr.Status)
+}
+
+func TestSelfCheckIn_ChecksInOnceAndRefusesReplay(t *testing.T) {
+	f := newSelfCheckInFixture(t)
+	req := f.request("7890")
+
+	require.NoError(t, f.checkIn(req))
+	assert.ErrorIs(t, f.checkIn(req), eventModule.ErrSelfCheckInReplayed)
+
+	var checkIns, attendances int64
+	require.NoError(t, f.db.Model(&entity.EventSelfCheckIn{}).Count(&checkIns).Error)
+	require.NoError(t, f.db.Model(&entity.EventAttendance{}).Count(&attendances).Error)
+	assert.Equal(t, int64(1), checkIns)
+	assert.Equal(t, int64(1), attendances)
+
+	// A successful check-in is not counted as a failure
+	card, ip := f.failures(t)
+	assert.Zero(t, card)
+	assert.Zero(t, ip)
+}
+
+func TestSelfCheckIn_RefusedAttendanceLeavesCodeUnused(t *testing.T) {
+	f := newSelfCheckInFixture(t)
+	require.NoError(t, f.db.Create(&entity.EventAttendance{FollowerID: f.follower.ID, EventID: f.event.ID, AttendedAt: time.Now()}).Error)
+
+	assert.ErrorIs(t, f.checkIn(f.request("7890")), eventModule.ErrAttendanceDuplicate)
+
+	var checkIns int64
+	require.NoError(t, f.db.Model(&entity.EventSelfCheckIn{}).Count(&checkIns).Error)
+	assert.Zero(t, checkIns)
+}
+
+func TestSelfCheckIn_MismatchesThrottleTheCard(t *testing.T) {
+	f := newSelfCheckInFixture(t)
+
+	// The free failures answer with the mismatch error, the next one starts the backoff
+	for range 4 {
+		assert.ErrorIs(t, f.checkIn(f.request("0000")), eventModule.ErrSelfCheckInMismatch)
+	}
+
+	// Even the right phone number is refused during the backoff, without being counted
+	assertStatus(t, http.StatusTooManyRequests, f.checkIn(f.request("7890")))
+
+	card, ip := f.failures(t)
+	assert.Equal(t, 4, card)
+	assert.Equal(t, 4, ip)
+
+	var checkIns int64
+	require.NoError(t, f.db.Model(&entity.EventSelfCheckIn{}).Count(&checkIns).Error)
+	assert.Zero(t, checkIns)
+}
+
+func TestSelfCheckIn_ConcurrentGuessesStayWithinLockout(t *testing.T) {
+	const guesses = 20
+
+	f := newSelfCheckInFixture(t)
+
+	var wg sync.WaitGroup
+	errs := make([]error, guesses)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = f.checkIn(f.request("0000"))
+		}(i)
+	}
+	wg.Wait()
+
+	// Every guess is counted before it is checked, so no more than the lockout are ever compared
+	checked := 0
+	for _, err := range errs {
+		var appErr *errors.AppError
+		require.ErrorAs(t, err, &appErr)
+		if appErr.Status == http.StatusForbidden {
+			checked++
+			continue
+		}
+		assert.Equal(t, http.StatusTooManyRequests, appErr.Status)
+	}
+	assert.LessOrEqual(t, checked, 10)
+
+	assertStatus(t, http.StatusTooManyRequests, f.checkIn(f.request("7890")))
+}
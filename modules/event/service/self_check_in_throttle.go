@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event/dto/request"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// selfCheckInThrottleKey identifies one failure counter.
+type selfCheckInThrottleKey struct {
+	scope      string
+	identifier string
+}
+
+// selfCheckInThrottleKeys returns the counters a self check-in is checked against.
+// Card codes are unique across every domain, so the card counter is keyed by the code alone.
+func selfCheckInThrottleKeys(req request.EventSelfCheckInRequest) []selfCheckInThrottleKey {
+	keys := []selfCheckInThrottleKey{{
+		scope:      entity.LoginThrottleScopeSelfCheckInCard,
+		identifier: strings.TrimSpace(req.CardCode),
+	}}
+	if req.IPAddress != "" {
+		keys = append(keys, selfCheckInThrottleKey{scope: entity.LoginThrottleScopeSelfCheckInIP, identifier: req.IPAddress})
+	}
+	return keys
+}
+
+// beginSelfCheckIn counts the attempt as a failure before the card is matched and refuses it while
+// the card or the IP address is in backoff or locked out. Counting first means parallel guesses
+// cannot all pass the check before any of them is recorded. The returned counters must be handed
+// to failSelfCheckIn or forgiveSelfCheckIn once the attempt is checked.
+func (s *service) beginSelfCheckIn(ctx context.Context, keys []selfCheckInThrottleKey, at time.Time) (throttles []entity.LoginThrottle, err error) {
+	for _, key := range keys {
+		throttle, err := s.loginThrottleRepo.RecordFailure(ctx, key.scope, key.identifier, at)
+		if err != nil {
+			s.forgiveSelfCheckIn(ctx, throttles)
+			return nil, err
+		}
+		throttles = append(throttles, throttle)
+	}
+
+	var blockedUntil time.Time
+	for _, throttle := range throttles {
+		if throttle.IsExhausted() {
+			until, _ := throttle.NextBlock(at)
+			if err = s.loginThrottleRepo.Block(ctx, throttle.ID, until); err != nil {
+				s.forgiveSelfCheckIn(ctx, throttles)
+				return nil, err
+			}
+			if until.After(blockedUntil) {
+				blockedUntil = until
+			}
+		}
+
+		if throttle.IsBlocked(at) && throttle.BlockedUntil.After(blockedUntil) {
+			blockedUntil = *throttle.BlockedUntil
+		}
+	}
+
+	if blockedUntil.IsZero() {
+		return
+	}
+
+	s.forgiveSelfCheckIn(ctx, throttles)
+	return nil, errors.NewTooManyRequestsError("too many check-in attempts, please try again later", nil).
+		WithDetails(map[string]interface{}{
+			"retry_after": int(math.Ceil(blockedUntil.Sub(at).Seconds())),
+		})
+}
+
+// failSelfCheckIn starts the backoff or lockout earned by the failures counted in beginSelfCheckIn.
+// Errors are only logged so the caller still answers with the usual mismatch error.
+func (s *service) failSelfCheckIn(ctx context.Context, throttles []entity.LoginThrottle, at time.Time) {
+	for _, throttle := range throttles {
+		until, locked := throttle.NextBlock(at)
+		if until.IsZero() {
+			continue
+		}
+
+		if err := s.loginThrottleRepo.Block(ctx, throttle.ID, until); err != nil {
+			logger.FromCtx(ctx).Error("failed to block self check-ins", zap.Error(err))
+			continue
+		}
+
+		if locked {
+			logger.FromCtx(ctx).Warn("self check-ins locked out after too many failures",
+				zap.String("scope", throttle.Scope),
+				zap.String("identifier", throttle.Identifier),
+				zap.Int("failures", throttle.Failures),
+				zap.Time("locked_until", until),
+			)
+		}
+	}
+}
+
+// forgiveSelfCheckIn takes back the failures counted in beginSelfCheckIn for an attempt that did not fail to match.
+func (s *service) forgiveSelfCheckIn(ctx context.Context, throttles []entity.LoginThrottle) {
+	for _, throttle := range throttles {
+		if err := s.loginThrottleRepo.Forgive(ctx, throttle.ID); err != nil {
+			logger.FromCtx(ctx).Error("failed to forgive self check-in attempt", zap.Error(err))
+		}
+	}
+}
@@ -3,9 +3,12 @@ package service
 import (
 	"github.com/PhantomX7/dhamma/libs/transaction_manager"
 	"github.com/PhantomX7/dhamma/modules/card"
+	"github.com/PhantomX7/dhamma/modules/domain"
 	"github.com/PhantomX7/dhamma/modules/event"
 	"github.com/PhantomX7/dhamma/modules/event_attendance"
+	"github.com/PhantomX7/dhamma/modules/event_self_check_in"
 	"github.com/PhantomX7/dhamma/modules/follower"
+	"github.com/PhantomX7/dhamma/modules/login_throttle"
 	"github.com/PhantomX7/dhamma/modules/point_mutation"
 )
 
@@ -15,6 +18,9 @@ type service struct {
 	eventAttendanceRepo event_attendance.Repository // Add event_attendance repository
 	pointMutationRepo   point_mutation.Repository   // Add point_mutation repository
 	cardRepo            card.Repository             // Add card repository
+	domainRepo          domain.Repository
+	selfCheckInRepo     event_self_check_in.Repository
+	loginThrottleRepo   login_throttle.Repository
 	transactionManager  transaction_manager.Client
 }
 
@@ -25,6 +31,9 @@ func New(
 	eventAttendanceRepo event_attendance.Repository,
 	pointMutationRepo point_mutation.Repository,
 	cardRepo card.Repository,
+	domainRepo domain.Repository,
+	selfCheckInRepo event_self_check_in.Repository,
+	loginThrottleRepo login_throttle.Repository,
 	transactionManager transaction_manager.Client,
 ) event.Service {
 	return &service{
@@ -33,6 +42,9 @@ func New(
 		eventAttendanceRepo: eventAttendanceRepo,
 		pointMutationRepo:   pointMutationRepo,
 		cardRepo:            cardRepo,
+		domainRepo:          domainRepo,
+		selfCheckInRepo:     selfCheckInRepo,
+		loginThrottleRepo:   loginThrottleRepo,
 		transactionManager:  transactionManager,
 	}
 }
@@ -21,7 +21,18 @@ func (s *service) Update(ctx context.Context, eventID uint64, request request.Ev
 		return
 	}
 
-	err = copier.Copy(&event, &request)
+	// Ignore empty fields so omitted optional values don't clear the stored ones
+	err = copier.CopyWithOption(&event, &request, copier.Option{IgnoreEmpty: true})
+	if err != nil {
+		return
+	}
+
+	if event.Recurrence != nil && *event.Recurrence == "none" {
+		event.Recurrence = nil
+		event.RecurrenceUntil = nil
+	}
+
+	err = validateSchedule(event)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// validateSchedule checks that the event schedule is consistent before it is saved.
+func validateSchedule(event entity.Event) error {
+	if (event.StartAt == nil) != (event.EndAt == nil) {
+		return &errors.AppError{
+			Message: "start_at and end_at must be set together",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	if !event.IsScheduled() {
+		if event.Recurrence != nil {
+			return &errors.AppError{
+				Message: "an unscheduled event cannot have a recurrence",
+				Status:  http.StatusBadRequest,
+			}
+		}
+		return nil
+	}
+
+	if !event.EndAt.After(*event.StartAt) {
+		return &errors.AppError{
+			Message: "end_at must be after start_at",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	if event.RecurrenceUntil != nil && event.RecurrenceUntil.Before(*event.StartAt) {
+		return &errors.AppError{
+			Message: "recurrence_until must not be before start_at",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	// OccurrenceAt only looks at the occurrences next to t, so a check-in window must not reach
+	// past the start of the neighbouring occurrences
+	if interval := event.RecurrenceInterval(); interval > 0 {
+		opensBefore := time.Duration(event.CheckInOpensBefore) * time.Minute
+		closesAfter := event.EndAt.Sub(*event.StartAt) + time.Duration(event.CheckInClosesAfter)*time.Minute
+		if opensBefore > interval || closesAfter > interval {
+			return &errors.AppError{
+				Message: "the check-in window of an occurrence must not be longer than the recurrence interval",
+				Status:  http.StatusBadRequest,
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+func TestValidateSchedule_CapsCheckInWindowsToTheRecurrenceInterval(t *testing.T) {
+	start := time.Date(2025, time.January, 5, 19, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	day := 24 * 60
+
+	tests := []struct {
+		name        string
+		recurrence  *string
+		opensBefore int
+		closesAfter int
+		wantErr     bool
+	}{
+		{name: "one-off event", opensBefore: 10 * day, closesAfter: 10 * day},
+		{name: "daily opening a day before", recurrence: utility.PointOf(entity.EventRecurrenceDaily), opensBefore: day},
+		{name: "daily opening earlier", recurrence: utility.PointOf(entity.EventRecurrenceDaily), opensBefore: day + 1, wantErr: true},
+		{name: "daily closing when the next one ends", recurrence: utility.PointOf(entity.EventRecurrenceDaily), closesAfter: day - 120},
+		{name: "daily closing later", recurrence: utility.PointOf(entity.EventRecurrenceDaily), closesAfter: day - 119, wantErr: true},
+		{name: "weekly opening six days before", recurrence: utility.PointOf(entity.EventRecurrenceWeekly), opensBefore: 6 * day},
+		{name: "weekly opening eight days before", recurrence: utility.PointOf(entity.EventRecurrenceWeekly), opensBefore: 8 * day, wantErr: true},
+		{name: "monthly closing after four weeks", recurrence: utility.PointOf(entity.EventRecurrenceMonthly), closesAfter: 28*day - 120},
+		{name: "monthly closing after a month", recurrence: utility.PointOf(entity.EventRecurrenceMonthly), closesAfter: 30 * day, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSchedule(entity.Event{
+				StartAt:            &start,
+				EndAt:              &end,
+				Recurrence:         tt.recurrence,
+				CheckInOpensBefore: tt.opensBefore,
+				CheckInClosesAfter: tt.closesAfter,
+			})
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			var appErr *errors.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, http.StatusBadRequest, appErr.Status)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Inactive handles the HTTP GET request listing followers who have not attended for a number of weeks.
+// Expected route: GET /event-attendance/report/inactive?weeks=4
+func (c *controller) Inactive(ctx *gin.Context) {
+	var req request.EventAttendanceInactiveRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, meta, err := c.eventAttendanceService.Inactive(ctx.Request.Context(), request.NewEventAttendanceInactivePagination(ctx.Request.URL.Query()), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildPaginationResponseSuccess("ok", res, meta))
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Report handles the HTTP GET request aggregating attendances by event, day, week or month.
+// Expected route: GET /event-attendance/report?group_by=week&attended_at=between:2025-01-01,2025-03-31
+func (c *controller) Report(ctx *gin.Context) {
+	var req request.EventAttendanceReportRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.eventAttendanceService.Report(ctx.Request.Context(), request.NewEventAttendanceReportPagination(ctx.Request.URL.Query()), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// Void handles the HTTP POST request voiding an event attendance.
+// Expected route: POST /event-attendance/:id/void
+func (c *controller) Void(ctx *gin.Context) {
+	eventAttendanceID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid event_attendance id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req request.EventAttendanceVoidRequest
+	if err = ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.eventAttendanceService.Void(ctx.Request.Context(), eventAttendanceID, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
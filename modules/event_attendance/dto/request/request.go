@@ -16,6 +16,30 @@ type EventAttendanceUpdateRequest struct {
 	IsActive    *bool   `json:"is_active" form:"is_active" binding:"omitempty"`
 }
 
+// EventAttendanceVoidRequest defines the payload for voiding an event attendance.
+type EventAttendanceVoidRequest struct {
+	Reason string `json:"reason" form:"reason" binding:"required,max=255"`
+}
+
+// Groupings supported by the attendance report.
+const (
+	ReportGroupByEvent = "event"
+	ReportGroupByDay   = "day"
+	ReportGroupByWeek  = "week"
+	ReportGroupByMonth = "month"
+)
+
+// EventAttendanceReportRequest defines the query parameters of the attendance report.
+// The attendances included are narrowed with the filters of NewEventAttendanceReportPagination.
+type EventAttendanceReportRequest struct {
+	GroupBy string `json:"group_by" form:"group_by" binding:"required,oneof=event day week month"`
+}
+
+// EventAttendanceInactiveRequest defines the query parameters of the inactive followers report.
+type EventAttendanceInactiveRequest struct {
+	Weeks int `json:"weeks" form:"weeks" binding:"required,min=1,max=520"`
+}
+
 func NewEventAttendancePagination(conditions map[string][]string) *pagination.Pagination {
 	filterDef := pagination.NewFilterDefinition().
 		AddFilter("event_id", pagination.FilterConfig{
@@ -70,3 +94,111 @@ func NewEventAttendancePagination(conditions map[string][]string) *pagination.Pa
 		},
 	)
 }
+
+// NewEventAttendanceReportPagination defines the filters narrowing the attendances aggregated by the report.
+// Limit and offset do not apply; the report covers every matching attendance.
+func NewEventAttendanceReportPagination(conditions map[string][]string) *pagination.Pagination {
+	filterDef := pagination.NewFilterDefinition().
+		AddFilter("domain_id", pagination.FilterConfig{
+			TableName: "Event",
+			Field:     "domain_id",
+			Type:      pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("event_id", pagination.FilterConfig{
+			TableName: "event_attendances",
+			Field:     "event_id",
+			Type:      pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("follower_id", pagination.FilterConfig{
+			TableName: "event_attendances",
+			Field:     "follower_id",
+			Type:      pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("event_name", pagination.FilterConfig{
+			TableName: "Event",
+			Field:     "name",
+			Type:      pagination.FilterTypeString,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorLike, pagination.OperatorEquals, pagination.OperatorIn,
+			},
+		}).
+		AddFilter("is_youth", pagination.FilterConfig{
+			TableName: "Follower",
+			Field:     "is_youth",
+			Type:      pagination.FilterTypeBool,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("attended_at", pagination.FilterConfig{
+			TableName: "event_attendances",
+			Field:     "attended_at",
+			Type:      pagination.FilterTypeDate,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorBetween, pagination.OperatorEquals, pagination.OperatorGte, pagination.OperatorLte,
+			},
+		})
+
+	return pagination.NewPagination(
+		conditions,
+		filterDef,
+		pagination.PaginationOptions{
+			DefaultLimit: 100,
+			MaxLimit:     1000,
+			DefaultOrder: "event_attendances.id asc",
+		},
+	)
+}
+
+// NewEventAttendanceInactivePagination defines the filters of the inactive followers report.
+func NewEventAttendanceInactivePagination(conditions map[string][]string) *pagination.Pagination {
+	filterDef := pagination.NewFilterDefinition().
+		AddFilter("domain_id", pagination.FilterConfig{
+			TableName: "followers",
+			Field:     "domain_id",
+			Type:      pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("name", pagination.FilterConfig{
+			TableName: "followers",
+			Field:     "name",
+			Type:      pagination.FilterTypeString,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals, pagination.OperatorLike,
+			},
+		}).
+		AddFilter("is_youth", pagination.FilterConfig{
+			TableName: "followers",
+			Field:     "is_youth",
+			Type:      pagination.FilterTypeBool,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorEquals,
+			},
+		}).
+		AddSort("name", pagination.SortConfig{
+			TableName: "followers",
+			Field:     "name",
+			Allowed:   true,
+		})
+
+	return pagination.NewPagination(
+		conditions,
+		filterDef,
+		pagination.PaginationOptions{
+			DefaultLimit: 20,
+			MaxLimit:     100,
+			DefaultOrder: "followers.id desc",
+		},
+	)
+}
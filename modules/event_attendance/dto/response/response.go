@@ -0,0 +1,42 @@
+package response
+
+import (
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// AttendanceStats aggregates a set of attendances.
+// A follower is new when their first attendance ever falls within the set and returning otherwise.
+type AttendanceStats struct {
+	Attendances        int `json:"attendances"`
+	UniqueFollowers    int `json:"unique_followers"`
+	NewFollowers       int `json:"new_followers"`
+	ReturningFollowers int `json:"returning_followers"`
+	YouthFollowers     int `json:"youth_followers"`
+	AdultFollowers     int `json:"adult_followers"`
+}
+
+// AttendanceReportBucket is the statistics of one event, day, week or month.
+type AttendanceReportBucket struct {
+	// Key identifies the bucket: the event ID, the day or week start (2006-01-02) or the month (2006-01)
+	Key         string     `json:"key"`
+	EventID     *uint64    `json:"event_id,omitempty"`
+	EventName   *string    `json:"event_name,omitempty"`
+	PeriodStart *time.Time `json:"period_start,omitempty"` // Start of the day, week (Monday) or month in the report timezone
+	AttendanceStats
+}
+
+// AttendanceReport is the attendance statistics grouped by event, day, week or month.
+type AttendanceReport struct {
+	GroupBy  string                   `json:"group_by"`
+	Timezone string                   `json:"timezone"`
+	Summary  AttendanceStats          `json:"summary"`
+	Buckets  []AttendanceReportBucket `json:"buckets"`
+}
+
+// InactiveFollower is a follower who has not attended any event for the requested number of weeks.
+type InactiveFollower struct {
+	entity.Follower
+	LastAttendedAt *time.Time `json:"last_attended_at"` // nil if the follower never attended
+}
@@ -5,26 +5,48 @@ import (
 	"time" // Add time import
 
 	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/request"
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/response"
 	"github.com/PhantomX7/dhamma/utility"
 	"github.com/PhantomX7/dhamma/utility/pagination"
 	"github.com/PhantomX7/dhamma/utility/repository"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Repository defines the interface for event_attendance data operations.
 type Repository interface {
 	repository.BaseRepositoryInterface[entity.EventAttendance]
-	// HasAttendedOnDate checks if a follower attended a specific event on a given date.
-	HasAttendedOnDate(ctx context.Context, followerID uint64, eventID uint64, date time.Time) (bool, error)
+	// HasAttendedOnDate checks if a follower attended a specific event on the calendar day of date in location.
+	HasAttendedOnDate(ctx context.Context, followerID uint64, eventID uint64, date time.Time, location *time.Location, tx *gorm.DB) (bool, error)
+	// HasAttendedOccurrence checks if a follower attended the occurrence of an event starting at occurrenceAt.
+	HasAttendedOccurrence(ctx context.Context, followerID uint64, eventID uint64, occurrenceAt time.Time, tx *gorm.DB) (bool, error)
+	// Void marks the attendance as voided and soft-deletes it, reporting false if it was already voided.
+	Void(ctx context.Context, eventAttendanceID uint64, voidedBy uint64, reason string, at time.Time, tx *gorm.DB) (bool, error)
+	// ReassignFollower moves every attendance of fromFollowerID to toFollowerID and returns how many were moved.
+	ReassignFollower(ctx context.Context, fromFollowerID uint64, toFollowerID uint64, tx *gorm.DB) (int64, error)
+	// FindAttendedAtRange returns the first and last attended_at of the attendances matching the filters.
+	FindAttendedAtRange(ctx context.Context, pg *pagination.Pagination) (time.Time, time.Time, bool, error)
+	// AggregateStats aggregates the attendances matching the filters, grouped by the bucketKey expression.
+	AggregateStats(ctx context.Context, pg *pagination.Pagination, bucketKey clause.Expr) ([]response.AttendanceReportBucket, error)
+	// FindLastAttendedAt returns when each of the followers last attended an event.
+	FindLastAttendedAt(ctx context.Context, followerIDs []uint64) (map[uint64]time.Time, error)
 }
 
 type Service interface {
 	Index(ctx context.Context, pg *pagination.Pagination) ([]entity.EventAttendance, utility.PaginationMeta, error)
 	Show(ctx context.Context, eventAttendanceID uint64) (entity.EventAttendance, error)
+	Void(ctx context.Context, eventAttendanceID uint64, req request.EventAttendanceVoidRequest) (entity.EventAttendance, error)
+	Report(ctx context.Context, pg *pagination.Pagination, req request.EventAttendanceReportRequest) (response.AttendanceReport, error)
+	Inactive(ctx context.Context, pg *pagination.Pagination, req request.EventAttendanceInactiveRequest) ([]response.InactiveFollower, utility.PaginationMeta, error)
 }
 
 type Controller interface {
 	Index(ctx *gin.Context)
 	Show(ctx *gin.Context)
+	Void(ctx *gin.Context)
+	Report(ctx *gin.Context)
+	Inactive(ctx *gin.Context)
 }
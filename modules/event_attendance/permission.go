@@ -6,10 +6,16 @@ type permission struct {
 	Index string
 	// View event attendance details
 	Show string
+	// Void an event attendance and reverse its points
+	Void string
+	// View attendance reports and inactive followers
+	Report string
 }
 
 var Permissions = permission{
-	Key:   "event-attendance",
-	Index: "index",
-	Show:  "show",
+	Key:    "event-attendance",
+	Index:  "index",
+	Show:   "show",
+	Void:   "void",
+	Report: "report",
 }
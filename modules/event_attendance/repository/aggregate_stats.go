@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// attendanceStatsColumns aggregates a set of attendances. A follower counts as new when one of the
+// attendances in the set is their first attendance ever.
+const attendanceStatsColumns = "COUNT(*) AS attendances, " +
+	"COUNT(DISTINCT event_attendances.follower_id) AS unique_followers, " +
+	"COUNT(DISTINCT CASE WHEN event_attendances.attended_at = (SELECT MIN(earliest.attended_at) FROM event_attendances earliest " +
+	"WHERE earliest.follower_id = event_attendances.follower_id AND earliest.deleted_at IS NULL) " +
+	"THEN event_attendances.follower_id END) AS new_followers, " +
+	"COUNT(DISTINCT CASE WHEN Follower.is_youth = ? THEN event_attendances.follower_id END) AS youth_followers"
+
+// attendanceStatsRow is a single aggregated row.
+type attendanceStatsRow struct {
+	BucketKey       string
+	EventName       *string
+	Attendances     int
+	UniqueFollowers int
+	NewFollowers    int
+	YouthFollowers  int
+}
+
+// AggregateStats aggregates the attendances matching the pagination filters in the database.
+// The attendances are grouped by the bucketKey expression; an empty expression returns a single summary row.
+// Follower and Event are joined, so the filters and bucketKey may refer to them.
+func (r *repository) AggregateStats(ctx context.Context, pg *pagination.Pagination, bucketKey clause.Expr) ([]response.AttendanceReportBucket, error) {
+	query := r.reportQuery(ctx, pg)
+	if bucketKey.SQL == "" {
+		query = query.Select(attendanceStatsColumns, true)
+	} else {
+		query = query.
+			Select("? AS bucket_key, MAX(Event.name) AS event_name, "+attendanceStatsColumns, bucketKey, true).
+			Group("bucket_key")
+	}
+
+	var rows []attendanceStatsRow
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	buckets := make([]response.AttendanceReportBucket, 0, len(rows))
+	for _, row := range rows {
+		buckets = append(buckets, response.AttendanceReportBucket{
+			Key:       row.BucketKey,
+			EventName: row.EventName,
+			AttendanceStats: response.AttendanceStats{
+				Attendances:        row.Attendances,
+				UniqueFollowers:    row.UniqueFollowers,
+				NewFollowers:       row.NewFollowers,
+				ReturningFollowers: row.UniqueFollowers - row.NewFollowers,
+				YouthFollowers:     row.YouthFollowers,
+				AdultFollowers:     row.UniqueFollowers - row.YouthFollowers,
+			},
+		})
+	}
+
+	return buckets, nil
+}
+
+// reportQuery selects the attendances matching the pagination filters, ignoring its limit, offset and order.
+func (r *repository) reportQuery(ctx context.Context, pg *pagination.Pagination) *gorm.DB {
+	if pg.Location == nil {
+		pg.SetLocation(utility.LocationFromContext(ctx))
+	}
+	scopes, _ := pagination.NewScopeBuilder(pg).Build()
+
+	return r.db.WithContext(ctx).
+		Model(&entity.EventAttendance{}).
+		Joins("Follower").
+		Joins("Event").
+		Scopes(scopes...)
+}
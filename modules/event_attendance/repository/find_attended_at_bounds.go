@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// attendedAtBoundChunk caps how many follower IDs are sent in a single IN clause.
+const attendedAtBoundChunk = 1000
+
+// FindLastAttendedAt returns when each of the followers last attended an event.
+// Followers without any attendance are absent from the map.
+func (r *repository) FindLastAttendedAt(ctx context.Context, followerIDs []uint64) (map[uint64]time.Time, error) {
+	return r.findAttendedAtBound(ctx, followerIDs, "MAX")
+}
+
+// findAttendedAtBound selects the attendance rows whose attended_at equals the aggregate of the follower's
+// attendances. Comparing against a correlated subquery, rather than scanning the aggregate itself,
+// keeps the column typed as a timestamp on every driver.
+func (r *repository) findAttendedAtBound(ctx context.Context, followerIDs []uint64, aggregate string) (map[uint64]time.Time, error) {
+	bounds := make(map[uint64]time.Time, len(followerIDs))
+
+	for start := 0; start < len(followerIDs); start += attendedAtBoundChunk {
+		end := min(start+attendedAtBoundChunk, len(followerIDs))
+
+		var attendances []entity.EventAttendance
+		err := r.db.WithContext(ctx).
+			Model(&entity.EventAttendance{}).
+			Select("event_attendances.follower_id, event_attendances.attended_at").
+			Where("event_attendances.follower_id IN ?", followerIDs[start:end]).
+			Where(fmt.Sprintf(
+				"event_attendances.attended_at = (SELECT %s(bound.attended_at) FROM event_attendances bound "+
+					"WHERE bound.follower_id = event_attendances.follower_id AND bound.deleted_at IS NULL)",
+				aggregate,
+			)).
+			Find(&attendances).Error
+		if err != nil {
+			return nil, err
+		}
+
+		for _, attendance := range attendances {
+			bounds[attendance.FollowerID] = attendance.AttendedAt
+		}
+	}
+
+	return bounds, nil
+}
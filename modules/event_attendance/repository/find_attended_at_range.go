@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// FindAttendedAtRange returns the first and last attended_at of the attendances matching the pagination filters.
+// found is false when no attendance matches.
+func (r *repository) FindAttendedAtRange(ctx context.Context, pg *pagination.Pagination) (first time.Time, last time.Time, found bool, err error) {
+	var bounds []entity.EventAttendance
+	for _, order := range []string{"event_attendances.attended_at ASC", "event_attendances.attended_at DESC"} {
+		var attendances []entity.EventAttendance
+		err = r.reportQuery(ctx, pg).
+			Select("event_attendances.attended_at").
+			Order(order).
+			Limit(1).
+			Find(&attendances).Error
+		if err != nil || len(attendances) == 0 {
+			return
+		}
+		bounds = append(bounds, attendances[0])
+	}
+
+	return bounds[0].AttendedAt, bounds[1].AttendedAt, true, nil
+}
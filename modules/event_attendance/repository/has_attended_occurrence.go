@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// HasAttendedOccurrence checks if a follower attended a specific occurrence of an event.
+// Occurrences are identified by their start time, which is stored on the attendance as occurrence_at.
+func (r *repository) HasAttendedOccurrence(ctx context.Context, followerID uint64, eventID uint64, occurrenceAt time.Time, tx *gorm.DB) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	var count int64
+
+	err := db.WithContext(ctx).
+		Model(&entity.EventAttendance{}).
+		Where("follower_id = ?", followerID).
+		Where("event_id = ?", eventID).
+		Where("occurrence_at = ?", occurrenceAt).
+		Count(&count).Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -4,27 +4,30 @@ import (
 	"context"
 	"time"
 
+	"gorm.io/gorm"
+
 	"github.com/PhantomX7/dhamma/entity"
 )
 
 // HasAttendedOnDate checks if a follower attended a specific event on a given date.
 // It queries the database for an EventAttendance record matching the followerID, eventID,
 // and where the attended_at timestamp falls within the specified date.
-func (r *repository) HasAttendedOnDate(ctx context.Context, followerID uint64, eventID uint64, date time.Time) (bool, error) {
-	var count int64
-
-	location, err := time.LoadLocation("Asia/Jakarta") // GMT+7
-	if err != nil {
-		return false, err
+// The day boundaries are resolved in the given location, usually the domain's timezone.
+func (r *repository) HasAttendedOnDate(ctx context.Context, followerID uint64, eventID uint64, date time.Time, location *time.Location, tx *gorm.DB) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
 	}
 
+	var count int64
+
 	// Define the start and end of the given date
-	year, month, day := date.Date()
+	year, month, day := date.In(location).Date()
 	startOfDay := time.Date(year, month, day, 0, 0, 0, 0, location)
 	endOfDay := time.Date(year, month, day, 23, 59, 59, 999999999, location)
 
 	// Query the database
-	err = r.db.WithContext(ctx).
+	err := db.WithContext(ctx).
 		Model(&entity.EventAttendance{}).
 		Where("follower_id = ?", followerID).
 		Where("event_id = ?", eventID).
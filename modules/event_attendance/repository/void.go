@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Void records who voided the attendance and why and soft-deletes it with a single conditional UPDATE.
+// It reports false when the attendance was already voided, so concurrent voids are applied only once.
+func (r *repository) Void(ctx context.Context, eventAttendanceID uint64, voidedBy uint64, reason string, at time.Time, tx *gorm.DB) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&entity.EventAttendance{}).
+		Where("id = ? AND deleted_at IS NULL", eventAttendanceID).
+		UpdateColumns(map[string]interface{}{
+			"voided_at":   at,
+			"voided_by":   voidedBy,
+			"void_reason": reason,
+			"updated_at":  at,
+			"deleted_at":  at,
+		})
+
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/request"
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// Inactive lists the followers who have not attended any event in the last req.Weeks weeks.
+// Followers registered within that period are left out, since they had no chance to stop coming yet.
+func (s *service) Inactive(ctx context.Context, pg *pagination.Pagination, req request.EventAttendanceInactiveRequest) (
+	followers []response.InactiveFollower, meta utility.PaginationMeta, err error,
+) {
+	contextValues, err := utility.ValuesFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	since := time.Now().AddDate(0, 0, -7*req.Weeks)
+	pg.AddCustomScope(
+		func(db *gorm.DB) *gorm.DB {
+			if contextValues.DomainID != nil {
+				return db.Where("followers.domain_id = ?", *contextValues.DomainID)
+			}
+			return db
+		},
+		func(db *gorm.DB) *gorm.DB {
+			return db.
+				Where("followers.created_at < ?", since).
+				Where(
+					"NOT EXISTS (SELECT 1 FROM event_attendances recent WHERE recent.follower_id = followers.id "+
+						"AND recent.attended_at >= ? AND recent.deleted_at IS NULL)",
+					since,
+				)
+		},
+	)
+
+	found, err := s.followerRepo.FindAll(ctx, pg)
+	if err != nil {
+		return
+	}
+
+	count, err := s.followerRepo.Count(ctx, pg)
+	if err != nil {
+		return
+	}
+
+	followerIDs := make([]uint64, 0, len(found))
+	for _, follower := range found {
+		followerIDs = append(followerIDs, follower.ID)
+	}
+
+	lastAttendedAt, err := s.eventAttendanceRepo.FindLastAttendedAt(ctx, followerIDs)
+	if err != nil {
+		return
+	}
+
+	followers = make([]response.InactiveFollower, 0, len(found))
+	for _, follower := range found {
+		inactive := response.InactiveFollower{Follower: follower}
+		if last, ok := lastAttendedAt[follower.ID]; ok {
+			inactive.LastAttendedAt = &last
+		}
+		followers = append(followers, inactive)
+	}
+
+	meta.Limit = pg.Limit
+	meta.Offset = pg.Offset
+	meta.Total = count
+
+	return
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/request"
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// maxReportPeriods caps how many days, weeks or months a single report can cover.
+const maxReportPeriods = 1000
+
+// Report aggregates the attendances matching the report filters by event, day, week or month.
+// Days, weeks (starting on Monday) and months are resolved in the domain's timezone.
+// The attendances are counted in the database; only the aggregated rows are loaded.
+func (s *service) Report(ctx context.Context, pg *pagination.Pagination, req request.EventAttendanceReportRequest) (
+	report response.AttendanceReport, err error,
+) {
+	contextValues, err := utility.ValuesFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	pg.AddCustomScope(func(db *gorm.DB) *gorm.DB {
+		if contextValues.DomainID != nil {
+			return db.Where("Event.domain_id = ?", *contextValues.DomainID)
+		}
+		return db
+	})
+
+	location := utility.LocationFromContext(ctx)
+	report.GroupBy = req.GroupBy
+	report.Timezone = location.String()
+	report.Buckets = make([]response.AttendanceReportBucket, 0)
+
+	summary, err := s.eventAttendanceRepo.AggregateStats(ctx, pg, clause.Expr{})
+	if err != nil {
+		return
+	}
+	if len(summary) > 0 {
+		report.Summary = summary[0].AttendanceStats
+	}
+	if report.Summary.Attendances == 0 {
+		return
+	}
+
+	bucketKey := clause.Expr{SQL: "event_attendances.event_id"}
+	var periodStarts map[string]time.Time
+	if req.GroupBy != request.ReportGroupByEvent {
+		bucketKey, periodStarts, err = s.periodBucketKey(ctx, pg, req.GroupBy, location)
+		if err != nil {
+			return
+		}
+	}
+
+	report.Buckets, err = s.eventAttendanceRepo.AggregateStats(ctx, pg, bucketKey)
+	if err != nil {
+		return
+	}
+
+	for i := range report.Buckets {
+		bucket := &report.Buckets[i]
+		if req.GroupBy == request.ReportGroupByEvent {
+			eventID, parseErr := strconv.ParseUint(bucket.Key, 10, 64)
+			if parseErr != nil {
+				return report, parseErr
+			}
+			bucket.EventID = &eventID
+			continue
+		}
+
+		start := periodStarts[bucket.Key]
+		bucket.PeriodStart = &start
+		bucket.EventName = nil
+	}
+	sortReportBuckets(report.Buckets, req.GroupBy)
+
+	return
+}
+
+// periodBucketKey builds a SQL expression mapping attended_at to the key of its day, week or month.
+// The period boundaries are computed here, in the report timezone, and compared against in the database,
+// which keeps the grouping independent of the database's date and timezone functions.
+func (s *service) periodBucketKey(ctx context.Context, pg *pagination.Pagination, groupBy string, location *time.Location) (
+	bucketKey clause.Expr, periodStarts map[string]time.Time, err error,
+) {
+	first, last, found, err := s.eventAttendanceRepo.FindAttendedAtRange(ctx, pg)
+	if err != nil || !found {
+		return
+	}
+
+	var sql strings.Builder
+	sql.WriteString("CASE")
+	periodStarts = make(map[string]time.Time)
+	for start := periodStart(first, groupBy, location); !start.After(last); {
+		if len(periodStarts) == maxReportPeriods {
+			return bucketKey, nil, &errors.AppError{
+				Message: fmt.Sprintf("a report can cover at most %d periods, narrow the attended_at filter or group by a longer period", maxReportPeriods),
+				Status:  http.StatusBadRequest,
+			}
+		}
+
+		next := nextPeriodStart(start, groupBy)
+		key := periodKey(start, groupBy)
+		sql.WriteString(" WHEN event_attendances.attended_at < ? THEN ?")
+		bucketKey.Vars = append(bucketKey.Vars, next.UTC(), key)
+		periodStarts[key] = start
+		start = next
+	}
+	sql.WriteString(" END")
+	bucketKey.SQL = sql.String()
+
+	return
+}
+
+// periodStart returns the start of the day, week (Monday) or month containing t.
+func periodStart(t time.Time, groupBy string, location *time.Location) time.Time {
+	year, month, day := t.In(location).Date()
+	switch groupBy {
+	case request.ReportGroupByWeek:
+		start := time.Date(year, month, day, 0, 0, 0, 0, location)
+		return start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+	case request.ReportGroupByMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, location)
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, location)
+}
+
+// nextPeriodStart returns the start of the period following the one starting at start.
+func nextPeriodStart(start time.Time, groupBy string) time.Time {
+	switch groupBy {
+	case request.ReportGroupByWeek:
+		return start.AddDate(0, 0, 7)
+	case request.ReportGroupByMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// periodKey formats the key of the period starting at start: 2006-01-02 for days and weeks, 2006-01 for months.
+func periodKey(start time.Time, groupBy string) string {
+	if groupBy == request.ReportGroupByMonth {
+		return start.Format("2006-01")
+	}
+	return start.Format("2006-01-02")
+}
+
+// sortReportBuckets orders event buckets by attendances, most attended first, and period buckets chronologically.
+func sortReportBuckets(buckets []response.AttendanceReportBucket, groupBy string) {
+	sort.Slice(buckets, func(i, j int) bool {
+		if groupBy == request.ReportGroupByEvent {
+			if buckets[i].Attendances != buckets[j].Attendances {
+				return buckets[i].Attendances > buckets[j].Attendances
+			}
+			return *buckets[i].EventID < *buckets[j].EventID
+		}
+		return buckets[i].PeriodStart.Before(*buckets[j].PeriodStart)
+	})
+}
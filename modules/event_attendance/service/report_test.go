@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/event_attendance"
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/request"
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/response"
+	eventAttendanceRepo "github.com/PhantomX7/dhamma/modules/event_attendance/repository"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	pointMutationRepo "github.com/PhantomX7/dhamma/modules/point_mutation/repository"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// reportFixture holds two events of a domain in UTC+7 and the attendances of a youth and an adult follower.
+// Attendances of another domain and a voided attendance must not show up in the report.
+type reportFixture struct {
+	db      *gorm.DB
+	service event_attendance.Service
+	ctx     context.Context
+	first   entity.Event
+	second  entity.Event
+}
+
+func newReportFixture(t *testing.T) reportFixture {
+	db := setupEventAttendanceTestDB(t)
+
+	domain := testdb.Domain(t, db)
+	other := testdb.Domain(t, db, func(domain *entity.Domain) {
+		domain.Name, domain.Code = "Other", "other"
+	})
+
+	youth := entity.Follower{DomainID: domain.ID, Name: "Ani", IsYouth: true}
+	adult := entity.Follower{DomainID: domain.ID, Name: "Budi"}
+	outsider := entity.Follower{DomainID: other.ID, Name: "Citra"}
+	for _, follower := range []*entity.Follower{&youth, &adult, &outsider} {
+		require.NoError(t, db.Create(follower).Error)
+	}
+
+	first := entity.Event{DomainID: domain.ID, Name: "Puja"}
+	second := entity.Event{DomainID: domain.ID, Name: "Meditation"}
+	otherEvent := entity.Event{DomainID: other.ID, Name: "Puja"}
+	for _, event := range []*entity.Event{&first, &second, &otherEvent} {
+		require.NoError(t, db.Create(event).Error)
+	}
+
+	attend := func(follower entity.Follower, event entity.Event, at time.Time) entity.EventAttendance {
+		attendance := entity.EventAttendance{FollowerID: follower.ID, EventID: event.ID, AttendedAt: at}
+		require.NoError(t, db.Create(&attendance).Error)
+		return attendance
+	}
+
+	// Budi first attended in December, before the reported range
+	attend(adult, first, time.Date(2024, time.December, 30, 3, 0, 0, 0, time.UTC))
+	// Monday 6 January, 09:00 in Jakarta: Ani's first attendance ever
+	attend(youth, first, time.Date(2025, time.January, 6, 2, 0, 0, 0, time.UTC))
+	// Still 6 January in UTC but already Tuesday 7 January, 01:00 in Jakarta
+	attend(youth, second, time.Date(2025, time.January, 6, 18, 0, 0, 0, time.UTC))
+	attend(adult, first, time.Date(2025, time.January, 13, 3, 0, 0, 0, time.UTC))
+	attend(outsider, otherEvent, time.Date(2025, time.January, 6, 2, 0, 0, 0, time.UTC))
+
+	voided := attend(youth, first, time.Date(2025, time.January, 20, 3, 0, 0, 0, time.UTC))
+	require.NoError(t, db.Delete(&voided).Error)
+
+	return reportFixture{
+		db:      db,
+		service: New(eventAttendanceRepo.New(db), followerRepo.New(db), pointMutationRepo.New(db), transaction_manager.New(db)),
+		ctx:     testdb.Context(domain),
+		first:   first,
+		second:  second,
+	}
+}
+
+func (f reportFixture) report(t *testing.T, groupBy string, conditions map[string][]string) response.AttendanceReport {
+	t.Helper()
+
+	report, err := f.service.Report(f.ctx, request.NewEventAttendanceReportPagination(conditions), request.EventAttendanceReportRequest{GroupBy: groupBy})
+	require.NoError(t, err)
+	return report
+}
+
+// january limits the report to January 2025 in the domain's timezone.
+var january = map[string][]string{"attended_at": {"between:2025-01-01,2025-01-31"}}
+
+func TestReport_Summary(t *testing.T) {
+	f := newReportFixture(t)
+
+	report := f.report(t, request.ReportGroupByMonth, january)
+	assert.Equal(t, "Asia/Jakarta", report.Timezone)
+	assert.Equal(t, response.AttendanceStats{
+		Attendances:        3,
+		UniqueFollowers:    2,
+		NewFollowers:       1,
+		ReturningFollowers: 1,
+		YouthFollowers:     1,
+		AdultFollowers:     1,
+	}, report.Summary)
+
+	require.Len(t, report.Buckets, 1)
+	assert.Equal(t, "2025-01", report.Buckets[0].Key)
+	assert.Equal(t, report.Summary, report.Buckets[0].AttendanceStats)
+	assert.Nil(t, report.Buckets[0].EventName)
+}
+
+func TestReport_GroupByDayUsesDomainTimezone(t *testing.T) {
+	f := newReportFixture(t)
+
+	report := f.report(t, request.ReportGroupByDay, january)
+	require.Len(t, report.Buckets, 3)
+
+	keys := []string{report.Buckets[0].Key, report.Buckets[1].Key, report.Buckets[2].Key}
+	assert.Equal(t, []string{"2025-01-06", "2025-01-07", "2025-01-13"}, keys)
+
+	location, err := time.LoadLocation("Asia/Jakarta")
+	require.NoError(t, err)
+	assert.True(t, time.Date(2025, time.January, 7, 0, 0, 0, 0, location).Equal(*report.Buckets[1].PeriodStart))
+
+	// Ani's first attendance is on the 6th, so on the 7th she is returning
+	assert.Equal(t, 1, report.Buckets[0].NewFollowers)
+	assert.Equal(t, 0, report.Buckets[1].NewFollowers)
+	assert.Equal(t, 1, report.Buckets[1].ReturningFollowers)
+	assert.Equal(t, 1, report.Buckets[2].AdultFollowers)
+}
+
+func TestReport_GroupByWeek(t *testing.T) {
+	f := newReportFixture(t)
+
+	report := f.report(t, request.ReportGroupByWeek, january)
+	require.Len(t, report.Buckets, 2)
+
+	assert.Equal(t, "2025-01-06", report.Buckets[0].Key)
+	assert.Equal(t, 2, report.Buckets[0].Attendances)
+	assert.Equal(t, 1, report.Buckets[0].UniqueFollowers)
+	assert.Equal(t, 1, report.Buckets[0].YouthFollowers)
+
+	assert.Equal(t, "2025-01-13", report.Buckets[1].Key)
+	assert.Equal(t, 1, report.Buckets[1].Attendances)
+}
+
+func TestReport_GroupByEvent(t *testing.T) {
+	f := newReportFixture(t)
+
+	report := f.report(t, request.ReportGroupByEvent, january)
+	require.Len(t, report.Buckets, 2)
+
+	assert.Equal(t, f.first.ID, *report.Buckets[0].EventID)
+	assert.Equal(t, "Puja", *report.Buckets[0].EventName)
+	assert.Equal(t, 2, report.Buckets[0].Attendances)
+	assert.Equal(t, 2, report.Buckets[0].UniqueFollowers)
+	assert.Equal(t, 1, report.Buckets[0].NewFollowers)
+	assert.Nil(t, report.Buckets[0].PeriodStart)
+
+	assert.Equal(t, f.second.ID, *report.Buckets[1].EventID)
+	assert.Equal(t, 1, report.Buckets[1].Attendances)
+	assert.Equal(t, 0, report.Buckets[1].NewFollowers)
+}
+
+func TestReport_FiltersNarrowTheTotals(t *testing.T) {
+	f := newReportFixture(t)
+
+	report := f.report(t, request.ReportGroupByEvent, map[string][]string{"is_youth": {"true"}})
+	assert.Equal(t, 2, report.Summary.Attendances)
+	assert.Equal(t, 1, report.Summary.UniqueFollowers)
+	assert.Len(t, report.Buckets, 2)
+
+	empty := f.report(t, request.ReportGroupByDay, map[string][]string{"attended_at": {"between:2026-01-01,2026-01-31"}})
+	assert.Zero(t, empty.Summary.Attendances)
+	assert.Empty(t, empty.Buckets)
+}
+
+func TestReport_TooManyPeriods(t *testing.T) {
+	f := newReportFixture(t)
+
+	old := entity.EventAttendance{FollowerID: 1, EventID: f.first.ID, AttendedAt: time.Date(2020, time.January, 1, 3, 0, 0, 0, time.UTC)}
+	require.NoError(t, f.db.Create(&old).Error)
+
+	_, err := f.service.Report(f.ctx, request.NewEventAttendanceReportPagination(map[string][]string{}), request.EventAttendanceReportRequest{GroupBy: request.ReportGroupByDay})
+	var appErr *errors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusBadRequest, appErr.Status)
+
+	// The same range fits in months
+	report := f.report(t, request.ReportGroupByMonth, map[string][]string{})
+	assert.Equal(t, 5, report.Summary.Attendances)
+	assert.Equal(t, "2020-01", report.Buckets[0].Key)
+}
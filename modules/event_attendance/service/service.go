@@ -1,17 +1,29 @@
 package service
 
 import (
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
 	"github.com/PhantomX7/dhamma/modules/event_attendance"
+	"github.com/PhantomX7/dhamma/modules/follower"
+	"github.com/PhantomX7/dhamma/modules/point_mutation"
 )
 
 type service struct {
 	eventAttendanceRepo event_attendance.Repository
+	followerRepo        follower.Repository
+	pointMutationRepo   point_mutation.Repository
+	transactionManager  transaction_manager.Client
 }
 
 func New(
 	eventAttendanceRepo event_attendance.Repository,
+	followerRepo follower.Repository,
+	pointMutationRepo point_mutation.Repository,
+	transactionManager transaction_manager.Client,
 ) event_attendance.Service {
 	return &service{
 		eventAttendanceRepo: eventAttendanceRepo,
+		followerRepo:        followerRepo,
+		pointMutationRepo:   pointMutationRepo,
+		transactionManager:  transactionManager,
 	}
 }
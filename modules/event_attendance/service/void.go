@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Void reverses an attendance recorded by mistake. In a single transaction it records who voided the
+// attendance and why, soft-deletes it, and writes a negative point mutation against the same source
+// that takes back the points it awarded. Once voided the follower can check in to the event again.
+// The soft delete is conditional, so only one of several concurrent voids reverses the points.
+func (s *service) Void(ctx context.Context, eventAttendanceID uint64, req request.EventAttendanceVoidRequest) (eventAttendance entity.EventAttendance, err error) {
+	eventAttendance, err = s.eventAttendanceRepo.FindByID(ctx, eventAttendanceID, "Event")
+	if err != nil {
+		return
+	}
+
+	contextValues, err := utility.CheckDomainContext(ctx, eventAttendance.Event.DomainID, "event attendance", "void")
+	if err != nil {
+		return
+	}
+
+	alreadyVoided := &errors.AppError{
+		Message: "event attendance is already voided",
+		Status:  http.StatusConflict,
+	}
+	insufficientPoints := &errors.AppError{
+		Message: "follower no longer has enough points to reverse this attendance",
+		Status:  http.StatusBadRequest,
+	}
+
+	now := time.Now()
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		voided, err := s.eventAttendanceRepo.Void(ctx, eventAttendance.ID, contextValues.UserID, req.Reason, now, tx)
+		if err != nil {
+			return err
+		}
+		if !voided {
+			return alreadyVoided
+		}
+
+		awarded, err := s.pointMutationRepo.SumBySource(ctx, entity.PointMutationSourceTypeEventAttendance, eventAttendance.ID, tx)
+		if err != nil {
+			return err
+		}
+
+		if awarded != 0 {
+			applied, err := s.followerRepo.IncrementPoints(ctx, eventAttendance.FollowerID, -awarded, tx)
+			if err != nil {
+				return err
+			}
+			if !applied {
+				return insufficientPoints
+			}
+
+			pointMutation := entity.PointMutation{
+				FollowerID:  eventAttendance.FollowerID,
+				Amount:      -awarded,
+				SourceType:  entity.PointMutationSourceTypeEventAttendance,
+				SourceID:    &eventAttendance.ID,
+				Description: utility.PointOf(fmt.Sprintf("Attendance voided for event %s: %s", eventAttendance.Event.Name, req.Reason)),
+			}
+			if err = s.pointMutationRepo.Create(ctx, &pointMutation, tx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err == alreadyVoided || err == insufficientPoints {
+		return
+	}
+	if err != nil {
+		return eventAttendance, &errors.AppError{
+			Message: "failed to void event attendance",
+			Status:  http.StatusInternalServerError,
+			Err:     err,
+		}
+	}
+
+	eventAttendance.VoidedAt = &now
+	eventAttendance.VoidedBy = &contextValues.UserID
+	eventAttendance.VoidReason = &req.Reason
+	return
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/event_attendance"
+	"github.com/PhantomX7/dhamma/modules/event_attendance/dto/request"
+	eventAttendanceRepo "github.com/PhantomX7/dhamma/modules/event_attendance/repository"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	pointMutationRepo "github.com/PhantomX7/dhamma/modules/point_mutation/repository"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// setupEventAttendanceTestDB creates a database with the tables voiding an attendance writes to.
+func setupEventAttendanceTestDB(t *testing.T) *gorm.DB {
+	return testdb.New(t,
+		&entity.Domain{},
+		&entity.Follower{},
+		&entity.Event{},
+		&entity.EventAttendance{},
+		&entity.PointMutation{},
+	)
+}
+
+// barrierEventAttendanceRepo holds every FindByID until all expected callers have read the attendance,
+// so each concurrent void starts from the same, not yet voided, attendance.
+type barrierEventAttendanceRepo struct {
+	event_attendance.Repository
+	readers *sync.WaitGroup
+}
+
+func (r barrierEventAttendanceRepo) FindByID(ctx context.Context, id uint64, preloads ...string) (entity.EventAttendance, error) {
+	eventAttendance, err := r.Repository.FindByID(ctx, id, preloads...)
+	r.readers.Done()
+	r.readers.Wait()
+	return eventAttendance, err
+}
+
+// voidFixture is a follower who checked in to an event and was awarded its points.
+type voidFixture struct {
+	domain          entity.Domain
+	follower        entity.Follower
+	eventAttendance entity.EventAttendance
+}
+
+func newVoidFixture(t *testing.T, db *gorm.DB, pointsAwarded int, extraPoints int) voidFixture {
+	domain := testdb.Domain(t, db)
+	follower := testdb.Follower(t, db, domain.ID, testdb.WithPoints(pointsAwarded+extraPoints))
+
+	event := entity.Event{DomainID: domain.ID, Name: "Puja", PointsAwarded: pointsAwarded}
+	require.NoError(t, db.Create(&event).Error)
+
+	eventAttendance := entity.EventAttendance{FollowerID: follower.ID, EventID: event.ID, AttendedAt: time.Now()}
+	require.NoError(t, db.Create(&eventAttendance).Error)
+
+	mutation := entity.PointMutation{
+		FollowerID: follower.ID,
+		Amount:     pointsAwarded,
+		SourceType: entity.PointMutationSourceTypeEventAttendance,
+		SourceID:   &eventAttendance.ID,
+	}
+	require.NoError(t, db.Create(&mutation).Error)
+
+	return voidFixture{domain: domain, follower: follower, eventAttendance: eventAttendance}
+}
+
+func (f voidFixture) context() context.Context {
+	return testdb.Context(f.domain)
+}
+
+// assertVoidState checks the follower's points and how many mutations point at the attendance.
+func assertVoidState(t *testing.T, db *gorm.DB, f voidFixture, points int, mutations int64) {
+	t.Helper()
+
+	var follower entity.Follower
+	require.NoError(t, db.First(&follower, f.follower.ID).Error)
+	assert.Equal(t, points, follower.Points)
+
+	var count int64
+	require.NoError(t, db.Model(&entity.PointMutation{}).
+		Where("source_type = ? AND source_id = ?", entity.PointMutationSourceTypeEventAttendance, f.eventAttendance.ID).
+		Count(&count).Error)
+	assert.Equal(t, mutations, count)
+}
+
+func TestVoid_ReversesPoints(t *testing.T) {
+	db := setupEventAttendanceTestDB(t)
+	f := newVoidFixture(t, db, 5, 2)
+	s := New(eventAttendanceRepo.New(db), followerRepo.New(db), pointMutationRepo.New(db), transaction_manager.New(db))
+
+	voided, err := s.Void(f.context(), f.eventAttendance.ID, request.EventAttendanceVoidRequest{Reason: "wrong follower"})
+	require.NoError(t, err)
+	require.NotNil(t, voided.VoidedAt)
+	assert.Equal(t, "wrong follower", *voided.VoidReason)
+
+	assertVoidState(t, db, f, 2, 2)
+
+	var stored entity.EventAttendance
+	require.NoError(t, db.Unscoped().First(&stored, f.eventAttendance.ID).Error)
+	assert.True(t, stored.DeletedAt.Valid)
+	require.NotNil(t, stored.VoidedBy)
+	assert.Equal(t, uint64(1), *stored.VoidedBy)
+
+	var live int64
+	require.NoError(t, db.Model(&entity.EventAttendance{}).Where("id = ?", f.eventAttendance.ID).Count(&live).Error)
+	assert.Zero(t, live)
+}
+
+func TestVoid_InsufficientPointsLeavesAttendance(t *testing.T) {
+	db := setupEventAttendanceTestDB(t)
+	f := newVoidFixture(t, db, 5, 0)
+	require.NoError(t, db.Model(&entity.Follower{}).Where("id = ?", f.follower.ID).Update("points", 3).Error)
+	s := New(eventAttendanceRepo.New(db), followerRepo.New(db), pointMutationRepo.New(db), transaction_manager.New(db))
+
+	_, err := s.Void(f.context(), f.eventAttendance.ID, request.EventAttendanceVoidRequest{Reason: "mistake"})
+	var appErr *errors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusBadRequest, appErr.Status)
+
+	assertVoidState(t, db, f, 3, 1)
+
+	var stored entity.EventAttendance
+	require.NoError(t, db.First(&stored, f.eventAttendance.ID).Error)
+	assert.Nil(t, stored.VoidedAt)
+}
+
+func TestVoid_ConcurrentVoidsReverseOnce(t *testing.T) {
+	const voids = 6
+
+	db := setupEventAttendanceTestDB(t)
+	f := newVoidFixture(t, db, 5, 20)
+
+	readers := &sync.WaitGroup{}
+	readers.Add(voids)
+	s := New(
+		barrierEventAttendanceRepo{Repository: eventAttendanceRepo.New(db), readers: readers},
+		followerRepo.New(db),
+		pointMutationRepo.New(db),
+		transaction_manager.New(db),
+	)
+
+	var wg sync.WaitGroup
+	errs := make([]error, voids)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.Void(f.context(), f.eventAttendance.ID, request.EventAttendanceVoidRequest{Reason: "mistake"})
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		var appErr *errors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, http.StatusConflict, appErr.Status)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	assertVoidState(t, db, f, 20, 2)
+}
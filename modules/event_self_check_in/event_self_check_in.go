@@ -0,0 +1,16 @@
+package event_self_check_in
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.EventSelfCheckIn]
+	// HasUsedStep checks if a follower already checked in to an event with the code of the given step.
+	HasUsedStep(ctx context.Context, eventID uint64, followerID uint64, step int64, tx *gorm.DB) (bool, error)
+}
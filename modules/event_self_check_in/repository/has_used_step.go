@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// HasUsedStep checks if a follower already checked in to an event with the code of the given step.
+func (r *repository) HasUsedStep(ctx context.Context, eventID uint64, followerID uint64, step int64, tx *gorm.DB) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	var count int64
+
+	err := db.WithContext(ctx).
+		Model(&entity.EventSelfCheckIn{}).
+		Where("event_id = ?", eventID).
+		Where("follower_id = ?", followerID).
+		Where("step = ?", step).
+		Count(&count).Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/event_self_check_in"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.EventSelfCheckIn] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new event self check-in repository instance.
+func New(db *gorm.DB) event_self_check_in.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.EventSelfCheckIn](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all event self check-in entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.EventSelfCheckIn, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves an event self check-in entity by its ID.
+func (r *repository) FindByID(ctx context.Context, eventSelfCheckInID uint64, preloads ...string) (entity.EventSelfCheckIn, error) {
+	return r.base.FindByID(ctx, eventSelfCheckInID, preloads...)
+}
+
+// Create creates a new event self check-in entity.
+func (r *repository) Create(ctx context.Context, eventSelfCheckIn *entity.EventSelfCheckIn, tx *gorm.DB) error {
+	return r.base.Create(ctx, eventSelfCheckIn, tx)
+}
+
+// Update updates an existing event self check-in entity.
+func (r *repository) Update(ctx context.Context, eventSelfCheckIn *entity.EventSelfCheckIn, tx *gorm.DB) error {
+	return r.base.Update(ctx, eventSelfCheckIn, tx)
+}
+
+// Delete deletes an event self check-in entity.
+func (r *repository) Delete(ctx context.Context, eventSelfCheckIn *entity.EventSelfCheckIn, tx *gorm.DB) error {
+	return r.base.Delete(ctx, eventSelfCheckIn, tx)
+}
+
+// Count counts event self check-in entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves event self check-in entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.EventSelfCheckIn, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single event self check-in entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.EventSelfCheckIn, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves event self check-in entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.EventSelfCheckIn, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single event self check-in entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.EventSelfCheckIn, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any event self check-in records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
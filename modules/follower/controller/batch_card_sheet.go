@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility/cardsheet"
+)
+
+// BatchCardSheet handles the HTTP POST request downloading a printable PDF of the active cards of several followers.
+// Expected route: POST /followers/card-sheet
+func (ctrl *controller) BatchCardSheet(ctx *gin.Context) {
+	var req request.FollowerCardSheetRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	data, err := ctrl.followerService.CardSheet(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="cards.pdf"`)
+	ctx.Data(http.StatusOK, cardsheet.ContentType, data)
+}
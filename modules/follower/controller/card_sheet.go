@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility/cardsheet"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// CardSheet handles the HTTP GET request downloading a printable PDF of a follower's active cards.
+// Expected route: GET /followers/:id/card-sheet
+func (ctrl *controller) CardSheet(ctx *gin.Context) {
+	followerID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid follower id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	data, err := ctrl.followerService.CardSheet(ctx.Request.Context(), request.FollowerCardSheetRequest{
+		FollowerIDs: []uint64{followerID},
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="cards-%d.pdf"`, followerID))
+	ctx.Data(http.StatusOK, cardsheet.ContentType, data)
+}
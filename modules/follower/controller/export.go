@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility/spreadsheet"
+)
+
+// Export handles the HTTP GET request downloading the filtered followers as a CSV or XLSX file.
+// It accepts the same filters as Index.
+// Expected route: GET /followers/export?format=xlsx
+func (c *controller) Export(ctx *gin.Context) {
+	var req request.FollowerExportRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	format := req.Format
+	if format == "" {
+		format = spreadsheet.FormatCSV
+	}
+
+	data, err := c.followerService.Export(ctx.Request.Context(), request.NewFollowerPagination(ctx.Request.URL.Query()), format)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="followers.%s"`, format))
+	ctx.Data(http.StatusOK, spreadsheet.ContentType(format), data)
+}
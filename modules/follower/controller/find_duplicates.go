@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// FindDuplicates handles the HTTP GET request listing likely duplicate followers.
+// Expected route: GET /followers/duplicates
+func (c *controller) FindDuplicates(ctx *gin.Context) {
+	var req request.FollowerDuplicateRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.followerService.FindDuplicates(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// Merge handles the HTTP POST request merging a duplicate follower into the follower in the path.
+// Expected route: POST /followers/:id/merge
+func (ctrl *controller) Merge(ctx *gin.Context) {
+	followerID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid follower id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req request.FollowerMergeRequest
+	if err = ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := ctrl.followerService.Merge(ctx.Request.Context(), followerID, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// ReplaceCard handles the HTTP POST request to revoke a follower's card and issue a new one.
+// Expected route: POST /followers/:id/cards/:card_id/replace
+func (ctrl *controller) ReplaceCard(ctx *gin.Context) {
+	followerID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid follower id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	cardID, err := strconv.ParseUint(ctx.Param("card_id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid card id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req request.FollowerReplaceCardRequest
+	if err = ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := ctrl.followerService.ReplaceCard(ctx.Request.Context(), followerID, cardID, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
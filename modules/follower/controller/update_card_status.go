@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateCardStatus handles the HTTP PATCH request to change the status of a follower's card.
+// Expected route: PATCH /followers/:id/cards/:card_id/status
+func (ctrl *controller) UpdateCardStatus(ctx *gin.Context) {
+	followerID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid follower id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	cardID, err := strconv.ParseUint(ctx.Param("card_id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid card id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req request.FollowerCardStatusRequest
+	if err = ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := ctrl.followerService.UpdateCardStatus(ctx.Request.Context(), followerID, cardID, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
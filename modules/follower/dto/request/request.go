@@ -1,23 +1,77 @@
 package request
 
-import "github.com/PhantomX7/dhamma/utility/pagination"
+import (
+	"mime/multipart"
+	"time"
+
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
 
 type FollowerCreateRequest struct {
-	DomainID uint64  `json:"domain_id" form:"domain_id" binding:"required,exist=domains.id"`
-	Name     string  `json:"name" form:"name" binding:"required"`
-	Phone    *string `json:"phone" form:"phone" binding:"omitempty"`
-	IsYouth  *bool   `json:"is_youth" form:"is_youth" binding:"omitempty"`
+	DomainID     uint64  `json:"domain_id" form:"domain_id" binding:"required,exist=domains.id"`
+	Name         string  `json:"name" form:"name" binding:"required"`
+	Phone        *string `json:"phone" form:"phone" binding:"omitempty"`
+	IsYouth      *bool   `json:"is_youth" form:"is_youth" binding:"omitempty"`
+	IsBloodDonor *bool   `json:"is_blood_donor" form:"is_blood_donor" binding:"omitempty"`
 }
 
 type FollowerUpdateRequest struct {
-	Name    *string `json:"name" form:"name" binding:"omitempty"`
-	Phone   *string `json:"phone" form:"phone" binding:"omitempty"`
-	IsYouth *bool   `json:"is_youth" form:"is_youth" binding:"omitempty"`
+	Name         *string `json:"name" form:"name" binding:"omitempty"`
+	Phone        *string `json:"phone" form:"phone" binding:"omitempty"`
+	IsYouth      *bool   `json:"is_youth" form:"is_youth" binding:"omitempty"`
+	IsBloodDonor *bool   `json:"is_blood_donor" form:"is_blood_donor" binding:"omitempty"`
 }
 
 // FollowerAddCardRequest defines the payload for adding a card to a follower.
+// Without a Code one is generated in the domain's card code format.
 type FollowerAddCardRequest struct {
-	Code string `json:"code" form:"code" binding:"required,max=100,unique=cards.code"`
+	Code      string     `json:"code" form:"code" binding:"omitempty,max=100,unique=cards.code"`
+	ExpiresAt *time.Time `json:"expires_at" form:"expires_at" binding:"omitempty"`
+}
+
+// FollowerCardStatusRequest defines the payload for changing a card's status.
+// Cards expire by their expiry date only; reactivating an expired card needs a later ExpiresAt.
+type FollowerCardStatusRequest struct {
+	Status    string     `json:"status" form:"status" binding:"required,oneof=active lost revoked"`
+	ExpiresAt *time.Time `json:"expires_at" form:"expires_at" binding:"omitempty"`
+}
+
+// FollowerReplaceCardRequest defines the payload for issuing a new card in place of an old one.
+// Without a Code one is generated in the domain's card code format.
+type FollowerReplaceCardRequest struct {
+	Code      string     `json:"code" form:"code" binding:"omitempty,max=100,unique=cards.code"`
+	ExpiresAt *time.Time `json:"expires_at" form:"expires_at" binding:"omitempty"`
+}
+
+// FollowerCardSheetRequest defines the payload for printing the cards of several followers on one sheet.
+type FollowerCardSheetRequest struct {
+	FollowerIDs []uint64 `json:"follower_ids" form:"follower_ids" binding:"required,min=1,max=200"`
+}
+
+// FollowerImportRequest defines the payload for importing followers from a CSV or XLSX file.
+// When DryRun is set the rows are only validated and nothing is stored.
+type FollowerImportRequest struct {
+	DomainID uint64                `json:"domain_id" form:"domain_id" binding:"required,exist=domains.id"`
+	File     *multipart.FileHeader `json:"-" form:"file" binding:"required"`
+	DryRun   bool                  `json:"dry_run" form:"dry_run"`
+}
+
+// FollowerExportRequest defines the file format of a follower export.
+type FollowerExportRequest struct {
+	Format string `json:"format" form:"format" binding:"omitempty,oneof=csv xlsx"`
+}
+
+// FollowerDuplicateRequest defines the options for finding likely duplicate followers.
+// DomainID is only needed outside of domain routes.
+type FollowerDuplicateRequest struct {
+	DomainID      *uint64 `json:"domain_id" form:"domain_id" binding:"omitempty,exist=domains.id"`
+	MinSimilarity float64 `json:"min_similarity" form:"min_similarity" binding:"omitempty,gt=0,lte=1"`
+}
+
+// FollowerMergeRequest defines the payload for merging a duplicate follower into another one.
+type FollowerMergeRequest struct {
+	MergedFollowerID uint64  `json:"merged_follower_id" form:"merged_follower_id" binding:"required,exist=followers.id"`
+	Note             *string `json:"note" form:"note" binding:"omitempty,max=255"`
 }
 
 func NewFollowerPagination(conditions map[string][]string) *pagination.Pagination {
@@ -77,6 +131,13 @@ func NewFollowerPagination(conditions map[string][]string) *pagination.Paginatio
 				pagination.OperatorEquals,
 			},
 		}).
+		AddFilter("is_blood_donor", pagination.FilterConfig{
+			Field: "is_blood_donor",
+			Type:  pagination.FilterTypeBool,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorEquals,
+			},
+		}).
 		AddFilter("created_at", pagination.FilterConfig{
 			Field: "created_at",
 			Type:  pagination.FilterTypeDate,
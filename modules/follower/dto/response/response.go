@@ -0,0 +1,40 @@
+package response
+
+import "github.com/PhantomX7/dhamma/entity"
+
+// Reasons a pair of followers is reported as likely duplicates.
+const (
+	DuplicateReasonPhone = "phone"
+	DuplicateReasonName  = "name"
+)
+
+// FollowerDuplicate is a pair of followers that likely are the same person.
+// Follower is the older record and usually the one to keep.
+type FollowerDuplicate struct {
+	Follower       entity.Follower `json:"follower"`
+	Duplicate      entity.Follower `json:"duplicate"`
+	Reasons        []string        `json:"reasons"`
+	NameSimilarity float64         `json:"name_similarity"`
+}
+
+// FollowerImportRow is the parsed and validated content of a single import row.
+type FollowerImportRow struct {
+	// Row is the 1-based row number in the file, the header being row 1
+	Row        int               `json:"row"`
+	Name       string            `json:"name"`
+	Phone      *string           `json:"phone"`
+	IsYouth    *bool             `json:"is_youth"`
+	CardCodes  []string          `json:"card_codes"`
+	Errors     map[string]string `json:"errors,omitempty"`
+	FollowerID *uint64           `json:"follower_id,omitempty"`
+}
+
+// FollowerImportResponse summarises a follower import or its dry-run preview.
+type FollowerImportResponse struct {
+	DryRun   bool                `json:"dry_run"`
+	Total    int                 `json:"total"`
+	Valid    int                 `json:"valid"`
+	Invalid  int                 `json:"invalid"`
+	Imported int                 `json:"imported"`
+	Rows     []FollowerImportRow `json:"rows"`
+}
@@ -5,15 +5,27 @@ import (
 
 	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/modules/follower/dto/response"
 	"github.com/PhantomX7/dhamma/utility"
 	"github.com/PhantomX7/dhamma/utility/pagination"
 	"github.com/PhantomX7/dhamma/utility/repository"
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 type Repository interface {
 	repository.BaseRepositoryInterface[entity.Follower]
-	// Add other repository methods if needed
+	// IncrementPoints atomically adds amount to the follower's points.
+	// Negative amounts that would take the balance below zero are not applied and return false.
+	IncrementPoints(ctx context.Context, followerID uint64, amount int, tx *gorm.DB) (bool, error)
+	// FindByIDForUpdate reads the follower and locks its row until tx ends.
+	FindByIDForUpdate(ctx context.Context, followerID uint64, tx *gorm.DB) (entity.Follower, error)
+	// RecalculatePoints sets the follower's points to the sum of its point mutations and returns it.
+	RecalculatePoints(ctx context.Context, followerID uint64, tx *gorm.DB) (int, error)
+	// MarkBloodDonor flags the follower as a blood donor without touching its other columns.
+	MarkBloodDonor(ctx context.Context, followerID uint64, tx *gorm.DB) error
+	// FindByPhoneKey returns the followers of a domain whose phone number normalises to phoneKey, see fuzzy.NormalizePhone.
+	FindByPhoneKey(ctx context.Context, domainID uint64, phoneKey string) ([]entity.Follower, error)
 }
 
 type Service interface {
@@ -23,6 +35,14 @@ type Service interface {
 	Update(ctx context.Context, followerID uint64, request request.FollowerUpdateRequest) (entity.Follower, error)
 	AddCard(ctx context.Context, followerID uint64, req request.FollowerAddCardRequest) (entity.Card, error)
 	DeleteCard(ctx context.Context, followerID uint64, cardID uint64) error
+	UpdateCardStatus(ctx context.Context, followerID uint64, cardID uint64, req request.FollowerCardStatusRequest) (entity.Card, error)
+	ReplaceCard(ctx context.Context, followerID uint64, cardID uint64, req request.FollowerReplaceCardRequest) (entity.Card, error)
+	CardSheet(ctx context.Context, req request.FollowerCardSheetRequest) ([]byte, error)
+	Import(ctx context.Context, req request.FollowerImportRequest) (response.FollowerImportResponse, error)
+	Export(ctx context.Context, paginationConfig *pagination.Pagination, format string) ([]byte, error)
+	FindDuplicates(ctx context.Context, req request.FollowerDuplicateRequest) ([]response.FollowerDuplicate, error)
+	Merge(ctx context.Context, followerID uint64, req request.FollowerMergeRequest) (entity.FollowerMerge, error)
+	Merges(ctx context.Context, followerID uint64) ([]entity.FollowerMerge, error)
 }
 
 type Controller interface {
@@ -32,4 +52,13 @@ type Controller interface {
 	Update(c *gin.Context)
 	AddCard(c *gin.Context)
 	DeleteCard(c *gin.Context)
+	UpdateCardStatus(c *gin.Context)
+	ReplaceCard(c *gin.Context)
+	CardSheet(c *gin.Context)
+	BatchCardSheet(c *gin.Context)
+	Import(c *gin.Context)
+	Export(c *gin.Context)
+	FindDuplicates(c *gin.Context)
+	Merge(c *gin.Context)
+	Merges(c *gin.Context)
 }
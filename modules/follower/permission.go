@@ -14,14 +14,35 @@ type permission struct {
 	AddCard string
 	// Delete a card from a follower
 	DeleteCard string
+	// Mark a follower's card as active, lost or revoked
+	UpdateCardStatus string
+	// Revoke a follower's card and issue a new one in its place
+	ReplaceCard string
+	// Print followers' active cards on a PDF sheet
+	PrintCards string
+	// Import followers from a CSV or XLSX file
+	Import string
+	// Export followers to a CSV or XLSX file
+	Export string
+	// Find likely duplicate followers
+	FindDuplicates string
+	// Merge a duplicate follower into another follower
+	Merge string
 }
 
 var Permissions = permission{
-	Key:        "follower",
-	Index:      "index",
-	Show:       "show",
-	Create:     "create",
-	Update:     "update",
-	AddCard:    "add-card",
-	DeleteCard: "delete-card",
+	Key:              "follower",
+	Index:            "index",
+	Show:             "show",
+	Create:           "create",
+	Update:           "update",
+	AddCard:          "add-card",
+	DeleteCard:       "delete-card",
+	UpdateCardStatus: "update-card-status",
+	ReplaceCard:      "replace-card",
+	PrintCards:       "print-cards",
+	Import:           "import",
+	Export:           "export",
+	FindDuplicates:   "find-duplicates",
+	Merge:            "merge",
 }
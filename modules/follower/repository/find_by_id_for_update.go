@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindByIDForUpdate reads the follower and locks its row until tx ends, so checks made
+// against the follower inside tx cannot interleave with another transaction doing the same.
+func (r *repository) FindByIDForUpdate(ctx context.Context, followerID uint64, tx *gorm.DB) (follower entity.Follower, err error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	err = db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", followerID).
+		Take(&follower).Error
+	return
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindByPhoneKey returns the followers of a domain whose phone number normalises to phoneKey.
+// Phone numbers are stored as typed, so they are matched on the PhoneKey kept alongside them.
+func (r *repository) FindByPhoneKey(ctx context.Context, domainID uint64, phoneKey string) ([]entity.Follower, error) {
+	followers := make([]entity.Follower, 0)
+	err := r.db.WithContext(ctx).
+		Where("domain_id = ? AND phone_key = ?", domainID, phoneKey).
+		Order("id").
+		Find(&followers).Error
+	return followers, err
+}
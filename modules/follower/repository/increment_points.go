@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// IncrementPoints atomically adds amount to the follower's points with a single UPDATE,
+// so concurrent balance changes cannot overwrite each other. A negative amount is only applied
+// when the balance covers it; otherwise nothing is updated and applied is false.
+func (r *repository) IncrementPoints(ctx context.Context, followerID uint64, amount int, tx *gorm.DB) (applied bool, err error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	query := db.WithContext(ctx).
+		Model(&entity.Follower{}).
+		Where("id = ?", followerID)
+	if amount < 0 {
+		query = query.Where("points + ? >= 0", amount)
+	}
+
+	result := query.UpdateColumn("points", gorm.Expr("points + ?", amount))
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// MarkBloodDonor flags the follower as a blood donor. Only that column is written,
+// so a points update made in the same transaction is not overwritten.
+func (r *repository) MarkBloodDonor(ctx context.Context, followerID uint64, tx *gorm.DB) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	return db.WithContext(ctx).
+		Model(&entity.Follower{}).
+		Where("id = ?", followerID).
+		Update("is_blood_donor", true).Error
+}
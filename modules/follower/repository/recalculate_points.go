@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// RecalculatePoints sets the follower's points to the sum of its point mutations in a single UPDATE
+// and returns the new balance.
+func (r *repository) RecalculatePoints(ctx context.Context, followerID uint64, tx *gorm.DB) (int, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	db = db.WithContext(ctx)
+
+	ledger := db.Model(&entity.PointMutation{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("follower_id = ?", followerID)
+
+	err := db.Model(&entity.Follower{}).
+		Where("id = ?", followerID).
+		UpdateColumn("points", ledger).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var follower entity.Follower
+	err = db.Select("points").Where("id = ?", followerID).Take(&follower).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return follower.Points, nil
+}
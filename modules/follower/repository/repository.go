@@ -40,8 +40,13 @@ func (r *repository) Create(ctx context.Context, follower *entity.Follower, tx *
 }
 
 // Update updates an existing follower entity.
+// Points are never written here, so a stale balance cannot overwrite a concurrent change;
+// balances only change through IncrementPoints and RecalculatePoints.
 func (r *repository) Update(ctx context.Context, follower *entity.Follower, tx *gorm.DB) error {
-	return r.base.Update(ctx, follower, tx)
+	if tx == nil {
+		tx = r.db
+	}
+	return r.base.Update(ctx, follower, tx.Omit("points"))
 }
 
 // Delete deletes a follower entity.
@@ -22,11 +22,21 @@ func (s *service) AddCard(ctx context.Context, followerID uint64, req request.Fo
 		return
 	}
 
+	code := req.Code
+	if code == "" {
+		code, err = s.generateCardCode(ctx, follower.DomainID)
+		if err != nil {
+			return
+		}
+	}
+
 	// Prepare the new card entity
 	card = entity.Card{
 		FollowerID: followerID,
 		DomainID:   follower.DomainID, // Associate card with the follower's domain
-		Code:       req.Code,
+		Code:       code,
+		Status:     entity.CardStatusActive,
+		ExpiresAt:  req.ExpiresAt,
 	}
 
 	// Create the card
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/cardqr"
+	"github.com/PhantomX7/dhamma/utility/cardsheet"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// CardSheet renders the active cards of the given followers onto a printable PDF, in the order the followers are given.
+// Each card's QR code carries a signed payload so scanners can reject forged cards and cards of other domains.
+func (s *service) CardSheet(ctx context.Context, req request.FollowerCardSheetRequest) (data []byte, err error) {
+	now := time.Now()
+	cards := make([]cardsheet.Card, 0, len(req.FollowerIDs))
+	seen := make(map[uint64]bool, len(req.FollowerIDs))
+
+	for _, followerID := range req.FollowerIDs {
+		if seen[followerID] {
+			continue
+		}
+		seen[followerID] = true
+
+		var follower entity.Follower
+		follower, err = s.followerRepo.FindByID(ctx, followerID, "Domain", "Cards")
+		if err != nil {
+			return
+		}
+
+		_, err = utility.CheckDomainContext(ctx, follower.DomainID, "follower", "print cards of")
+		if err != nil {
+			return
+		}
+
+		for _, card := range follower.Cards {
+			if card.StatusAt(now) != entity.CardStatusActive {
+				continue
+			}
+
+			cards = append(cards, cardsheet.Card{
+				DomainName:   follower.Domain.Name,
+				FollowerName: follower.Name,
+				Code:         card.Code,
+				QRPayload:    cardqr.Sign([]byte(config.CARD_QR_SECRET), card.DomainID, card.Code),
+			})
+		}
+	}
+
+	if len(cards) == 0 {
+		return nil, &errors.AppError{
+			Message: "the selected followers have no active cards",
+			Status:  http.StatusBadRequest,
+			Err:     cardsheet.ErrNoCards,
+		}
+	}
+
+	data, err = cardsheet.Render(cards)
+	if err != nil {
+		return nil, &errors.AppError{
+			Message: "failed to render card sheet",
+			Status:  http.StatusInternalServerError,
+			Err:     err,
+		}
+	}
+
+	return
+}
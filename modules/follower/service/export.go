@@ -0,0 +1,93 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	"github.com/PhantomX7/dhamma/utility/spreadsheet"
+)
+
+// maxExportRows caps how many followers a single export can contain.
+const maxExportRows = 50000
+
+// Export writes every follower matching the Index filters into a CSV or XLSX file.
+// The limit and offset of the pagination are ignored; followers are read in pages of the maximum limit.
+// Exports matching more than maxExportRows followers are refused rather than cut short.
+func (s *service) Export(ctx context.Context, pg *pagination.Pagination, format string) (data []byte, err error) {
+	contextValues, err := utility.ValuesFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	addIndexScopes(pg, contextValues)
+
+	rows := [][]string{{columnID, columnName, columnPhone, columnIsYouth, columnPoints, columnCardCode, columnCreatedAt}}
+	location := utility.LocationFromContext(ctx)
+
+	pg.Limit = pg.Options.MaxLimit
+	for pg.Offset = 0; ; pg.Offset += pg.Limit {
+		var followers []entity.Follower
+		followers, err = s.followerRepo.FindAll(ctx, pg)
+		if err != nil {
+			return
+		}
+
+		for _, follower := range followers {
+			rows = append(rows, exportRow(follower, location))
+		}
+
+		if len(rows)-1 > maxExportRows {
+			return nil, &errors.AppError{
+				Message: fmt.Sprintf("too many followers to export, narrow the filters to at most %d", maxExportRows),
+				Status:  http.StatusBadRequest,
+			}
+		}
+
+		if len(followers) < pg.Limit {
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = spreadsheet.Write(&buf, format, rows); err != nil {
+		return nil, &errors.AppError{
+			Message: "failed to export followers",
+			Status:  http.StatusInternalServerError,
+			Err:     err,
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// exportRow converts a follower into a row matching the export header.
+func exportRow(follower entity.Follower, location *time.Location) []string {
+	phone := ""
+	if follower.Phone != nil {
+		phone = *follower.Phone
+	}
+
+	cardCodes := make([]string, 0, len(follower.Cards))
+	for _, card := range follower.Cards {
+		cardCodes = append(cardCodes, card.Code)
+	}
+
+	return []string{
+		strconv.FormatUint(follower.ID, 10),
+		follower.Name,
+		phone,
+		strconv.FormatBool(follower.IsYouth),
+		strconv.Itoa(follower.Points),
+		strings.Join(cardCodes, cardCodeSeparator),
+		follower.CreatedAt.In(location).Format("2006-01-02 15:04:05"),
+	}
+}
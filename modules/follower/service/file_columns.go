@@ -0,0 +1,17 @@
+package service
+
+// Column headers of follower import and export files.
+// Import matches headers case-insensitively and ignores columns it does not know,
+// so an exported file can be imported again as is.
+const (
+	columnID        = "id"
+	columnName      = "name"
+	columnPhone     = "phone"
+	columnIsYouth   = "is_youth"
+	columnPoints    = "points"
+	columnCardCode  = "card_code"
+	columnCreatedAt = "created_at"
+)
+
+// cardCodeSeparator separates multiple card codes in a single card_code cell.
+const cardCodeSeparator = ";"
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// findCard loads a card after checking that the follower is in the allowed domain and owns the card.
+// actionVerb completes the domain error message, e.g. "delete card from".
+func (s *service) findCard(ctx context.Context, followerID uint64, cardID uint64, actionVerb string) (card entity.Card, err error) {
+	// Find the follower
+	follower, err := s.followerRepo.FindByID(ctx, followerID)
+	if err != nil {
+		return
+	}
+
+	// Perform domain context check for the follower
+	_, err = utility.CheckDomainContext(ctx, follower.DomainID, "follower", actionVerb)
+	if err != nil {
+		return
+	}
+
+	// Find the card
+	card, err = s.cardRepo.FindByID(ctx, cardID)
+	if err != nil {
+		return
+	}
+
+	// Validate card ownership and domain
+	if card.FollowerID != followerID {
+		return card, &errors.AppError{
+			Message: fmt.Sprintf("card with id %d does not belong to follower %d", cardID, followerID),
+			Status:  http.StatusForbidden,
+		}
+	}
+	// Double check domain consistency, though follower check should cover it.
+	if card.DomainID != follower.DomainID {
+		return card, &errors.AppError{
+			Message: fmt.Sprintf("card with id %d is not in the same domain as follower %d", cardID, followerID),
+			Status:  http.StatusForbidden,
+		}
+	}
+
+	return
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/modules/follower/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/fuzzy"
+)
+
+const (
+	// defaultNameSimilarity is the name similarity from which two followers are reported as duplicates.
+	defaultNameSimilarity = 0.85
+	// maxDuplicatePairs caps how many pairs a single request returns.
+	maxDuplicatePairs = 500
+)
+
+// FindDuplicates reports pairs of followers in a domain that are likely the same person:
+// followers sharing a normalized phone number, and followers whose normalized names are at least
+// req.MinSimilarity alike. Phone matches are listed first, then pairs by descending name similarity.
+func (s *service) FindDuplicates(ctx context.Context, req request.FollowerDuplicateRequest) (duplicates []response.FollowerDuplicate, err error) {
+	contextValues, err := utility.ValuesFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	domainID := req.DomainID
+	if contextValues.DomainID != nil {
+		domainID = contextValues.DomainID
+	}
+	if domainID == nil {
+		return nil, &errors.AppError{
+			Message: "domain_id is required",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	minSimilarity := req.MinSimilarity
+	if minSimilarity == 0 {
+		minSimilarity = defaultNameSimilarity
+	}
+
+	followers, err := s.followerRepo.FindByField(ctx, "domain_id", *domainID)
+	if err != nil {
+		return
+	}
+
+	// Oldest first so the first follower of each pair is the natural survivor
+	sort.Slice(followers, func(i, j int) bool { return followers[i].ID < followers[j].ID })
+
+	names := make([]string, len(followers))
+	phoneGroups := make(map[string][]int)
+	nameBlocks := make(map[rune][]int)
+	for i, follower := range followers {
+		names[i] = fuzzy.NormalizeName(follower.Name)
+		if follower.Phone != nil {
+			if key := fuzzy.NormalizePhone(*follower.Phone); key != "" {
+				phoneGroups[key] = append(phoneGroups[key], i)
+			}
+		}
+		// Names are only compared within the same first letter to keep the search from growing quadratically
+		if names[i] != "" {
+			first := []rune(names[i])[0]
+			nameBlocks[first] = append(nameBlocks[first], i)
+		}
+	}
+
+	pairs := make(map[[2]int]*response.FollowerDuplicate)
+	pair := func(i, j int) *response.FollowerDuplicate {
+		key := [2]int{i, j}
+		if pairs[key] == nil {
+			pairs[key] = &response.FollowerDuplicate{
+				Follower:       followers[i],
+				Duplicate:      followers[j],
+				Reasons:        make([]string, 0, 2),
+				NameSimilarity: fuzzy.Similarity(names[i], names[j]),
+			}
+		}
+		return pairs[key]
+	}
+
+	for _, group := range phoneGroups {
+		for a := 0; a < len(group); a++ {
+			for b := a + 1; b < len(group); b++ {
+				duplicate := pair(group[a], group[b])
+				duplicate.Reasons = append(duplicate.Reasons, response.DuplicateReasonPhone)
+			}
+		}
+	}
+
+	for _, block := range nameBlocks {
+		for a := 0; a < len(block); a++ {
+			for b := a + 1; b < len(block); b++ {
+				i, j := block[a], block[b]
+				if fuzzy.Similarity(names[i], names[j]) < minSimilarity {
+					continue
+				}
+				duplicate := pair(i, j)
+				duplicate.Reasons = append(duplicate.Reasons, response.DuplicateReasonName)
+			}
+		}
+	}
+
+	duplicates = make([]response.FollowerDuplicate, 0, len(pairs))
+	for _, duplicate := range pairs {
+		duplicates = append(duplicates, *duplicate)
+	}
+	sort.Slice(duplicates, func(i, j int) bool {
+		iPhone, jPhone := hasReason(duplicates[i], response.DuplicateReasonPhone), hasReason(duplicates[j], response.DuplicateReasonPhone)
+		if iPhone != jPhone {
+			return iPhone
+		}
+		if duplicates[i].NameSimilarity != duplicates[j].NameSimilarity {
+			return duplicates[i].NameSimilarity > duplicates[j].NameSimilarity
+		}
+		if duplicates[i].Follower.ID != duplicates[j].Follower.ID {
+			return duplicates[i].Follower.ID < duplicates[j].Follower.ID
+		}
+		return duplicates[i].Duplicate.ID < duplicates[j].Duplicate.ID
+	})
+
+	if len(duplicates) > maxDuplicatePairs {
+		duplicates = duplicates[:maxDuplicatePairs]
+	}
+
+	return
+}
+
+// hasReason reports whether the duplicate pair was matched for the given reason.
+func hasReason(duplicate response.FollowerDuplicate, reason string) bool {
+	for _, r := range duplicate.Reasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/PhantomX7/dhamma/utility/cardcode"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// maxCardCodeAttempts bounds the retries when a generated code was already typed in by hand.
+const maxCardCodeAttempts = 5
+
+// generateCardCode returns an unused card code in the domain's card code format.
+func (s *service) generateCardCode(ctx context.Context, domainID uint64) (code string, err error) {
+	domain, err := s.domainRepo.FindByID(ctx, domainID)
+	if err != nil {
+		return
+	}
+
+	for range maxCardCodeAttempts {
+		var sequence uint64
+		sequence, err = s.domainRepo.NextCardSequence(ctx, domain.ID)
+		if err != nil {
+			return
+		}
+
+		code, err = cardcode.Generate(domain.CardCodeFormat, cardcode.Vars{
+			DomainCode: domain.Code,
+			Sequence:   sequence,
+			IssuedAt:   time.Now().In(domain.Location()),
+		})
+		if err != nil {
+			return
+		}
+
+		var exists bool
+		exists, err = s.cardRepo.Exists(ctx, map[string]any{"code": code})
+		if err != nil || !exists {
+			return
+		}
+	}
+
+	return "", &errors.AppError{
+		Message: "could not generate an unused card code, check the domain's card code format",
+		Status:  http.StatusConflict,
+	}
+}
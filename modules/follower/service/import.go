@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/stoewer/go-strcase"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/modules/follower/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/spreadsheet"
+)
+
+// maxImportRows caps how many data rows a single import file can contain.
+const maxImportRows = 5000
+
+// Import reads followers from a CSV or XLSX file and validates every row with the same rules as
+// Create and AddCard. Valid rows are stored in a single transaction unless the request is a dry run;
+// invalid rows are reported with their errors and skipped.
+func (s *service) Import(ctx context.Context, req request.FollowerImportRequest) (res response.FollowerImportResponse, err error) {
+	_, err = utility.CheckDomainContext(ctx, req.DomainID, "follower", "import")
+	if err != nil {
+		return
+	}
+
+	rows, err := readImportFile(req)
+	if err != nil {
+		return
+	}
+
+	columns := make(map[string]int, len(rows[0]))
+	for i, header := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(header))] = i
+	}
+	if _, ok := columns[columnName]; !ok {
+		return res, &errors.AppError{
+			Message: fmt.Sprintf("import file must have a %q column", columnName),
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	res.DryRun = req.DryRun
+	res.Rows = make([]response.FollowerImportRow, 0, len(rows)-1)
+	cardCodeRows := make(map[string]int)
+	for i, record := range rows[1:] {
+		if isBlankRecord(record) {
+			continue
+		}
+
+		row := parseImportRow(i+2, record, columns)
+		validateImportRow(&row, req.DomainID, cardCodeRows)
+
+		if len(row.Errors) == 0 {
+			res.Valid++
+		} else {
+			res.Invalid++
+		}
+		res.Rows = append(res.Rows, row)
+	}
+	res.Total = len(res.Rows)
+
+	if req.DryRun || res.Valid == 0 {
+		return
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		for i := range res.Rows {
+			row := &res.Rows[i]
+			if len(row.Errors) > 0 {
+				continue
+			}
+
+			follower := entity.Follower{
+				DomainID: req.DomainID,
+				Name:     row.Name,
+				Phone:    row.Phone,
+			}
+			if row.IsYouth != nil {
+				follower.IsYouth = *row.IsYouth
+			}
+			if err := s.followerRepo.Create(ctx, &follower, tx); err != nil {
+				return err
+			}
+
+			for _, code := range row.CardCodes {
+				card := entity.Card{
+					DomainID:   req.DomainID,
+					FollowerID: follower.ID,
+					Code:       code,
+					Status:     entity.CardStatusActive,
+				}
+				if err := s.cardRepo.Create(ctx, &card, tx); err != nil {
+					return err
+				}
+			}
+
+			row.FollowerID = &follower.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return res, &errors.AppError{
+			Message: "failed to import followers",
+			Status:  http.StatusInternalServerError,
+			Err:     err,
+		}
+	}
+
+	res.Imported = res.Valid
+	return
+}
+
+// readImportFile opens the uploaded file and returns its rows, the first one being the header.
+func readImportFile(req request.FollowerImportRequest) ([][]string, error) {
+	format, err := spreadsheet.FormatFromFilename(req.File.Filename)
+	if err != nil {
+		return nil, &errors.AppError{
+			Message: err.Error(),
+			Status:  http.StatusBadRequest,
+			Err:     err,
+		}
+	}
+
+	file, err := req.File.Open()
+	if err != nil {
+		return nil, &errors.AppError{
+			Message: "failed to open import file",
+			Status:  http.StatusBadRequest,
+			Err:     err,
+		}
+	}
+	defer file.Close()
+
+	rows, err := spreadsheet.Read(file, format)
+	if err != nil {
+		return nil, &errors.AppError{
+			Message: "failed to read import file",
+			Status:  http.StatusBadRequest,
+			Err:     err,
+		}
+	}
+
+	if len(rows) < 2 {
+		return nil, &errors.AppError{
+			Message: "import file has no rows",
+			Status:  http.StatusBadRequest,
+		}
+	}
+	if len(rows)-1 > maxImportRows {
+		return nil, &errors.AppError{
+			Message: fmt.Sprintf("import file cannot have more than %d rows", maxImportRows),
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	return rows, nil
+}
+
+// parseImportRow maps a record onto an import row using the header columns.
+// Values that cannot be parsed are reported as row errors.
+func parseImportRow(rowNumber int, record []string, columns map[string]int) response.FollowerImportRow {
+	value := func(column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	row := response.FollowerImportRow{
+		Row:       rowNumber,
+		Name:      value(columnName),
+		CardCodes: make([]string, 0),
+		Errors:    make(map[string]string),
+	}
+
+	if phone := value(columnPhone); phone != "" {
+		row.Phone = &phone
+	}
+
+	if isYouth := value(columnIsYouth); isYouth != "" {
+		parsed, ok := parseImportBool(isYouth)
+		if ok {
+			row.IsYouth = &parsed
+		} else {
+			row.Errors[columnIsYouth] = "Must be true or false"
+		}
+	}
+
+	for _, code := range strings.Split(value(columnCardCode), cardCodeSeparator) {
+		if code = strings.TrimSpace(code); code != "" {
+			row.CardCodes = append(row.CardCodes, code)
+		}
+	}
+
+	return row
+}
+
+// validateImportRow runs the FollowerCreateRequest and FollowerAddCardRequest validation rules
+// against the row. cardCodeRows tracks the card codes seen so far to catch duplicates within the file.
+func validateImportRow(row *response.FollowerImportRow, domainID uint64, cardCodeRows map[string]int) {
+	createRequest := request.FollowerCreateRequest{
+		DomainID: domainID,
+		Name:     row.Name,
+		Phone:    row.Phone,
+		IsYouth:  row.IsYouth,
+	}
+	addValidationErrors(row.Errors, binding.Validator.ValidateStruct(&createRequest), "")
+
+	for _, code := range row.CardCodes {
+		if firstRow, ok := cardCodeRows[code]; ok {
+			row.Errors[columnCardCode] = fmt.Sprintf("Card code %s is also used on row %d", code, firstRow)
+			continue
+		}
+		cardCodeRows[code] = row.Row
+
+		addCardRequest := request.FollowerAddCardRequest{Code: code}
+		addValidationErrors(row.Errors, binding.Validator.ValidateStruct(&addCardRequest), columnCardCode)
+	}
+
+	if len(row.Errors) == 0 {
+		row.Errors = nil
+	}
+}
+
+// addValidationErrors converts validator errors into row errors keyed by snake_case field name,
+// or by column when it is set.
+func addValidationErrors(rowErrors map[string]string, err error, column string) {
+	validationErrors, ok := err.(validator.ValidationErrors)
+	if !ok {
+		if err != nil {
+			rowErrors["row"] = err.Error()
+		}
+		return
+	}
+
+	for _, fieldError := range validationErrors {
+		key := column
+		if key == "" {
+			key = strcase.SnakeCase(fieldError.Field())
+		}
+
+		switch fieldError.Tag() {
+		case "required":
+			rowErrors[key] = "This field is required"
+		case "max":
+			rowErrors[key] = "Value is too long or large"
+		case "unique":
+			rowErrors[key] = fmt.Sprintf("Value %v is already taken", fieldError.Value())
+		case "exist":
+			rowErrors[key] = "Value does not exist"
+		default:
+			rowErrors[key] = "Invalid value"
+		}
+	}
+}
+
+// parseImportBool accepts the usual spreadsheet spellings of a boolean.
+func parseImportBool(value string) (bool, bool) {
+	switch strings.ToLower(value) {
+	case "y", "yes":
+		return true, true
+	case "n", "no":
+		return false, true
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	return parsed, err == nil
+}
+
+// isBlankRecord reports whether every cell of the record is empty.
+func isBlankRecord(record []string) bool {
+	for _, cell := range record {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -21,6 +21,27 @@ func (s *service) Index(ctx context.Context, pg *pagination.Pagination) (
 		return
 	}
 
+	addIndexScopes(pg, contextValues)
+
+	followers, err = s.followerRepo.FindAll(ctx, pg)
+	if err != nil {
+		return
+	}
+
+	count, err := s.followerRepo.Count(ctx, pg)
+	if err != nil {
+		return
+	}
+
+	meta.Limit = pg.Limit
+	meta.Offset = pg.Offset
+	meta.Total = count
+
+	return
+}
+
+// addIndexScopes adds the joins, preloads and domain restriction shared by Index and Export.
+func addIndexScopes(pg *pagination.Pagination, contextValues utility.ContextValues) {
 	// Combine all scopes into a single AddCustomScope call
 	pg.AddCustomScope(
 		func(db *gorm.DB) *gorm.DB {
@@ -38,20 +59,4 @@ func (s *service) Index(ctx context.Context, pg *pagination.Pagination) (
 			return db
 		},
 	)
-
-	followers, err = s.followerRepo.FindAll(ctx, pg)
-	if err != nil {
-		return
-	}
-
-	count, err := s.followerRepo.Count(ctx, pg)
-	if err != nil {
-		return
-	}
-
-	meta.Limit = pg.Limit
-	meta.Offset = pg.Offset
-	meta.Total = count
-
-	return
 }
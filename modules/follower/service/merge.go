@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Merge folds the follower req.MergedFollowerID into followerID in a single transaction.
+// Cards, event attendances, point mutations, reward redemption orders and blood donations are moved to the survivor,
+// the survivor's points are recomputed from its point mutations, the merged follower is soft-deleted and the merge
+// is recorded as an entity.FollowerMerge audit entry. The merged follower's row stays locked throughout, so no
+// points can be added to it once they have been counted.
+func (s *service) Merge(ctx context.Context, followerID uint64, req request.FollowerMergeRequest) (followerMerge entity.FollowerMerge, err error) {
+	if followerID == req.MergedFollowerID {
+		return followerMerge, &errors.AppError{
+			Message: "cannot merge a follower into itself",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	survivor, err := s.followerRepo.FindByID(ctx, followerID)
+	if err != nil {
+		return
+	}
+
+	contextValues, err := utility.CheckDomainContext(ctx, survivor.DomainID, "follower", "merge")
+	if err != nil {
+		return
+	}
+
+	merged, err := s.followerRepo.FindByID(ctx, req.MergedFollowerID)
+	if err != nil {
+		return
+	}
+
+	if merged.DomainID != survivor.DomainID {
+		return followerMerge, &errors.AppError{
+			Message: "cannot merge followers of different domains",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		var err error
+		merged, err = s.followerRepo.FindByIDForUpdate(ctx, merged.ID, tx)
+		if err != nil {
+			return err
+		}
+
+		followerMerge = entity.FollowerMerge{
+			DomainID:     survivor.DomainID,
+			SurvivorID:   survivor.ID,
+			MergedID:     merged.ID,
+			MergedName:   merged.Name,
+			MergedPhone:  merged.Phone,
+			PointsBefore: survivor.Points + merged.Points,
+			MergedBy:     contextValues.UserID,
+			Note:         req.Note,
+		}
+
+		followerMerge.CardsMoved, err = s.cardRepo.ReassignFollower(ctx, merged.ID, survivor.ID, tx)
+		if err != nil {
+			return err
+		}
+
+		followerMerge.AttendancesMoved, err = s.eventAttendanceRepo.ReassignFollower(ctx, merged.ID, survivor.ID, tx)
+		if err != nil {
+			return err
+		}
+
+		followerMerge.PointMutationsMoved, err = s.pointMutationRepo.ReassignFollower(ctx, merged.ID, survivor.ID, tx)
+		if err != nil {
+			return err
+		}
+
+		// Orders follow their point mutations so a later cancellation refunds the survivor
+		if _, err = s.rewardRedemptionRepo.ReassignFollower(ctx, merged.ID, survivor.ID, tx); err != nil {
+			return err
+		}
+
+		// Donations follow them too, and count towards the survivor's donation interval
+		if _, err = s.bloodDonationRepo.ReassignFollower(ctx, merged.ID, survivor.ID, tx); err != nil {
+			return err
+		}
+
+		survivor.Points, err = s.followerRepo.RecalculatePoints(ctx, survivor.ID, tx)
+		if err != nil {
+			return err
+		}
+		followerMerge.PointsAfter = survivor.Points
+
+		// Keep details the survivor is missing
+		if survivor.Phone == nil {
+			survivor.Phone = merged.Phone
+		}
+		survivor.IsBloodDonor = survivor.IsBloodDonor || merged.IsBloodDonor
+
+		if err = s.followerRepo.Update(ctx, &survivor, tx); err != nil {
+			return err
+		}
+
+		if err = s.followerRepo.Delete(ctx, &merged, tx); err != nil {
+			return err
+		}
+
+		return s.followerMergeRepo.Create(ctx, &followerMerge, tx)
+	})
+	if err != nil {
+		return followerMerge, &errors.AppError{
+			Message: "failed to merge followers",
+			Status:  http.StatusInternalServerError,
+			Err:     err,
+		}
+	}
+
+	return
+}
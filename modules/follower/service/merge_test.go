@@ -0,0 +1,87 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	bloodDonationRepo "github.com/PhantomX7/dhamma/modules/blood_donation/repository"
+	cardRepo "github.com/PhantomX7/dhamma/modules/card/repository"
+	domainRepo "github.com/PhantomX7/dhamma/modules/domain/repository"
+	eventAttendanceRepo "github.com/PhantomX7/dhamma/modules/event_attendance/repository"
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	followerMergeRepo "github.com/PhantomX7/dhamma/modules/follower_merge/repository"
+	pointMutationRepo "github.com/PhantomX7/dhamma/modules/point_mutation/repository"
+	rewardRedemptionRepo "github.com/PhantomX7/dhamma/modules/reward_redemption/repository"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+func TestMerge_MovesPointsAndBloodDonations(t *testing.T) {
+	db := testdb.New(t,
+		&entity.Domain{},
+		&entity.Follower{},
+		&entity.Card{},
+		&entity.EventAttendance{},
+		&entity.PointMutation{},
+		&entity.RewardRedemption{},
+		&entity.BloodDonation{},
+		&entity.FollowerMerge{},
+	)
+	domain := testdb.Domain(t, db)
+	survivor := testdb.Follower(t, db, domain.ID, testdb.WithPoints(3))
+	merged := testdb.Follower(t, db, domain.ID, testdb.WithPoints(10), func(follower *entity.Follower) {
+		follower.Name, follower.IsBloodDonor = "Budi Santoso", true
+	})
+
+	for _, mutation := range []entity.PointMutation{
+		{FollowerID: survivor.ID, Amount: 3, SourceType: entity.PointMutationSourceTypeManual},
+		{FollowerID: merged.ID, Amount: 10, SourceType: entity.PointMutationSourceTypeBloodDonation},
+	} {
+		require.NoError(t, db.Create(&mutation).Error)
+	}
+	donatedAt := time.Now().AddDate(0, 0, -10)
+	donation := entity.BloodDonation{
+		DomainID:       domain.ID,
+		FollowerID:     merged.ID,
+		DonatedAt:      donatedAt,
+		Location:       "PMI",
+		BloodType:      "O+",
+		NextEligibleAt: donatedAt.AddDate(0, 0, 60),
+		Points:         10,
+	}
+	require.NoError(t, db.Create(&donation).Error)
+
+	s := New(
+		followerRepo.New(db),
+		cardRepo.New(db),
+		eventAttendanceRepo.New(db),
+		pointMutationRepo.New(db),
+		followerMergeRepo.New(db),
+		domainRepo.New(db),
+		rewardRedemptionRepo.New(db),
+		bloodDonationRepo.New(db),
+		transaction_manager.New(db),
+	)
+
+	followerMerge, err := s.Merge(testdb.Context(domain), survivor.ID, request.FollowerMergeRequest{MergedFollowerID: merged.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 13, followerMerge.PointsBefore)
+	assert.Equal(t, 13, followerMerge.PointsAfter)
+
+	// The donation now counts towards the survivor, so deleting it takes the points back from the survivor
+	var moved entity.BloodDonation
+	require.NoError(t, db.First(&moved, donation.ID).Error)
+	assert.Equal(t, survivor.ID, moved.FollowerID)
+
+	var reloaded entity.Follower
+	require.NoError(t, db.First(&reloaded, survivor.ID).Error)
+	assert.Equal(t, 13, reloaded.Points)
+	assert.True(t, reloaded.IsBloodDonor)
+
+	assert.Error(t, db.First(&entity.Follower{}, merged.ID).Error)
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Merges lists the merges recorded into the follower, most recent first.
+func (s *service) Merges(ctx context.Context, followerID uint64) (followerMerges []entity.FollowerMerge, err error) {
+	follower, err := s.followerRepo.FindByID(ctx, followerID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, follower.DomainID, "follower", "show")
+	if err != nil {
+		return
+	}
+
+	followerMerges, err = s.followerMergeRepo.FindByField(ctx, "survivor_id", followerID)
+	if err != nil {
+		return
+	}
+
+	sort.Slice(followerMerges, func(i, j int) bool { return followerMerges[i].ID > followerMerges[j].ID })
+
+	return
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// ReplaceCard issues a new card to the follower and revokes the old one in the same transaction,
+// so the follower is never left with both codes usable or with neither.
+func (s *service) ReplaceCard(ctx context.Context, followerID uint64, cardID uint64, req request.FollowerReplaceCardRequest) (card entity.Card, err error) {
+	old, err := s.findCard(ctx, followerID, cardID, "replace card of")
+	if err != nil {
+		return
+	}
+
+	alreadyReplaced := &errors.AppError{
+		Message: "card has already been replaced",
+		Status:  http.StatusBadRequest,
+	}
+	if old.ReplacedByID != nil {
+		return card, alreadyReplaced
+	}
+
+	code := req.Code
+	if code == "" {
+		code, err = s.generateCardCode(ctx, old.DomainID)
+		if err != nil {
+			return
+		}
+	}
+
+	card = entity.Card{
+		FollowerID: old.FollowerID,
+		DomainID:   old.DomainID,
+		Code:       code,
+		Status:     entity.CardStatusActive,
+		ExpiresAt:  req.ExpiresAt,
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		if err := s.cardRepo.Create(ctx, &card, tx); err != nil {
+			return err
+		}
+
+		// The old card is only revoked if no concurrent replacement got to it first, otherwise the new card is rolled back
+		replaced, err := s.cardRepo.Replace(ctx, old.ID, card.ID, tx)
+		if err != nil {
+			return err
+		}
+		if !replaced {
+			return alreadyReplaced
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.Card{}, err
+	}
+
+	return
+}
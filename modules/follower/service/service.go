@@ -1,22 +1,51 @@
 package service
 
 import (
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/blood_donation"
 	"github.com/PhantomX7/dhamma/modules/card" // Import card module
+	"github.com/PhantomX7/dhamma/modules/domain"
+	"github.com/PhantomX7/dhamma/modules/event_attendance"
 	"github.com/PhantomX7/dhamma/modules/follower"
+	"github.com/PhantomX7/dhamma/modules/follower_merge"
+	"github.com/PhantomX7/dhamma/modules/point_mutation"
+	"github.com/PhantomX7/dhamma/modules/reward_redemption"
 )
 
 type service struct {
-	followerRepo follower.Repository
-	cardRepo     card.Repository // Add card repository
+	followerRepo         follower.Repository
+	cardRepo             card.Repository // Add card repository
+	eventAttendanceRepo  event_attendance.Repository
+	pointMutationRepo    point_mutation.Repository
+	followerMergeRepo    follower_merge.Repository
+	domainRepo           domain.Repository
+	rewardRedemptionRepo reward_redemption.Repository
+	bloodDonationRepo    blood_donation.Repository
+
+	transactionManager transaction_manager.Client
 }
 
 // New creates a new follower service instance.
 func New(
 	followerRepo follower.Repository,
 	cardRepo card.Repository, // Inject card repository
+	eventAttendanceRepo event_attendance.Repository,
+	pointMutationRepo point_mutation.Repository,
+	followerMergeRepo follower_merge.Repository,
+	domainRepo domain.Repository,
+	rewardRedemptionRepo reward_redemption.Repository,
+	bloodDonationRepo blood_donation.Repository,
+	transactionManager transaction_manager.Client,
 ) follower.Service {
 	return &service{
-		followerRepo: followerRepo,
-		cardRepo:     cardRepo, // Initialize card repository
+		followerRepo:         followerRepo,
+		cardRepo:             cardRepo, // Initialize card repository
+		eventAttendanceRepo:  eventAttendanceRepo,
+		pointMutationRepo:    pointMutationRepo,
+		followerMergeRepo:    followerMergeRepo,
+		domainRepo:           domainRepo,
+		rewardRedemptionRepo: rewardRedemptionRepo,
+		bloodDonationRepo:    bloodDonationRepo,
+		transactionManager:   transactionManager,
 	}
 }
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// UpdateCardStatus marks a follower's card as active, lost or revoked, optionally moving its expiry date.
+// A card that was replaced stays revoked.
+func (s *service) UpdateCardStatus(ctx context.Context, followerID uint64, cardID uint64, req request.FollowerCardStatusRequest) (card entity.Card, err error) {
+	card, err = s.findCard(ctx, followerID, cardID, "update card of")
+	if err != nil {
+		return
+	}
+
+	if card.ReplacedByID != nil {
+		return card, &errors.AppError{
+			Message: "card has been replaced and cannot be changed",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	if req.ExpiresAt != nil {
+		card.ExpiresAt = req.ExpiresAt
+	}
+	card.Status = req.Status
+
+	if req.Status == entity.CardStatusActive && card.StatusAt(time.Now()) == entity.CardStatusExpired {
+		return card, &errors.AppError{
+			Message: "card has expired, set a later expires_at to reactivate it",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	err = s.cardRepo.Update(ctx, &card, nil)
+	return
+}
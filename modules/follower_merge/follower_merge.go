@@ -0,0 +1,10 @@
+package follower_merge
+
+import (
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.FollowerMerge]
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower_merge"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.FollowerMerge] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new follower merge repository instance.
+func New(db *gorm.DB) follower_merge.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.FollowerMerge](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all follower merge entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.FollowerMerge, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a follower merge entity by its ID.
+func (r *repository) FindByID(ctx context.Context, followerMergeID uint64, preloads ...string) (entity.FollowerMerge, error) {
+	return r.base.FindByID(ctx, followerMergeID, preloads...)
+}
+
+// Create creates a new follower merge entity.
+func (r *repository) Create(ctx context.Context, followerMerge *entity.FollowerMerge, tx *gorm.DB) error {
+	return r.base.Create(ctx, followerMerge, tx)
+}
+
+// Update updates an existing follower merge entity.
+func (r *repository) Update(ctx context.Context, followerMerge *entity.FollowerMerge, tx *gorm.DB) error {
+	return r.base.Update(ctx, followerMerge, tx)
+}
+
+// Delete deletes a follower merge entity.
+func (r *repository) Delete(ctx context.Context, followerMerge *entity.FollowerMerge, tx *gorm.DB) error {
+	return r.base.Delete(ctx, followerMerge, tx)
+}
+
+// Count counts follower merge entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves follower merge entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.FollowerMerge, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single follower merge entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.FollowerMerge, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves follower merge entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.FollowerMerge, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single follower merge entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.FollowerMerge, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any follower merge records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
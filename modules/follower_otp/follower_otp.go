@@ -0,0 +1,19 @@
+package follower_otp
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.FollowerOTP]
+	// FindLatest returns the latest unconsumed code of a phone number and reports false if there is none.
+	FindLatest(ctx context.Context, domainID uint64, phoneKey string) (entity.FollowerOTP, bool, error)
+	// UseAttempt counts a guess of the code and reports false if the code is used up, consumed or expired.
+	UseAttempt(ctx context.Context, otpID uint64, at time.Time) (bool, error)
+	// Consume marks the code as used and reports false if it was already used by a concurrent request.
+	Consume(ctx context.Context, otpID uint64, at time.Time) (bool, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Consume marks the code as used and reports false if it was already used by a concurrent request.
+func (r *repository) Consume(ctx context.Context, otpID uint64, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.FollowerOTP{}).
+		Where("id = ? AND consumed_at IS NULL", otpID).
+		UpdateColumn("consumed_at", at)
+
+	return result.RowsAffected == 1, result.Error
+}
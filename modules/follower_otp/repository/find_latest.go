@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindLatest returns the latest unconsumed code of a phone number and reports false if there is none.
+func (r *repository) FindLatest(ctx context.Context, domainID uint64, phoneKey string) (entity.FollowerOTP, bool, error) {
+	var otp entity.FollowerOTP
+	err := r.db.WithContext(ctx).
+		Where("domain_id = ? AND phone_key = ? AND consumed_at IS NULL", domainID, phoneKey).
+		Order("id desc").
+		Take(&otp).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return otp, false, nil
+	}
+	if err != nil {
+		return otp, false, err
+	}
+
+	return otp, true, nil
+}
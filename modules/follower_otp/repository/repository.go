@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower_otp"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.FollowerOTP] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new follower OTP repository instance.
+func New(db *gorm.DB) follower_otp.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.FollowerOTP](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all follower OTP entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.FollowerOTP, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a follower OTP entity by its ID.
+func (r *repository) FindByID(ctx context.Context, followerOTPID uint64, preloads ...string) (entity.FollowerOTP, error) {
+	return r.base.FindByID(ctx, followerOTPID, preloads...)
+}
+
+// Create creates a new follower OTP entity.
+func (r *repository) Create(ctx context.Context, followerOTP *entity.FollowerOTP, tx *gorm.DB) error {
+	return r.base.Create(ctx, followerOTP, tx)
+}
+
+// Update updates an existing follower OTP entity.
+func (r *repository) Update(ctx context.Context, followerOTP *entity.FollowerOTP, tx *gorm.DB) error {
+	return r.base.Update(ctx, followerOTP, tx)
+}
+
+// Delete deletes a follower OTP entity.
+func (r *repository) Delete(ctx context.Context, followerOTP *entity.FollowerOTP, tx *gorm.DB) error {
+	return r.base.Delete(ctx, followerOTP, tx)
+}
+
+// Count counts follower OTP entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves follower OTP entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.FollowerOTP, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single follower OTP entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.FollowerOTP, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves follower OTP entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.FollowerOTP, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single follower OTP entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.FollowerOTP, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any follower OTP records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// UseAttempt counts a guess of the code in a single conditional update and reports false if the code
+// is used up, consumed or expired. Parallel guesses therefore cannot go beyond entity.FollowerOTPMaxAttempts.
+func (r *repository) UseAttempt(ctx context.Context, otpID uint64, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.FollowerOTP{}).
+		Where("id = ? AND consumed_at IS NULL AND expires_at > ?", otpID, at).
+		Where("attempts < ?", entity.FollowerOTPMaxAttempts).
+		UpdateColumn("attempts", gorm.Expr("attempts + 1"))
+
+	return result.RowsAffected == 1, result.Error
+}
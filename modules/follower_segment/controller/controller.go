@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"github.com/PhantomX7/dhamma/modules/follower_segment"
+)
+
+type controller struct {
+	followerSegmentService follower_segment.Service
+}
+
+func New(followerSegmentService follower_segment.Service) follower_segment.Controller {
+	return &controller{
+		followerSegmentService: followerSegmentService,
+	}
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Followers lists the followers currently matching a segment.
+// Expected route: GET /follower-segment/:id/followers?limit=20
+func (c *controller) Followers(ctx *gin.Context) {
+	segmentID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Error(&errors.AppError{
+			Message: "invalid follower segment id",
+			Status:  http.StatusBadRequest,
+		})
+		return
+	}
+
+	res, meta, err := c.followerSegmentService.Followers(ctx.Request.Context(), segmentID, ctx.Request.URL.Query())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildPaginationResponseSuccess("ok", res, meta))
+}
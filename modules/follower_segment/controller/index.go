@@ -0,0 +1,20 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/follower_segment/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+func (c *controller) Index(ctx *gin.Context) {
+	res, meta, err := c.followerSegmentService.Index(ctx.Request.Context(), request.NewFollowerSegmentPagination(ctx.Request.URL.Query()))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildPaginationResponseSuccess("ok", res, meta))
+}
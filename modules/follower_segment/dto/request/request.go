@@ -0,0 +1,77 @@
+package request
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	followerRequest "github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// FollowerSegmentCreateRequest defines the payload for saving a follower segment.
+// Filters uses the follower list query filters, e.g. {"is_youth": "true", "name": "like:budi"}.
+type FollowerSegmentCreateRequest struct {
+	DomainID     uint64            `json:"domain_id" form:"domain_id" binding:"required,exist=domains.id"`
+	Name         string            `json:"name" form:"name" binding:"required,max=255"`
+	Description  *string           `json:"description" form:"description" binding:"omitempty,max=500"`
+	Filters      map[string]string `json:"filters" form:"filters"`
+	InactiveDays *int              `json:"inactive_days" form:"inactive_days" binding:"omitempty,min=1,max=3650"`
+}
+
+// FollowerSegmentUpdateRequest defines the payload for updating a follower segment.
+// Filters, when given, replaces the saved filters.
+type FollowerSegmentUpdateRequest struct {
+	Name         *string           `json:"name" form:"name" binding:"omitempty,max=255"`
+	Description  *string           `json:"description" form:"description" binding:"omitempty,max=500"`
+	Filters      map[string]string `json:"filters" form:"filters"`
+	InactiveDays *int              `json:"inactive_days" form:"inactive_days" binding:"omitempty,min=0,max=3650"` // 0 clears the inactivity condition
+}
+
+func NewFollowerSegmentPagination(conditions map[string][]string) *pagination.Pagination {
+	filterDef := pagination.NewFilterDefinition().
+		AddFilter("name", pagination.FilterConfig{
+			TableName: "follower_segments",
+			Field:     "name",
+			Type:      pagination.FilterTypeString,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals, pagination.OperatorLike,
+			},
+		}).
+		AddSort("name", pagination.SortConfig{
+			TableName: "follower_segments",
+			Field:     "name",
+			Allowed:   true,
+		})
+
+	return pagination.NewPagination(
+		conditions,
+		filterDef,
+		pagination.PaginationOptions{
+			DefaultLimit: 20,
+			MaxLimit:     100,
+			DefaultOrder: "id desc",
+		},
+	)
+}
+
+// NewSegmentFollowerPagination selects the followers of a segment from its saved conditions.
+// With inactiveDays, followers who attended an event within that many days, or registered within them, are left out.
+func NewSegmentFollowerPagination(conditions map[string][]string, inactiveDays *int) *pagination.Pagination {
+	pg := followerRequest.NewFollowerPagination(conditions)
+
+	if inactiveDays != nil && *inactiveDays > 0 {
+		since := time.Now().AddDate(0, 0, -*inactiveDays)
+		pg.AddCustomScope(func(db *gorm.DB) *gorm.DB {
+			return db.
+				Where("followers.created_at < ?", since).
+				Where(
+					"NOT EXISTS (SELECT 1 FROM event_attendances recent WHERE recent.follower_id = followers.id "+
+						"AND recent.attended_at >= ? AND recent.deleted_at IS NULL)",
+					since,
+				)
+		})
+	}
+
+	return pg
+}
@@ -0,0 +1,35 @@
+package follower_segment
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower_segment/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.FollowerSegment]
+}
+
+type Service interface {
+	Index(ctx context.Context, pg *pagination.Pagination) ([]entity.FollowerSegment, utility.PaginationMeta, error)
+	Show(ctx context.Context, segmentID uint64) (entity.FollowerSegment, error)
+	Create(ctx context.Context, req request.FollowerSegmentCreateRequest) (entity.FollowerSegment, error)
+	Update(ctx context.Context, segmentID uint64, req request.FollowerSegmentUpdateRequest) (entity.FollowerSegment, error)
+	Delete(ctx context.Context, segmentID uint64) error
+	Followers(ctx context.Context, segmentID uint64, query map[string][]string) ([]entity.Follower, utility.PaginationMeta, error)
+}
+
+type Controller interface {
+	Index(ctx *gin.Context)
+	Show(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Followers(ctx *gin.Context)
+}
@@ -0,0 +1,27 @@
+package follower_segment
+
+type permission struct {
+	Key string
+	// Index all follower segments
+	Index string
+	// View follower segment details
+	Show string
+	// Create a new follower segment
+	Create string
+	// Update follower segment information
+	Update string
+	// Delete a follower segment
+	Delete string
+	// List the followers of a segment
+	Followers string
+}
+
+var Permissions = permission{
+	Key:       "follower-segment",
+	Index:     "index",
+	Show:      "show",
+	Create:    "create",
+	Update:    "update",
+	Delete:    "delete",
+	Followers: "followers",
+}
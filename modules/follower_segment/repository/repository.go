@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower_segment"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.FollowerSegment] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new follower_segment repository instance.
+func New(db *gorm.DB) follower_segment.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.FollowerSegment](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all follower_segment entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.FollowerSegment, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a follower_segment entity by its ID.
+func (r *repository) FindByID(ctx context.Context, segmentID uint64, preloads ...string) (entity.FollowerSegment, error) {
+	return r.base.FindByID(ctx, segmentID, preloads...)
+}
+
+// Create creates a new follower_segment entity.
+func (r *repository) Create(ctx context.Context, segment *entity.FollowerSegment, tx *gorm.DB) error {
+	return r.base.Create(ctx, segment, tx)
+}
+
+// Update updates an existing follower_segment entity.
+func (r *repository) Update(ctx context.Context, segment *entity.FollowerSegment, tx *gorm.DB) error {
+	return r.base.Update(ctx, segment, tx)
+}
+
+// Delete deletes a follower_segment entity.
+func (r *repository) Delete(ctx context.Context, segment *entity.FollowerSegment, tx *gorm.DB) error {
+	return r.base.Delete(ctx, segment, tx)
+}
+
+// Count counts follower_segment entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves follower_segment entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.FollowerSegment, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single follower_segment entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.FollowerSegment, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves follower_segment entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.FollowerSegment, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single follower_segment entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.FollowerSegment, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any follower_segment records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
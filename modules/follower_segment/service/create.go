@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower_segment/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Create implements follower_segment.Service
+func (s *service) Create(ctx context.Context, req request.FollowerSegmentCreateRequest) (segment entity.FollowerSegment, err error) {
+	_, err = utility.CheckDomainContext(ctx, req.DomainID, "follower segment", "create")
+	if err != nil {
+		return
+	}
+
+	filters, err := encodeFilters(req.Filters)
+	if err != nil {
+		return
+	}
+
+	segment = entity.FollowerSegment{
+		DomainID:     req.DomainID,
+		Name:         req.Name,
+		Description:  req.Description,
+		Filters:      filters,
+		InactiveDays: req.InactiveDays,
+	}
+
+	err = s.followerSegmentRepo.Create(ctx, &segment, nil)
+	return
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Delete implements follower_segment.Service
+func (s *service) Delete(ctx context.Context, segmentID uint64) (err error) {
+	segment, err := s.followerSegmentRepo.FindByID(ctx, segmentID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, segment.DomainID, "follower segment", "delete")
+	if err != nil {
+		return
+	}
+
+	return s.followerSegmentRepo.Delete(ctx, &segment, nil)
+}
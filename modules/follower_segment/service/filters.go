@@ -0,0 +1,40 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	followerRequest "github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// encodeFilters checks that every filter is a follower list filter and encodes them for FollowerSegment.Filters.
+func encodeFilters(filters map[string]string) (string, error) {
+	filterDef := followerRequest.NewFollowerPagination(nil).FilterDef
+
+	conditions := make(map[string][]string, len(filters))
+	unknown := make([]string, 0)
+	for name, value := range filters {
+		if !filterDef.HasFilter(name) {
+			unknown = append(unknown, name)
+			continue
+		}
+		conditions[name] = []string{value}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return "", &errors.AppError{
+			Message: fmt.Sprintf("unknown follower filters: %v", unknown),
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	encoded, err := json.Marshal(conditions)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
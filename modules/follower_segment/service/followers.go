@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower_segment/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// Followers lists the followers currently matching the segment.
+// Only the limit, offset and sort of the query are used; the filters come from the segment.
+func (s *service) Followers(ctx context.Context, segmentID uint64, query map[string][]string) (
+	followers []entity.Follower, meta utility.PaginationMeta, err error,
+) {
+	segment, err := s.Show(ctx, segmentID)
+	if err != nil {
+		return
+	}
+
+	conditions, err := segment.Conditions()
+	if err != nil {
+		return followers, meta, &errors.AppError{
+			Message: "invalid follower segment filters",
+			Status:  http.StatusInternalServerError,
+			Err:     err,
+		}
+	}
+	for _, key := range []string{pagination.QueryKeyLimit, pagination.QueryKeyOffset, pagination.QueryKeySort} {
+		if value, ok := query[key]; ok {
+			conditions[key] = value
+		}
+	}
+
+	pg := request.NewSegmentFollowerPagination(conditions, segment.InactiveDays)
+	pg.AddCustomScope(func(db *gorm.DB) *gorm.DB {
+		return db.Where("followers.domain_id = ?", segment.DomainID)
+	})
+
+	return s.followerService.Index(ctx, pg)
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"github.com/PhantomX7/dhamma/modules/follower"
+	"github.com/PhantomX7/dhamma/modules/follower_segment"
+)
+
+type service struct {
+	followerSegmentRepo follower_segment.Repository
+	followerService     follower.Service
+}
+
+func New(
+	followerSegmentRepo follower_segment.Repository,
+	followerService follower.Service,
+) follower_segment.Service {
+	return &service{
+		followerSegmentRepo: followerSegmentRepo,
+		followerService:     followerService,
+	}
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Show implements follower_segment.Service
+func (s *service) Show(ctx context.Context, segmentID uint64) (segment entity.FollowerSegment, err error) {
+	segment, err = s.followerSegmentRepo.FindByID(ctx, segmentID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, segment.DomainID, "follower segment", "show")
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/follower_segment/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Update implements follower_segment.Service
+func (s *service) Update(ctx context.Context, segmentID uint64, req request.FollowerSegmentUpdateRequest) (segment entity.FollowerSegment, err error) {
+	segment, err = s.followerSegmentRepo.FindByID(ctx, segmentID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, segment.DomainID, "follower segment", "update")
+	if err != nil {
+		return
+	}
+
+	if req.Name != nil {
+		segment.Name = *req.Name
+	}
+	if req.Description != nil {
+		segment.Description = req.Description
+	}
+	if req.Filters != nil {
+		if segment.Filters, err = encodeFilters(req.Filters); err != nil {
+			return
+		}
+	}
+	if req.InactiveDays != nil {
+		segment.InactiveDays = req.InactiveDays
+		if *req.InactiveDays == 0 {
+			segment.InactiveDays = nil
+		}
+	}
+
+	err = s.followerSegmentRepo.Update(ctx, &segment, nil)
+	return
+}
@@ -0,0 +1,24 @@
+package login_throttle
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.LoginThrottle]
+	// FindByIdentifier returns the failure counter of a username or IP address and reports false if there is none.
+	FindByIdentifier(ctx context.Context, scope, identifier string) (entity.LoginThrottle, bool, error)
+	// RecordFailure atomically counts a failed sign-in and returns the updated counter.
+	// The count starts over when the last failure is older than entity.LoginThrottleWindow.
+	RecordFailure(ctx context.Context, scope, identifier string, at time.Time) (entity.LoginThrottle, error)
+	// Block refuses sign-ins of the counter until the given time. It never shortens an existing block.
+	Block(ctx context.Context, throttleID uint64, until time.Time) error
+	// Forgive takes back one failure counted for an attempt that turned out not to fail.
+	Forgive(ctx context.Context, throttleID uint64) error
+	// Clear removes the failure counter and reports false if there was none.
+	Clear(ctx context.Context, scope, identifier string) (bool, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Block refuses sign-ins of the counter until the given time.
+// A block is only ever extended, so a parallel failure cannot shorten a lockout into a backoff.
+func (r *repository) Block(ctx context.Context, throttleID uint64, until time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entity.LoginThrottle{}).
+		Where("id = ? AND (blocked_until IS NULL OR blocked_until < ?)", throttleID, until).
+		UpdateColumn("blocked_until", until).Error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Clear removes the failure counter and reports false if there was none.
+// The row is deleted for good, as a soft deleted one would still hold the key's unique index
+// and the next RecordFailure could neither insert nor find the counter.
+func (r *repository) Clear(ctx context.Context, scope, identifier string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("scope = ? AND identifier = ?", scope, identifier).
+		Delete(&entity.LoginThrottle{})
+
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindByIdentifier returns the failure counter of a username or IP address and reports false if there is none.
+func (r *repository) FindByIdentifier(ctx context.Context, scope, identifier string) (entity.LoginThrottle, bool, error) {
+	var throttle entity.LoginThrottle
+	err := r.db.WithContext(ctx).
+		Where("scope = ? AND identifier = ?", scope, identifier).
+		Take(&throttle).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return throttle, false, nil
+	}
+	if err != nil {
+		return throttle, false, err
+	}
+
+	return throttle, true, nil
+}
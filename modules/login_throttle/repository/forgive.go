@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Forgive takes back one failure counted for an attempt that turned out not to fail,
+// e.g. because it succeeded or was refused by the throttle without being checked.
+func (r *repository) Forgive(ctx context.Context, throttleID uint64) error {
+	return r.db.WithContext(ctx).
+		Model(&entity.LoginThrottle{}).
+		Where("id = ? AND failures > 0", throttleID).
+		UpdateColumn("failures", gorm.Expr("failures - 1")).Error
+}
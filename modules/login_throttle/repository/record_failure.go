@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// RecordFailure atomically counts a failed sign-in and returns the updated counter.
+// The count starts over when the last failure is older than entity.LoginThrottleWindow.
+// Both steps are single statements so parallel guesses are all counted.
+func (r *repository) RecordFailure(ctx context.Context, scope, identifier string, at time.Time) (throttle entity.LoginThrottle, err error) {
+	db := r.db.WithContext(ctx)
+
+	err = db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entity.LoginThrottle{Scope: scope, Identifier: identifier, LastFailedAt: at}).Error
+	if err != nil {
+		return
+	}
+
+	err = db.Model(&entity.LoginThrottle{}).
+		Where("scope = ? AND identifier = ?", scope, identifier).
+		UpdateColumns(map[string]any{
+			"failures": gorm.Expr(
+				"CASE WHEN last_failed_at < ? AND (blocked_until IS NULL OR blocked_until <= ?) THEN 1 ELSE failures + 1 END",
+				at.Add(-entity.LoginThrottleWindow), at,
+			),
+			"last_failed_at": at,
+		}).Error
+	if err != nil {
+		return
+	}
+
+	err = db.Where("scope = ? AND identifier = ?", scope, identifier).Take(&throttle).Error
+	return
+}
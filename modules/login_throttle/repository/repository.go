@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/login_throttle"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.LoginThrottle] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new login_throttle repository instance.
+func New(db *gorm.DB) login_throttle.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.LoginThrottle](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all login_throttle entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.LoginThrottle, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a login_throttle entity by its ID.
+func (r *repository) FindByID(ctx context.Context, loginThrottleID uint64, preloads ...string) (entity.LoginThrottle, error) {
+	return r.base.FindByID(ctx, loginThrottleID, preloads...)
+}
+
+// Create creates a new login_throttle entity.
+func (r *repository) Create(ctx context.Context, loginThrottle *entity.LoginThrottle, tx *gorm.DB) error {
+	return r.base.Create(ctx, loginThrottle, tx)
+}
+
+// Update updates an existing login_throttle entity.
+func (r *repository) Update(ctx context.Context, loginThrottle *entity.LoginThrottle, tx *gorm.DB) error {
+	return r.base.Update(ctx, loginThrottle, tx)
+}
+
+// Delete deletes a login_throttle entity.
+func (r *repository) Delete(ctx context.Context, loginThrottle *entity.LoginThrottle, tx *gorm.DB) error {
+	return r.base.Delete(ctx, loginThrottle, tx)
+}
+
+// Count counts login_throttle entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves login_throttle entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.LoginThrottle, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single login_throttle entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.LoginThrottle, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves login_throttle entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.LoginThrottle, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single login_throttle entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.LoginThrottle, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any login_throttle records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	followerRequest "github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	"github.com/PhantomX7/dhamma/modules/outbound_message/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Broadcast handles the HTTP POST request queueing a chat template to filtered followers.
+// The followers are selected with the same query filters as the follower list.
+// Expected route: POST /outbound-message/broadcast?is_youth=true
+func (c *controller) Broadcast(ctx *gin.Context) {
+	var req request.OutboundMessageBroadcastRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.outboundMessageService.Broadcast(ctx.Request.Context(), followerRequest.NewFollowerPagination(ctx.Request.URL.Query()), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"github.com/PhantomX7/dhamma/modules/outbound_message"
+)
+
+type controller struct {
+	outboundMessageService outbound_message.Service
+}
+
+func New(outboundMessageService outbound_message.Service) outbound_message.Controller {
+	return &controller{
+		outboundMessageService: outboundMessageService,
+	}
+}
@@ -0,0 +1,70 @@
+package request
+
+import "github.com/PhantomX7/dhamma/utility/pagination"
+
+// OutboundMessageBroadcastRequest defines the payload for queueing a chat template to every matching follower.
+// The followers are selected with the follower list filters given in the query string.
+type OutboundMessageBroadcastRequest struct {
+	DomainID       uint64  `json:"domain_id" form:"domain_id" binding:"required,exist=domains.id"`
+	ChatTemplateID uint64  `json:"chat_template_id" form:"chat_template_id" binding:"required,exist=chat_templates.id"`
+	EventID        *uint64 `json:"event_id" form:"event_id" binding:"omitempty,exist=events.id"` // Event the message reminds of
+}
+
+func NewOutboundMessagePagination(conditions map[string][]string) *pagination.Pagination {
+	filterDef := pagination.NewFilterDefinition().
+		AddFilter("status", pagination.FilterConfig{
+			Field:      "status",
+			Type:       pagination.FilterTypeEnum,
+			EnumValues: []string{"pending", "sending", "sent", "failed"},
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorEquals, pagination.OperatorIn,
+			},
+		}).
+		AddFilter("follower_id", pagination.FilterConfig{
+			Field: "follower_id",
+			Type:  pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("event_id", pagination.FilterConfig{
+			Field: "event_id",
+			Type:  pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("campaign_run_id", pagination.FilterConfig{
+			Field: "campaign_run_id",
+			Type:  pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("chat_template_id", pagination.FilterConfig{
+			Field: "chat_template_id",
+			Type:  pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("created_at", pagination.FilterConfig{
+			Field:     "created_at",
+			Type:      pagination.FilterTypeDateTime,
+			Operators: []pagination.FilterOperator{pagination.OperatorBetween, pagination.OperatorEquals},
+		}).
+		AddSort("created_at", pagination.SortConfig{
+			Field:   "created_at",
+			Allowed: true,
+		})
+
+	return pagination.NewPagination(
+		conditions,
+		filterDef,
+		pagination.PaginationOptions{
+			DefaultLimit: 20,
+			MaxLimit:     100,
+			DefaultOrder: "id desc",
+		},
+	)
+}
@@ -0,0 +1,15 @@
+package response
+
+// OutboundMessageBroadcastResponse summarises the messages queued by a broadcast.
+type OutboundMessageBroadcastResponse struct {
+	Recipients int `json:"recipients"` // Followers matching the filters
+	Queued     int `json:"queued"`
+	Skipped    int `json:"skipped"` // Followers without a phone number
+}
+
+// OutboxRunResult is the outcome of one pass over the outbox.
+type OutboxRunResult struct {
+	Sent    int `json:"sent"`
+	Retried int `json:"retried"` // Failed attempts that will be retried later
+	Failed  int `json:"failed"`  // Messages given up on
+}
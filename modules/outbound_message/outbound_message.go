@@ -0,0 +1,42 @@
+package outbound_message
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/outbound_message/dto/request"
+	"github.com/PhantomX7/dhamma/modules/outbound_message/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.OutboundMessage]
+	// FindDue returns up to limit messages whose next delivery attempt is due at now, oldest first.
+	FindDue(ctx context.Context, now time.Time, limit int) ([]entity.OutboundMessage, error)
+	// Claim marks a due message as sending until leaseUntil and counts the attempt.
+	// It reports false when the message is no longer due, e.g. because another worker claimed it.
+	Claim(ctx context.Context, messageID uint64, now time.Time, leaseUntil time.Time) (bool, error)
+	// CountStatusByCampaignRun counts the messages of each campaign run by status.
+	CountStatusByCampaignRun(ctx context.Context, campaignRunIDs []uint64) (map[uint64]map[string]int, error)
+}
+
+type Service interface {
+	Index(ctx context.Context, pg *pagination.Pagination) ([]entity.OutboundMessage, utility.PaginationMeta, error)
+	Show(ctx context.Context, messageID uint64) (entity.OutboundMessage, error)
+	Broadcast(ctx context.Context, pg *pagination.Pagination, req request.OutboundMessageBroadcastRequest) (response.OutboundMessageBroadcastResponse, error)
+	QueueCampaignRun(ctx context.Context, pg *pagination.Pagination, req request.OutboundMessageBroadcastRequest, campaignRunID uint64) (response.OutboundMessageBroadcastResponse, error)
+	Retry(ctx context.Context, messageID uint64) (entity.OutboundMessage, error)
+	ProcessOutbox(ctx context.Context) (response.OutboxRunResult, error)
+}
+
+type Controller interface {
+	Index(ctx *gin.Context)
+	Show(ctx *gin.Context)
+	Broadcast(ctx *gin.Context)
+	Retry(ctx *gin.Context)
+}
@@ -0,0 +1,21 @@
+package outbound_message
+
+type permission struct {
+	Key string
+	// Index all outbound messages
+	Index string
+	// View outbound message details and delivery status
+	Show string
+	// Broadcast a chat template to filtered followers
+	Broadcast string
+	// Retry a failed outbound message
+	Retry string
+}
+
+var Permissions = permission{
+	Key:       "outbound-message",
+	Index:     "index",
+	Show:      "show",
+	Broadcast: "broadcast",
+	Retry:     "retry",
+}
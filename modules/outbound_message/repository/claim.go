@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Claim marks a due message as sending until leaseUntil and increments its attempts in a single
+// conditional update, so a message is only handed to the provider by whoever claims it first.
+func (r *repository) Claim(ctx context.Context, messageID uint64, now time.Time, leaseUntil time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.OutboundMessage{}).
+		Where("id = ?", messageID).
+		Where("status IN ?", []string{entity.OutboundMessageStatusPending, entity.OutboundMessageStatusSending}).
+		Where("next_attempt_at <= ?", now).
+		Updates(map[string]any{
+			"status":          entity.OutboundMessageStatusSending,
+			"attempts":        gorm.Expr("attempts + 1"),
+			"next_attempt_at": leaseUntil,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
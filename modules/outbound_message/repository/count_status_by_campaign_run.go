@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// CountStatusByCampaignRun counts the messages of each campaign run by status.
+// Runs without messages are absent from the map.
+func (r *repository) CountStatusByCampaignRun(ctx context.Context, campaignRunIDs []uint64) (map[uint64]map[string]int, error) {
+	counts := make(map[uint64]map[string]int)
+	if len(campaignRunIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		CampaignRunID uint64
+		Status        string
+		Total         int
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entity.OutboundMessage{}).
+		Select("campaign_run_id, status, COUNT(*) AS total").
+		Where("campaign_run_id IN ?", campaignRunIDs).
+		Group("campaign_run_id, status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if counts[row.CampaignRunID] == nil {
+			counts[row.CampaignRunID] = make(map[string]int)
+		}
+		counts[row.CampaignRunID][row.Status] = row.Total
+	}
+
+	return counts, nil
+}
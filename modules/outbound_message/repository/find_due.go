@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindDue returns up to limit pending messages, and sending messages whose lease expired,
+// whose next delivery attempt is due at now. The oldest due messages come first.
+func (r *repository) FindDue(ctx context.Context, now time.Time, limit int) ([]entity.OutboundMessage, error) {
+	messages := make([]entity.OutboundMessage, 0)
+
+	err := r.db.WithContext(ctx).
+		Where("status IN ?", []string{entity.OutboundMessageStatusPending, entity.OutboundMessageStatusSending}).
+		Where("next_attempt_at <= ?", now).
+		Order("next_attempt_at asc, id asc").
+		Limit(limit).
+		Find(&messages).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
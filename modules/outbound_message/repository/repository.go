@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/outbound_message"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.OutboundMessage] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new outbound_message repository instance.
+func New(db *gorm.DB) outbound_message.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.OutboundMessage](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all outbound_message entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.OutboundMessage, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves an outbound_message entity by its ID.
+func (r *repository) FindByID(ctx context.Context, messageID uint64, preloads ...string) (entity.OutboundMessage, error) {
+	return r.base.FindByID(ctx, messageID, preloads...)
+}
+
+// Create creates a new outbound_message entity.
+func (r *repository) Create(ctx context.Context, message *entity.OutboundMessage, tx *gorm.DB) error {
+	return r.base.Create(ctx, message, tx)
+}
+
+// Update updates an existing outbound_message entity.
+func (r *repository) Update(ctx context.Context, message *entity.OutboundMessage, tx *gorm.DB) error {
+	return r.base.Update(ctx, message, tx)
+}
+
+// Delete deletes an outbound_message entity.
+func (r *repository) Delete(ctx context.Context, message *entity.OutboundMessage, tx *gorm.DB) error {
+	return r.base.Delete(ctx, message, tx)
+}
+
+// Count counts outbound_message entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves outbound_message entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.OutboundMessage, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single outbound_message entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.OutboundMessage, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves outbound_message entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.OutboundMessage, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single outbound_message entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.OutboundMessage, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any outbound_message records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
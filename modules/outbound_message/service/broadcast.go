@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/outbound_message/dto/request"
+	"github.com/PhantomX7/dhamma/modules/outbound_message/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// maxBroadcastRecipients caps how many followers a single broadcast can reach.
+const maxBroadcastRecipients = 5000
+
+// Broadcast renders the chat template for every follower of the domain matching the follower filters
+// and queues the messages in the outbox. Followers without a phone number are skipped.
+// The template and event are loaded once and only the follower's details are filled in per recipient.
+// Nothing is queued if rendering fails for any follower.
+func (s *service) Broadcast(ctx context.Context, pg *pagination.Pagination, req request.OutboundMessageBroadcastRequest) (
+	response.OutboundMessageBroadcastResponse, error,
+) {
+	return s.broadcast(ctx, pg, req, nil)
+}
+
+// QueueCampaignRun broadcasts like Broadcast and links the queued messages to the campaign run.
+func (s *service) QueueCampaignRun(ctx context.Context, pg *pagination.Pagination, req request.OutboundMessageBroadcastRequest, campaignRunID uint64) (
+	response.OutboundMessageBroadcastResponse, error,
+) {
+	return s.broadcast(ctx, pg, req, &campaignRunID)
+}
+
+func (s *service) broadcast(ctx context.Context, pg *pagination.Pagination, req request.OutboundMessageBroadcastRequest, campaignRunID *uint64) (
+	res response.OutboundMessageBroadcastResponse, err error,
+) {
+	contextValues, err := utility.CheckDomainContext(ctx, req.DomainID, "outbound message", "broadcast")
+	if err != nil {
+		return
+	}
+
+	template, err := s.chatTemplateRepo.FindByID(ctx, req.ChatTemplateID)
+	if err != nil {
+		return
+	}
+	if template.DomainID != req.DomainID {
+		return res, &errors.AppError{
+			Message: "chat template does not belong to the domain",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	addRecipientScopes(pg, req.DomainID)
+
+	count, err := s.followerRepo.Count(ctx, pg)
+	if err != nil {
+		return
+	}
+	if count > maxBroadcastRecipients {
+		return res, &errors.AppError{
+			Message: "too many recipients for a single broadcast, narrow the filters",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	recipients := make([]entity.Follower, 0, count)
+	pg.Limit = pg.Options.MaxLimit
+	for pg.Offset = 0; ; pg.Offset += pg.Limit {
+		var followers []entity.Follower
+		followers, err = s.followerRepo.FindAll(ctx, pg)
+		if err != nil {
+			return
+		}
+
+		for _, follower := range followers {
+			res.Recipients++
+			if follower.Phone == nil || strings.TrimSpace(*follower.Phone) == "" {
+				res.Skipped++
+				continue
+			}
+			recipients = append(recipients, follower)
+		}
+
+		if len(followers) < pg.Limit {
+			break
+		}
+	}
+
+	contents, err := s.chatTemplateService.RenderForFollowers(ctx, template.ID, req.EventID, recipients)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	messages := make([]entity.OutboundMessage, 0, len(recipients))
+	for i, follower := range recipients {
+		messages = append(messages, entity.OutboundMessage{
+			DomainID:       req.DomainID,
+			FollowerID:     &follower.ID,
+			ChatTemplateID: &template.ID,
+			EventID:        req.EventID,
+			CampaignRunID:  campaignRunID,
+			Recipient:      strings.TrimSpace(*follower.Phone),
+			Body:           contents[i],
+			Status:         entity.OutboundMessageStatusPending,
+			NextAttemptAt:  &now,
+			CreatedBy:      &contextValues.UserID,
+		})
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		for i := range messages {
+			if err := s.outboundMessageRepo.Create(ctx, &messages[i], tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	res.Queued = len(messages)
+	return
+}
+
+// addRecipientScopes applies the joins the follower list filters rely on and restricts the followers to the domain.
+func addRecipientScopes(pg *pagination.Pagination, domainID uint64) {
+	pg.AddCustomScope(
+		func(db *gorm.DB) *gorm.DB {
+			return db.
+				Joins("LEFT JOIN cards Card ON Card.follower_id = followers.id").
+				Joins("Domain").
+				Group("followers.id") // Group by follower ID to avoid duplicates from joins
+		},
+		func(db *gorm.DB) *gorm.DB {
+			return db.Where("followers.domain_id = ?", domainID)
+		},
+	)
+}
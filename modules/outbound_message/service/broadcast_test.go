@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	chatTemplateRepo "github.com/PhantomX7/dhamma/modules/chat_template/repository"
+	chatTemplateService "github.com/PhantomX7/dhamma/modules/chat_template/service"
+	chatTemplateVersionRepo "github.com/PhantomX7/dhamma/modules/chat_template_version/repository"
+	"github.com/PhantomX7/dhamma/modules/event"
+	eventRepo "github.com/PhantomX7/dhamma/modules/event/repository"
+	followerRequest "github.com/PhantomX7/dhamma/modules/follower/dto/request"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	"github.com/PhantomX7/dhamma/modules/outbound_message/dto/request"
+	outboundMessageRepo "github.com/PhantomX7/dhamma/modules/outbound_message/repository"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// countingEventRepo counts how often an event is loaded.
+type countingEventRepo struct {
+	event.Repository
+	finds *atomic.Int32
+}
+
+func (r countingEventRepo) FindByID(ctx context.Context, id uint64, preloads ...string) (entity.Event, error) {
+	r.finds.Add(1)
+	return r.Repository.FindByID(ctx, id, preloads...)
+}
+
+func TestBroadcast_RendersPerFollowerAndLoadsEventOnce(t *testing.T) {
+	db := setupOutboundMessageTestDB(t)
+	require.NoError(t, db.AutoMigrate(&entity.Card{}))
+
+	domain := testdb.Domain(t, db, func(domain *entity.Domain) {
+		domain.Name, domain.Code = "Vihara", "vihara"
+	})
+
+	ani := entity.Follower{DomainID: domain.ID, Name: "Ani", Phone: utility.PointOf("+62811")}
+	budi := entity.Follower{DomainID: domain.ID, Name: "Budi", Phone: utility.PointOf(" +62812 ")}
+	citra := entity.Follower{DomainID: domain.ID, Name: "Citra"}
+	for _, follower := range []*entity.Follower{&ani, &budi, &citra} {
+		require.NoError(t, db.Create(follower).Error)
+	}
+
+	puja := entity.Event{DomainID: domain.ID, Name: "Puja"}
+	require.NoError(t, db.Create(&puja).Error)
+
+	template := entity.ChatTemplate{DomainID: domain.ID, Name: "Reminder", Content: "Hi {{follower.name}}, see you at {{event.name}} in {{domain.name}}", IsActive: true}
+	require.NoError(t, db.Create(&template).Error)
+
+	finds := &atomic.Int32{}
+	templates := chatTemplateRepo.New(db)
+	s := New(
+		outboundMessageRepo.New(db),
+		followerRepo.New(db),
+		templates,
+		chatTemplateService.New(templates, chatTemplateVersionRepo.New(db), followerRepo.New(db), countingEventRepo{Repository: eventRepo.New(db), finds: finds}, transaction_manager.New(db)),
+		&fakeMessagingClient{},
+		transaction_manager.New(db),
+	)
+	ctx := utility.NewContextWithValues(context.Background(), utility.ContextValues{DomainID: &domain.ID, UserID: 1})
+
+	res, err := s.Broadcast(ctx, followerRequest.NewFollowerPagination(map[string][]string{}), request.OutboundMessageBroadcastRequest{
+		DomainID:       domain.ID,
+		ChatTemplateID: template.ID,
+		EventID:        &puja.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, res.Recipients)
+	assert.Equal(t, 1, res.Skipped)
+	assert.Equal(t, 2, res.Queued)
+	assert.Equal(t, int32(1), finds.Load())
+
+	var messages []entity.OutboundMessage
+	require.NoError(t, db.Order("recipient asc").Find(&messages).Error)
+	require.Len(t, messages, 2)
+
+	assert.Equal(t, "+62811", messages[0].Recipient)
+	assert.Equal(t, "Hi Ani, see you at Puja in Vihara", messages[0].Body)
+	assert.Equal(t, "+62812", messages[1].Recipient)
+	assert.Equal(t, "Hi Budi, see you at Puja in Vihara", messages[1].Body)
+	assert.Equal(t, entity.OutboundMessageStatusPending, messages[1].Status)
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// Index implements outbound_message.Service.
+func (s *service) Index(ctx context.Context, pg *pagination.Pagination) (
+	messages []entity.OutboundMessage, meta utility.PaginationMeta, err error,
+) {
+	contextValues, err := utility.ValuesFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	pg.AddCustomScope(func(db *gorm.DB) *gorm.DB {
+		if contextValues.DomainID != nil {
+			return db.Where("outbound_messages.domain_id = ?", *contextValues.DomainID)
+		}
+		return db
+	})
+
+	messages, err = s.outboundMessageRepo.FindAll(ctx, pg)
+	if err != nil {
+		return
+	}
+
+	count, err := s.outboundMessageRepo.Count(ctx, pg)
+	if err != nil {
+		return
+	}
+
+	meta.Limit = pg.Limit
+	meta.Offset = pg.Offset
+	meta.Total = count
+
+	return
+}
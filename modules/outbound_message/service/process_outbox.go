@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/messaging"
+	"github.com/PhantomX7/dhamma/modules/outbound_message/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+const (
+	// outboxBatchSize is how many due messages a single pass sends.
+	outboxBatchSize = 100
+	// sendLease is how long a claimed message stays reserved. A message still sending after that,
+	// e.g. because the process died mid-send, is picked up again.
+	sendLease = 5 * time.Minute
+	// retryBaseDelay is the delay after the first failed attempt; it doubles with every further attempt.
+	retryBaseDelay = time.Minute
+	// retryMaxDelay caps the delay between two attempts.
+	retryMaxDelay = 6 * time.Hour
+	// maxErrorLength is the size of OutboundMessage.LastError.
+	maxErrorLength = 500
+)
+
+// ProcessOutbox sends the messages that are due and schedules failed ones for a retry with exponential backoff.
+// A message is given up on when the provider rejects it permanently or after MESSAGING_MAX_ATTEMPTS attempts.
+func (s *service) ProcessOutbox(ctx context.Context) (result response.OutboxRunResult, err error) {
+	messages, err := s.outboundMessageRepo.FindDue(ctx, time.Now(), outboxBatchSize)
+	if err != nil {
+		return
+	}
+
+	for _, message := range messages {
+		now := time.Now()
+		claimed, claimErr := s.outboundMessageRepo.Claim(ctx, message.ID, now, now.Add(sendLease))
+		if claimErr != nil {
+			return result, claimErr
+		}
+		if !claimed {
+			continue
+		}
+		message.Attempts++
+
+		sent, sendErr := s.messagingClient.Send(ctx, messaging.Message{To: message.Recipient, Body: message.Body})
+		finishedAt := time.Now()
+		switch {
+		case sendErr == nil:
+			message.Status = entity.OutboundMessageStatusSent
+			message.Provider = utility.PointOf(s.messagingClient.Name())
+			message.ProviderMessageID = nil
+			// A provider may accept a message without an ID we could read
+			if sent.ProviderMessageID != "" {
+				message.ProviderMessageID = &sent.ProviderMessageID
+			}
+			message.SentAt = &finishedAt
+			message.NextAttemptAt = nil
+			message.LastError = nil
+			result.Sent++
+		case errors.Is(sendErr, messaging.ErrPermanent) || message.Attempts >= config.MESSAGING_MAX_ATTEMPTS:
+			message.Status = entity.OutboundMessageStatusFailed
+			message.NextAttemptAt = nil
+			message.LastError = utility.PointOf(truncateError(sendErr))
+			result.Failed++
+		default:
+			next := finishedAt.Add(retryDelay(message.Attempts))
+			message.Status = entity.OutboundMessageStatusPending
+			message.NextAttemptAt = &next
+			message.LastError = utility.PointOf(truncateError(sendErr))
+			result.Retried++
+		}
+
+		if sendErr != nil {
+			logger.FromCtx(ctx).Warn("failed to send outbound message",
+				zap.Uint64("message_id", message.ID),
+				zap.Int("attempts", message.Attempts),
+				zap.String("status", message.Status),
+				zap.Error(sendErr),
+			)
+		}
+
+		if err = s.outboundMessageRepo.Update(ctx, &message, nil); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// retryDelay returns how long to wait before the attempt following the given number of failed attempts.
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, retryMaxDelay)
+}
+
+// truncateError returns the error message cut to fit OutboundMessage.LastError.
+func truncateError(err error) string {
+	message := err.Error()
+	if len(message) > maxErrorLength {
+		return message[:maxErrorLength]
+	}
+	return message
+}
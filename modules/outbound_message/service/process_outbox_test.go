@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/messaging"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/outbound_message"
+	outboundMessageRepo "github.com/PhantomX7/dhamma/modules/outbound_message/repository"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// setupOutboundMessageTestDB creates a database with the tables rendering and sending messages reads and writes.
+func setupOutboundMessageTestDB(t *testing.T) *gorm.DB {
+	return testdb.New(t,
+		&entity.Domain{},
+		&entity.Follower{},
+		&entity.Event{},
+		&entity.ChatTemplate{},
+		&entity.ChatTemplateVersion{},
+		&entity.OutboundMessage{},
+	)
+}
+
+// fakeMessagingClient fails the recipients listed in errs and counts every send per recipient.
+type fakeMessagingClient struct {
+	errs  map[string]error
+	mu    sync.Mutex
+	sends map[string]int
+}
+
+func (c *fakeMessagingClient) Name() string {
+	return "fake"
+}
+
+func (c *fakeMessagingClient) Send(ctx context.Context, message messaging.Message) (messaging.Result, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sends == nil {
+		c.sends = make(map[string]int)
+	}
+	c.sends[message.To]++
+
+	if err := c.errs[message.To]; err != nil {
+		return messaging.Result{}, err
+	}
+	return messaging.Result{ProviderMessageID: "id-" + message.To}, nil
+}
+
+func newOutboxTestService(db *gorm.DB, client messaging.Client) outbound_message.Service {
+	return New(outboundMessageRepo.New(db), nil, nil, nil, client, transaction_manager.New(db))
+}
+
+// queueMessage creates a pending message to recipient that has already been attempted attempts times.
+func queueMessage(t *testing.T, db *gorm.DB, recipient string, attempts int, nextAttemptAt time.Time) entity.OutboundMessage {
+	message := entity.OutboundMessage{
+		DomainID:      1,
+		Recipient:     recipient,
+		Body:          "Hello",
+		Status:        entity.OutboundMessageStatusPending,
+		Attempts:      attempts,
+		NextAttemptAt: &nextAttemptAt,
+	}
+	require.NoError(t, db.Create(&message).Error)
+	return message
+}
+
+func reloadMessage(t *testing.T, db *gorm.DB, id uint64) entity.OutboundMessage {
+	t.Helper()
+
+	var message entity.OutboundMessage
+	require.NoError(t, db.First(&message, id).Error)
+	return message
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Minute},
+		{attempts: 2, want: 2 * time.Minute},
+		{attempts: 3, want: 4 * time.Minute},
+		{attempts: 9, want: 256 * time.Minute},
+		{attempts: 10, want: retryMaxDelay},
+		{attempts: 100, want: retryMaxDelay},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, retryDelay(tt.attempts), "attempts %d", tt.attempts)
+	}
+}
+
+func TestProcessOutbox_Outcomes(t *testing.T) {
+	maxAttempts := config.MESSAGING_MAX_ATTEMPTS
+	config.MESSAGING_MAX_ATTEMPTS = 3
+	t.Cleanup(func() { config.MESSAGING_MAX_ATTEMPTS = maxAttempts })
+
+	db := setupOutboundMessageTestDB(t)
+	client := &fakeMessagingClient{errs: map[string]error{
+		"permanent": fmt.Errorf("invalid recipient: %w", messaging.ErrPermanent),
+		"retryable": errors.New("provider unavailable"),
+		"exhausted": errors.New("provider unavailable"),
+	}}
+	s := newOutboxTestService(db, client)
+
+	past := time.Now().Add(-time.Minute)
+	sent := queueMessage(t, db, "sent", 0, past)
+	permanent := queueMessage(t, db, "permanent", 0, past)
+	retryable := queueMessage(t, db, "retryable", 1, past)
+	exhausted := queueMessage(t, db, "exhausted", 2, past)
+	notDue := queueMessage(t, db, "not-due", 0, time.Now().Add(time.Hour))
+
+	before := time.Now()
+	result, err := s.ProcessOutbox(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Sent)
+	assert.Equal(t, 1, result.Retried)
+	assert.Equal(t, 2, result.Failed)
+
+	stored := reloadMessage(t, db, sent.ID)
+	assert.Equal(t, entity.OutboundMessageStatusSent, stored.Status)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.Equal(t, "fake", *stored.Provider)
+	assert.Equal(t, "id-sent", *stored.ProviderMessageID)
+	assert.NotNil(t, stored.SentAt)
+	assert.Nil(t, stored.NextAttemptAt)
+
+	stored = reloadMessage(t, db, permanent.ID)
+	assert.Equal(t, entity.OutboundMessageStatusFailed, stored.Status)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.Contains(t, *stored.LastError, "invalid recipient")
+	assert.Nil(t, stored.NextAttemptAt)
+
+	// The second failed attempt waits twice the base delay
+	stored = reloadMessage(t, db, retryable.ID)
+	assert.Equal(t, entity.OutboundMessageStatusPending, stored.Status)
+	assert.Equal(t, 2, stored.Attempts)
+	assert.Equal(t, "provider unavailable", *stored.LastError)
+	require.NotNil(t, stored.NextAttemptAt)
+	assert.WithinDuration(t, before.Add(2*retryBaseDelay), *stored.NextAttemptAt, 5*time.Second)
+
+	stored = reloadMessage(t, db, exhausted.ID)
+	assert.Equal(t, entity.OutboundMessageStatusFailed, stored.Status)
+	assert.Equal(t, 3, stored.Attempts)
+
+	stored = reloadMessage(t, db, notDue.ID)
+	assert.Equal(t, entity.OutboundMessageStatusPending, stored.Status)
+	assert.Zero(t, stored.Attempts)
+	assert.Zero(t, client.sends["not-due"])
+}
+
+func TestProcessOutbox_ExpiredLeaseIsSentAgain(t *testing.T) {
+	db := setupOutboundMessageTestDB(t)
+	client := &fakeMessagingClient{}
+	s := newOutboxTestService(db, client)
+
+	message := queueMessage(t, db, "stuck", 1, time.Now().Add(-time.Minute))
+	require.NoError(t, db.Model(&message).Update("status", entity.OutboundMessageStatusSending).Error)
+
+	result, err := s.ProcessOutbox(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Sent)
+	assert.Equal(t, entity.OutboundMessageStatusSent, reloadMessage(t, db, message.ID).Status)
+}
+
+func TestClaim_OnlyOnce(t *testing.T) {
+	db := setupOutboundMessageTestDB(t)
+	repo := outboundMessageRepo.New(db)
+	ctx := context.Background()
+
+	message := queueMessage(t, db, "once", 0, time.Now().Add(-time.Minute))
+
+	now := time.Now()
+	claimed, err := repo.Claim(ctx, message.ID, now, now.Add(sendLease))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = repo.Claim(ctx, message.ID, now, now.Add(sendLease))
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	stored := reloadMessage(t, db, message.ID)
+	assert.Equal(t, entity.OutboundMessageStatusSending, stored.Status)
+	assert.Equal(t, 1, stored.Attempts)
+
+	// Once the lease has expired the message can be claimed again
+	claimed, err = repo.Claim(ctx, message.ID, now.Add(sendLease), now.Add(2*sendLease))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+}
+
+func TestProcessOutbox_ConcurrentRunsSendOnce(t *testing.T) {
+	const (
+		messages = 20
+		runs     = 4
+	)
+
+	db := setupOutboundMessageTestDB(t)
+	client := &fakeMessagingClient{}
+	s := newOutboxTestService(db, client)
+
+	for i := range messages {
+		queueMessage(t, db, fmt.Sprintf("recipient-%d", i), 0, time.Now().Add(-time.Minute))
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, runs)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.ProcessOutbox(context.Background())
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Len(t, client.sends, messages)
+	for recipient, sends := range client.sends {
+		assert.Equal(t, 1, sends, recipient)
+	}
+
+	var sent int64
+	require.NoError(t, db.Model(&entity.OutboundMessage{}).Where("status = ?", entity.OutboundMessageStatusSent).Count(&sent).Error)
+	assert.Equal(t, int64(messages), sent)
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Retry puts a failed message back in the outbox with a fresh set of attempts.
+func (s *service) Retry(ctx context.Context, messageID uint64) (message entity.OutboundMessage, err error) {
+	message, err = s.outboundMessageRepo.FindByID(ctx, messageID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, message.DomainID, "outbound message", "retry")
+	if err != nil {
+		return
+	}
+
+	if message.Status != entity.OutboundMessageStatusFailed {
+		return message, &errors.AppError{
+			Message: "only failed messages can be retried",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	now := time.Now()
+	message.Status = entity.OutboundMessageStatusPending
+	message.Attempts = 0
+	message.NextAttemptAt = &now
+
+	err = s.outboundMessageRepo.Update(ctx, &message, nil)
+	return
+}
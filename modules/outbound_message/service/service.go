@@ -0,0 +1,36 @@
+package service
+
+import (
+	"github.com/PhantomX7/dhamma/libs/messaging"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/chat_template"
+	"github.com/PhantomX7/dhamma/modules/follower"
+	"github.com/PhantomX7/dhamma/modules/outbound_message"
+)
+
+type service struct {
+	outboundMessageRepo outbound_message.Repository
+	followerRepo        follower.Repository
+	chatTemplateRepo    chat_template.Repository
+	chatTemplateService chat_template.Service
+	messagingClient     messaging.Client
+	transactionManager  transaction_manager.Client
+}
+
+func New(
+	outboundMessageRepo outbound_message.Repository,
+	followerRepo follower.Repository,
+	chatTemplateRepo chat_template.Repository,
+	chatTemplateService chat_template.Service,
+	messagingClient messaging.Client,
+	transactionManager transaction_manager.Client,
+) outbound_message.Service {
+	return &service{
+		outboundMessageRepo: outboundMessageRepo,
+		followerRepo:        followerRepo,
+		chatTemplateRepo:    chatTemplateRepo,
+		chatTemplateService: chatTemplateService,
+		messagingClient:     messagingClient,
+		transactionManager:  transactionManager,
+	}
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Show implements outbound_message.Service
+func (s *service) Show(ctx context.Context, messageID uint64) (message entity.OutboundMessage, err error) {
+	message, err = s.outboundMessageRepo.FindByID(ctx, messageID, "Follower")
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, message.DomainID, "outbound message", "show")
+	if err != nil {
+		return
+	}
+
+	return
+}
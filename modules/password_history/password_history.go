@@ -0,0 +1,18 @@
+package password_history
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.PasswordHistory]
+	// FindRecentByUserID returns the latest previous passwords of a user, newest first.
+	FindRecentByUserID(ctx context.Context, userID uint64, limit int) ([]entity.PasswordHistory, error)
+	// PruneByUserID removes all but the latest keep previous passwords of a user.
+	PruneByUserID(ctx context.Context, userID uint64, keep int, tx *gorm.DB) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindRecentByUserID returns the latest previous passwords of a user, newest first.
+func (r *repository) FindRecentByUserID(ctx context.Context, userID uint64, limit int) (histories []entity.PasswordHistory, err error) {
+	if limit <= 0 {
+		return
+	}
+
+	err = r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("id desc").
+		Limit(limit).
+		Find(&histories).Error
+	return
+}
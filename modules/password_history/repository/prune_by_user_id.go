@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// PruneByUserID removes all but the latest keep previous passwords of a user.
+func (r *repository) PruneByUserID(ctx context.Context, userID uint64, keep int, tx *gorm.DB) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	db = db.WithContext(ctx)
+
+	var keptIDs []uint64
+	err := db.Model(&entity.PasswordHistory{}).
+		Where("user_id = ?", userID).
+		Order("id desc").
+		Limit(max(keep, 0)).
+		Pluck("id", &keptIDs).Error
+	if err != nil {
+		return err
+	}
+
+	query := db.Where("user_id = ?", userID)
+	if len(keptIDs) > 0 {
+		query = query.Where("id NOT IN ?", keptIDs)
+	}
+	return query.Delete(&entity.PasswordHistory{}).Error
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/password_history"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.PasswordHistory] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new password_history repository instance.
+func New(db *gorm.DB) password_history.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.PasswordHistory](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all password_history entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.PasswordHistory, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a password_history entity by its ID.
+func (r *repository) FindByID(ctx context.Context, passwordHistoryID uint64, preloads ...string) (entity.PasswordHistory, error) {
+	return r.base.FindByID(ctx, passwordHistoryID, preloads...)
+}
+
+// Create creates a new password_history entity.
+func (r *repository) Create(ctx context.Context, passwordHistory *entity.PasswordHistory, tx *gorm.DB) error {
+	return r.base.Create(ctx, passwordHistory, tx)
+}
+
+// Update updates an existing password_history entity.
+func (r *repository) Update(ctx context.Context, passwordHistory *entity.PasswordHistory, tx *gorm.DB) error {
+	return r.base.Update(ctx, passwordHistory, tx)
+}
+
+// Delete deletes a password_history entity.
+func (r *repository) Delete(ctx context.Context, passwordHistory *entity.PasswordHistory, tx *gorm.DB) error {
+	return r.base.Delete(ctx, passwordHistory, tx)
+}
+
+// Count counts password_history entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves password_history entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.PasswordHistory, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single password_history entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.PasswordHistory, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves password_history entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.PasswordHistory, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single password_history entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.PasswordHistory, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any password_history records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
@@ -0,0 +1,21 @@
+package password_reset_token
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.PasswordResetToken]
+	// FindLatestByUserID returns the latest token issued to a user and reports false if there is none.
+	FindLatestByUserID(ctx context.Context, userID uint64) (entity.PasswordResetToken, bool, error)
+	// Consume marks the token as used and reports false if it was already used by a concurrent request.
+	Consume(ctx context.Context, tokenID uint64, at time.Time, tx *gorm.DB) (bool, error)
+	// DeleteUnusedByUserID removes the tokens of a user that were not used, so only the latest one works.
+	DeleteUnusedByUserID(ctx context.Context, userID uint64) error
+}
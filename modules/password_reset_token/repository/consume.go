@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Consume marks the token as used and reports false if it was already used by a concurrent request.
+func (r *repository) Consume(ctx context.Context, tokenID uint64, at time.Time, tx *gorm.DB) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&entity.PasswordResetToken{}).
+		Where("id = ? AND used_at IS NULL", tokenID).
+		UpdateColumn("used_at", at)
+
+	return result.RowsAffected == 1, result.Error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// DeleteUnusedByUserID removes the tokens of a user that were not used, so only the latest one works.
+func (r *repository) DeleteUnusedByUserID(ctx context.Context, userID uint64) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Delete(&entity.PasswordResetToken{}).Error
+}
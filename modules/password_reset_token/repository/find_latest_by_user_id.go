@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindLatestByUserID returns the latest token issued to a user and reports false if there is none.
+func (r *repository) FindLatestByUserID(ctx context.Context, userID uint64) (entity.PasswordResetToken, bool, error) {
+	var token entity.PasswordResetToken
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("id desc").
+		Take(&token).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return token, false, nil
+	}
+	if err != nil {
+		return token, false, err
+	}
+
+	return token, true, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/password_reset_token"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.PasswordResetToken] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new password_reset_token repository instance.
+func New(db *gorm.DB) password_reset_token.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.PasswordResetToken](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all password_reset_token entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.PasswordResetToken, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a password_reset_token entity by its ID.
+func (r *repository) FindByID(ctx context.Context, passwordResetTokenID uint64, preloads ...string) (entity.PasswordResetToken, error) {
+	return r.base.FindByID(ctx, passwordResetTokenID, preloads...)
+}
+
+// Create creates a new password_reset_token entity.
+func (r *repository) Create(ctx context.Context, passwordResetToken *entity.PasswordResetToken, tx *gorm.DB) error {
+	return r.base.Create(ctx, passwordResetToken, tx)
+}
+
+// Update updates an existing password_reset_token entity.
+func (r *repository) Update(ctx context.Context, passwordResetToken *entity.PasswordResetToken, tx *gorm.DB) error {
+	return r.base.Update(ctx, passwordResetToken, tx)
+}
+
+// Delete deletes a password_reset_token entity.
+func (r *repository) Delete(ctx context.Context, passwordResetToken *entity.PasswordResetToken, tx *gorm.DB) error {
+	return r.base.Delete(ctx, passwordResetToken, tx)
+}
+
+// Count counts password_reset_token entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves password_reset_token entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.PasswordResetToken, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single password_reset_token entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.PasswordResetToken, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves password_reset_token entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.PasswordResetToken, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single password_reset_token entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.PasswordResetToken, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any password_reset_token records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
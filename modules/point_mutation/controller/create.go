@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+func (c *controller) Create(ctx *gin.Context) {
+	var req request.PointMutationCreateRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.pointMutationService.Create(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
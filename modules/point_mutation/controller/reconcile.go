@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Reconcile handles the HTTP POST request checking, and optionally repairing, the points ledger.
+func (c *controller) Reconcile(ctx *gin.Context) {
+	var req request.PointReconcileRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.pointMutationService.Reconcile(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -2,6 +2,28 @@ package request
 
 import "github.com/PhantomX7/dhamma/utility/pagination"
 
+// PointMutationCreateRequest defines the payload for manually granting or deducting points.
+// A positive amount grants points, a negative amount deducts them.
+type PointMutationCreateRequest struct {
+	FollowerID  uint64  `json:"follower_id" form:"follower_id" binding:"required,exist=followers.id"`
+	Amount      int     `json:"amount" form:"amount" binding:"required,ne=0"`
+	Description *string `json:"description" form:"description" binding:"omitempty,max=255"`
+}
+
+// PointMutationRedeemRequest defines the payload for a follower redeeming points.
+type PointMutationRedeemRequest struct {
+	FollowerID  uint64  `json:"follower_id" form:"follower_id" binding:"required,exist=followers.id"`
+	Amount      int     `json:"amount" form:"amount" binding:"required,gt=0"`
+	Description *string `json:"description" form:"description" binding:"omitempty,max=255"`
+}
+
+// PointReconcileRequest defines the scope of a points ledger reconciliation.
+// Without DomainID every domain is checked; with Repair a corrective mutation is written for each drift.
+type PointReconcileRequest struct {
+	DomainID *uint64 `json:"domain_id" form:"domain_id" binding:"omitempty,exist=domains.id"`
+	Repair   bool    `json:"repair" form:"repair"`
+}
+
 func NewPointMutationPagination(conditions map[string][]string) *pagination.Pagination {
 	filterDef := pagination.NewFilterDefinition().
 		AddFilter("follower_id", pagination.FilterConfig{
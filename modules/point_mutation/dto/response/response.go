@@ -0,0 +1,31 @@
+package response
+
+import "time"
+
+// FollowerPointDrift is a follower whose points differ from the sum of its point mutations.
+type FollowerPointDrift struct {
+	FollowerID   uint64 `json:"follower_id"`
+	DomainID     uint64 `json:"domain_id"`
+	Name         string `json:"name"`
+	Points       int    `json:"points"`        // Follower.Points
+	LedgerPoints int    `json:"ledger_points"` // Sum of PointMutation.Amount
+	Drift        int    `json:"drift"`         // Points - LedgerPoints
+	// RepairMutationID is the corrective mutation written for this follower, if repaired
+	RepairMutationID *uint64 `json:"repair_mutation_id,omitempty"`
+}
+
+// DomainPointDrift aggregates the drift of a single domain.
+type DomainPointDrift struct {
+	DomainID   uint64 `json:"domain_id"`
+	Followers  int    `json:"followers"`
+	TotalDrift int    `json:"total_drift"`
+}
+
+// PointReconcileReport is the outcome of a points ledger reconciliation.
+type PointReconcileReport struct {
+	CheckedAt time.Time            `json:"checked_at"`
+	Repair    bool                 `json:"repair"`
+	Repaired  int                  `json:"repaired"`
+	Domains   []DomainPointDrift   `json:"domains"`
+	Followers []FollowerPointDrift `json:"followers"`
+}
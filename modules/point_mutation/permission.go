@@ -6,10 +6,16 @@ type permission struct {
 	Index string
 	// View event attendance details
 	Show string
+	// Manually grant or deduct follower points
+	Create string
+	// Redeem follower points
+	Redeem string
 }
 
 var Permissions = permission{
-	Key:   "point-mutation",
-	Index: "index",
-	Show:  "show",
+	Key:    "point-mutation",
+	Index:  "index",
+	Show:   "show",
+	Create: "create",
+	Redeem: "redeem",
 }
@@ -4,23 +4,40 @@ import (
 	"context"
 
 	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/request"
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/response"
 	"github.com/PhantomX7/dhamma/utility"
 	"github.com/PhantomX7/dhamma/utility/pagination"
 	"github.com/PhantomX7/dhamma/utility/repository"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 type Repository interface {
 	repository.BaseRepositoryInterface[entity.PointMutation]
+	// ReassignFollower moves every point mutation of fromFollowerID to toFollowerID and returns how many were moved.
+	ReassignFollower(ctx context.Context, fromFollowerID uint64, toFollowerID uint64, tx *gorm.DB) (int64, error)
+	// SumByFollowerID returns the total amount of the follower's point mutations.
+	SumByFollowerID(ctx context.Context, followerID uint64, tx *gorm.DB) (int, error)
+	// SumBySource returns the total amount of the point mutations recorded for a source.
+	SumBySource(ctx context.Context, sourceType string, sourceID uint64, tx *gorm.DB) (int, error)
+	// FindDrifts returns the followers whose points differ from the sum of their point mutations.
+	FindDrifts(ctx context.Context, domainID *uint64) ([]response.FollowerPointDrift, error)
 }
 
 type Service interface {
 	Index(ctx context.Context, pg *pagination.Pagination) ([]entity.PointMutation, utility.PaginationMeta, error)
 	Show(ctx context.Context, pointMutationID uint64) (entity.PointMutation, error)
+	Create(ctx context.Context, req request.PointMutationCreateRequest) (entity.PointMutation, error)
+	Redeem(ctx context.Context, req request.PointMutationRedeemRequest) (entity.PointMutation, error)
+	Reconcile(ctx context.Context, req request.PointReconcileRequest) (response.PointReconcileReport, error)
 }
 
 type Controller interface {
 	Index(ctx *gin.Context)
 	Show(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Redeem(ctx *gin.Context)
+	Reconcile(ctx *gin.Context)
 }
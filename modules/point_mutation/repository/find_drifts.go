@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/response"
+)
+
+// FindDrifts returns every follower whose points differ from the sum of its point mutations,
+// optionally restricted to a single domain. Soft-deleted followers and mutations are ignored.
+func (r *repository) FindDrifts(ctx context.Context, domainID *uint64) ([]response.FollowerPointDrift, error) {
+	drifts := make([]response.FollowerPointDrift, 0)
+
+	query := r.db.WithContext(ctx).
+		Model(&entity.Follower{}).
+		Select(
+			"followers.id AS follower_id, followers.domain_id, followers.name, followers.points, " +
+				"COALESCE(SUM(point_mutations.amount), 0) AS ledger_points, " +
+				"followers.points - COALESCE(SUM(point_mutations.amount), 0) AS drift",
+		).
+		Joins("LEFT JOIN point_mutations ON point_mutations.follower_id = followers.id AND point_mutations.deleted_at IS NULL").
+		Group("followers.id, followers.domain_id, followers.name, followers.points").
+		Having("followers.points <> COALESCE(SUM(point_mutations.amount), 0)").
+		Order("followers.domain_id, followers.id")
+
+	if domainID != nil {
+		query = query.Where("followers.domain_id = ?", *domainID)
+	}
+
+	if err := query.Scan(&drifts).Error; err != nil {
+		return nil, err
+	}
+
+	return drifts, nil
+}
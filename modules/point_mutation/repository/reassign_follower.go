@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// ReassignFollower moves every point mutations of fromFollowerID, including soft-deleted ones, to toFollowerID.
+// It returns the number of rows moved.
+func (r *repository) ReassignFollower(ctx context.Context, fromFollowerID uint64, toFollowerID uint64, tx *gorm.DB) (int64, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Unscoped().
+		Model(&entity.PointMutation{}).
+		Where("follower_id = ?", fromFollowerID).
+		Update("follower_id", toFollowerID)
+
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// SumByFollowerID returns the total amount of the follower's point mutations, i.e. the balance the ledger implies.
+func (r *repository) SumByFollowerID(ctx context.Context, followerID uint64, tx *gorm.DB) (int, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	var sum int64
+	err := db.WithContext(ctx).
+		Model(&entity.PointMutation{}).
+		Where("follower_id = ?", followerID).
+		Select("COALESCE(SUM(amount), 0)").
+		Scan(&sum).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return int(sum), nil
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// SumBySource returns the total amount of the point mutations recorded for a source,
+// e.g. the points awarded for an event attendance net of any reversal.
+func (r *repository) SumBySource(ctx context.Context, sourceType string, sourceID uint64, tx *gorm.DB) (int, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	var sum int64
+	err := db.WithContext(ctx).
+		Model(&entity.PointMutation{}).
+		Where("source_type = ? AND source_id = ?", sourceType, sourceID).
+		Select("COALESCE(SUM(amount), 0)").
+		Scan(&sum).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return int(sum), nil
+}
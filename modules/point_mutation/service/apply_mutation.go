@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// applyMutation adds the mutation amount to the follower's balance and records the
+// mutation in a single transaction. Mutations that would take the balance below zero are refused.
+// The balance is changed with an atomic increment, so the check also holds under concurrent mutations.
+func (s *service) applyMutation(ctx context.Context, pointMutation *entity.PointMutation, actionVerb string) (err error) {
+	follower, err := s.followerRepo.FindByID(ctx, pointMutation.FollowerID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, follower.DomainID, "point mutation", actionVerb)
+	if err != nil {
+		return
+	}
+
+	insufficientPoints := &errors.AppError{
+		Message: "insufficient points",
+		Status:  http.StatusBadRequest,
+	}
+	if follower.Points+pointMutation.Amount < 0 {
+		return insufficientPoints
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		applied, err := s.followerRepo.IncrementPoints(ctx, follower.ID, pointMutation.Amount, tx)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			// The balance changed since it was read and no longer covers the mutation
+			return insufficientPoints
+		}
+
+		return s.pointMutationRepo.Create(ctx, pointMutation, tx)
+	})
+	if err == insufficientPoints {
+		return
+	}
+	if err != nil {
+		return &errors.AppError{
+			Message: "failed to " + actionVerb + " points",
+			Status:  http.StatusInternalServerError,
+			Err:     err,
+		}
+	}
+
+	return
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	"github.com/PhantomX7/dhamma/modules/point_mutation"
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/request"
+	pointMutationRepo "github.com/PhantomX7/dhamma/modules/point_mutation/repository"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// setupPointMutationTestDB creates a database with the tables a point mutation writes to.
+func setupPointMutationTestDB(t *testing.T) *gorm.DB {
+	return testdb.New(t, &entity.Domain{}, &entity.Follower{}, &entity.PointMutation{})
+}
+
+// newPointMutationTestService creates a follower with the given points and a service scoped to its domain.
+func newPointMutationTestService(t *testing.T, db *gorm.DB, points int) (point_mutation.Service, context.Context, entity.Follower) {
+	domain := testdb.Domain(t, db)
+	follower := testdb.Follower(t, db, domain.ID, testdb.WithPoints(points))
+
+	s := New(pointMutationRepo.New(db), followerRepo.New(db), transaction_manager.New(db))
+	return s, testdb.Context(domain), follower
+}
+
+func assertPoints(t *testing.T, db *gorm.DB, followerID uint64, points int, mutations int64) {
+	t.Helper()
+
+	var follower entity.Follower
+	require.NoError(t, db.First(&follower, followerID).Error)
+	assert.Equal(t, points, follower.Points)
+
+	var count int64
+	require.NoError(t, db.Model(&entity.PointMutation{}).Where("follower_id = ?", followerID).Count(&count).Error)
+	assert.Equal(t, mutations, count)
+}
+
+func assertInsufficientPoints(t *testing.T, err error) {
+	t.Helper()
+
+	var appErr *errors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusBadRequest, appErr.Status)
+	assert.Equal(t, "insufficient points", appErr.Message)
+}
+
+func TestCreate_NegativeAdjustmentDeductsPoints(t *testing.T) {
+	db := setupPointMutationTestDB(t)
+	s, ctx, follower := newPointMutationTestService(t, db, 10)
+
+	pointMutation, err := s.Create(ctx, request.PointMutationCreateRequest{FollowerID: follower.ID, Amount: -4})
+	require.NoError(t, err)
+	assert.Equal(t, -4, pointMutation.Amount)
+	assert.Equal(t, entity.PointMutationSourceTypeManual, pointMutation.SourceType)
+
+	assertPoints(t, db, follower.ID, 6, 1)
+}
+
+func TestCreate_NegativeAdjustmentDownToZero(t *testing.T) {
+	db := setupPointMutationTestDB(t)
+	s, ctx, follower := newPointMutationTestService(t, db, 10)
+
+	_, err := s.Create(ctx, request.PointMutationCreateRequest{FollowerID: follower.ID, Amount: -10})
+	require.NoError(t, err)
+
+	assertPoints(t, db, follower.ID, 0, 1)
+}
+
+func TestCreate_NegativeAdjustmentBelowZeroIsRefused(t *testing.T) {
+	db := setupPointMutationTestDB(t)
+	s, ctx, follower := newPointMutationTestService(t, db, 10)
+
+	_, err := s.Create(ctx, request.PointMutationCreateRequest{FollowerID: follower.ID, Amount: -11})
+	assertInsufficientPoints(t, err)
+
+	assertPoints(t, db, follower.ID, 10, 0)
+}
+
+func TestRedeem_DeductsPoints(t *testing.T) {
+	db := setupPointMutationTestDB(t)
+	s, ctx, follower := newPointMutationTestService(t, db, 10)
+
+	pointMutation, err := s.Redeem(ctx, request.PointMutationRedeemRequest{FollowerID: follower.ID, Amount: 7})
+	require.NoError(t, err)
+	assert.Equal(t, -7, pointMutation.Amount)
+	assert.Equal(t, entity.PointMutationSourceTypeRedemption, pointMutation.SourceType)
+
+	assertPoints(t, db, follower.ID, 3, 1)
+}
+
+func TestRedeem_InsufficientPoints(t *testing.T) {
+	db := setupPointMutationTestDB(t)
+	s, ctx, follower := newPointMutationTestService(t, db, 5)
+
+	_, err := s.Redeem(ctx, request.PointMutationRedeemRequest{FollowerID: follower.ID, Amount: 6})
+	assertInsufficientPoints(t, err)
+
+	assertPoints(t, db, follower.ID, 5, 0)
+}
+
+func TestRedeem_OtherDomainIsRefused(t *testing.T) {
+	db := setupPointMutationTestDB(t)
+	s, _, follower := newPointMutationTestService(t, db, 10)
+
+	otherDomainID := follower.DomainID + 1
+	ctx := utility.NewContextWithValues(context.Background(), utility.ContextValues{DomainID: &otherDomainID, UserID: 1})
+
+	_, err := s.Redeem(ctx, request.PointMutationRedeemRequest{FollowerID: follower.ID, Amount: 1})
+	require.Error(t, err)
+
+	assertPoints(t, db, follower.ID, 10, 0)
+}
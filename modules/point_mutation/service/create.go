@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Create manually grants or deducts points for a follower.
+func (s *service) Create(ctx context.Context, req request.PointMutationCreateRequest) (pointMutation entity.PointMutation, err error) {
+	pointMutation = entity.PointMutation{
+		FollowerID:  req.FollowerID,
+		Amount:      req.Amount,
+		SourceType:  entity.PointMutationSourceTypeManual,
+		Description: req.Description,
+	}
+	if pointMutation.Description == nil {
+		pointMutation.Description = utility.PointOf("Manual point adjustment")
+	}
+
+	err = s.applyMutation(ctx, &pointMutation, "adjust")
+	if err != nil {
+		return
+	}
+
+	return
+}
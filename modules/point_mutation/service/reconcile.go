@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/request"
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/response"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/logger"
+	"go.uber.org/zap"
+)
+
+// Reconcile compares every follower's points with the sum of its point mutations and reports the drift
+// per follower and per domain. With req.Repair a reconciliation mutation of the drifted amount is written
+// for each follower, so the ledger matches the points the follower currently sees.
+func (s *service) Reconcile(ctx context.Context, req request.PointReconcileRequest) (report response.PointReconcileReport, err error) {
+	report.CheckedAt = time.Now()
+	report.Repair = req.Repair
+
+	report.Followers, err = s.pointMutationRepo.FindDrifts(ctx, req.DomainID)
+	if err != nil {
+		return report, &errors.AppError{
+			Message: "failed to reconcile points",
+			Status:  http.StatusInternalServerError,
+			Err:     err,
+		}
+	}
+
+	report.Domains = make([]response.DomainPointDrift, 0)
+	domainIndex := make(map[uint64]int)
+	for i := range report.Followers {
+		drift := &report.Followers[i]
+
+		if req.Repair {
+			if err = s.repairDrift(ctx, drift); err != nil {
+				logger.FromCtx(ctx).Error("failed to repair point drift",
+					zap.Uint64("follower_id", drift.FollowerID),
+					zap.Error(err),
+				)
+			} else if drift.RepairMutationID != nil {
+				report.Repaired++
+			}
+		}
+
+		index, ok := domainIndex[drift.DomainID]
+		if !ok {
+			index = len(report.Domains)
+			domainIndex[drift.DomainID] = index
+			report.Domains = append(report.Domains, response.DomainPointDrift{DomainID: drift.DomainID})
+		}
+		report.Domains[index].Followers++
+		report.Domains[index].TotalDrift += drift.Drift
+	}
+
+	return report, nil
+}
+
+// repairDrift writes a reconciliation mutation for the drift of a single follower.
+// The follower row is locked and both its points and the ledger are read again inside the transaction,
+// so a mutation recorded since the report was built is neither corrected twice nor lost.
+func (s *service) repairDrift(ctx context.Context, drift *response.FollowerPointDrift) error {
+	return s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		follower, err := s.followerRepo.FindByIDForUpdate(ctx, drift.FollowerID, tx)
+		if err != nil {
+			return err
+		}
+
+		ledgerPoints, err := s.pointMutationRepo.SumByFollowerID(ctx, drift.FollowerID, tx)
+		if err != nil {
+			return err
+		}
+
+		amount := follower.Points - ledgerPoints
+		if amount == 0 {
+			return nil
+		}
+
+		description := fmt.Sprintf("Ledger reconciliation: points %d, ledger %d", follower.Points, ledgerPoints)
+		pointMutation := entity.PointMutation{
+			FollowerID:  drift.FollowerID,
+			Amount:      amount,
+			SourceType:  entity.PointMutationSourceTypeReconciliation,
+			Description: &description,
+		}
+		if err = s.pointMutationRepo.Create(ctx, &pointMutation, tx); err != nil {
+			return err
+		}
+
+		drift.RepairMutationID = &pointMutation.ID
+		return nil
+	})
+}
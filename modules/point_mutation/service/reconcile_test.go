@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	"github.com/PhantomX7/dhamma/modules/point_mutation"
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/request"
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/response"
+	pointMutationRepo "github.com/PhantomX7/dhamma/modules/point_mutation/repository"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// afterReportRepo runs afterReport once the drift report has been read, standing in for
+// a point mutation recorded between the report and the repair.
+type afterReportRepo struct {
+	point_mutation.Repository
+	afterReport func()
+}
+
+func (r afterReportRepo) FindDrifts(ctx context.Context, domainID *uint64) ([]response.FollowerPointDrift, error) {
+	drifts, err := r.Repository.FindDrifts(ctx, domainID)
+	r.afterReport()
+	return drifts, err
+}
+
+// newDriftedFollower creates a follower whose points are drift above the sum of its mutations.
+func newDriftedFollower(t *testing.T, db *gorm.DB, domainID uint64, ledger []int, drift int) entity.Follower {
+	points := drift
+	for _, amount := range ledger {
+		points += amount
+	}
+
+	follower := testdb.Follower(t, db, domainID, testdb.WithPoints(points))
+
+	for _, amount := range ledger {
+		mutation := entity.PointMutation{FollowerID: follower.ID, Amount: amount, SourceType: entity.PointMutationSourceTypeManual}
+		require.NoError(t, db.Create(&mutation).Error)
+	}
+
+	return follower
+}
+
+func newReconcileTestService(t *testing.T, db *gorm.DB, repo point_mutation.Repository) (point_mutation.Service, context.Context, entity.Domain) {
+	domain := testdb.Domain(t, db)
+
+	s := New(repo, followerRepo.New(db), transaction_manager.New(db))
+	ctx := utility.NewContextWithValues(context.Background(), utility.ContextValues{UserID: 1})
+
+	return s, ctx, domain
+}
+
+func ledgerSum(t *testing.T, db *gorm.DB, followerID uint64) int {
+	t.Helper()
+
+	var sum int
+	require.NoError(t, db.Model(&entity.PointMutation{}).
+		Where("follower_id = ?", followerID).
+		Select("COALESCE(SUM(amount), 0)").
+		Scan(&sum).Error)
+	return sum
+}
+
+func TestReconcile_ReportsDriftWithoutRepair(t *testing.T) {
+	db := setupPointMutationTestDB(t)
+	s, ctx, domain := newReconcileTestService(t, db, pointMutationRepo.New(db))
+
+	drifted := newDriftedFollower(t, db, domain.ID, []int{5, 3}, 4)
+	newDriftedFollower(t, db, domain.ID, []int{7}, 0)
+	negative := newDriftedFollower(t, db, domain.ID, []int{10}, -2)
+
+	report, err := s.Reconcile(ctx, request.PointReconcileRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, report.Followers, 2)
+	assert.Equal(t, drifted.ID, report.Followers[0].FollowerID)
+	assert.Equal(t, 12, report.Followers[0].Points)
+	assert.Equal(t, 8, report.Followers[0].LedgerPoints)
+	assert.Equal(t, 4, report.Followers[0].Drift)
+	assert.Nil(t, report.Followers[0].RepairMutationID)
+	assert.Equal(t, negative.ID, report.Followers[1].FollowerID)
+	assert.Equal(t, -2, report.Followers[1].Drift)
+
+	require.Len(t, report.Domains, 1)
+	assert.Equal(t, 2, report.Domains[0].Followers)
+	assert.Equal(t, 2, report.Domains[0].TotalDrift)
+	assert.Zero(t, report.Repaired)
+
+	assert.Equal(t, 8, ledgerSum(t, db, drifted.ID))
+}
+
+func TestReconcile_RepairWritesCorrectiveMutations(t *testing.T) {
+	db := setupPointMutationTestDB(t)
+	s, ctx, domain := newReconcileTestService(t, db, pointMutationRepo.New(db))
+
+	drifted := newDriftedFollower(t, db, domain.ID, []int{5, 3}, 4)
+	negative := newDriftedFollower(t, db, domain.ID, []int{10}, -2)
+
+	report, err := s.Reconcile(ctx, request.PointReconcileRequest{Repair: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Repaired)
+	require.NotNil(t, report.Followers[0].RepairMutationID)
+
+	var repair entity.PointMutation
+	require.NoError(t, db.First(&repair, *report.Followers[0].RepairMutationID).Error)
+	assert.Equal(t, 4, repair.Amount)
+	assert.Equal(t, entity.PointMutationSourceTypeReconciliation, repair.SourceType)
+
+	assert.Equal(t, 12, ledgerSum(t, db, drifted.ID))
+	assert.Equal(t, 8, ledgerSum(t, db, negative.ID))
+
+	report, err = s.Reconcile(ctx, request.PointReconcileRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, report.Followers)
+}
+
+func TestReconcile_RepairUsesPointsChangedSinceReport(t *testing.T) {
+	db := setupPointMutationTestDB(t)
+
+	var follower entity.Follower
+	repo := afterReportRepo{
+		Repository: pointMutationRepo.New(db),
+		afterReport: func() {
+			// A check-in awards 5 points between the report and the repair
+			require.NoError(t, db.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Model(&entity.Follower{}).Where("id = ?", follower.ID).
+					UpdateColumn("points", gorm.Expr("points + ?", 5)).Error; err != nil {
+					return err
+				}
+				mutation := entity.PointMutation{FollowerID: follower.ID, Amount: 5, SourceType: entity.PointMutationSourceTypeEventAttendance}
+				return tx.Create(&mutation).Error
+			}))
+		},
+	}
+	s, ctx, domain := newReconcileTestService(t, db, repo)
+	follower = newDriftedFollower(t, db, domain.ID, []int{5, 3}, 4)
+
+	report, err := s.Reconcile(ctx, request.PointReconcileRequest{Repair: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Repaired)
+
+	var reloaded entity.Follower
+	require.NoError(t, db.First(&reloaded, follower.ID).Error)
+	assert.Equal(t, 17, reloaded.Points)
+	assert.Equal(t, 17, ledgerSum(t, db, follower.ID))
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/point_mutation/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Redeem deducts points spent by a follower. It fails if the follower does not have enough points.
+func (s *service) Redeem(ctx context.Context, req request.PointMutationRedeemRequest) (pointMutation entity.PointMutation, err error) {
+	pointMutation = entity.PointMutation{
+		FollowerID:  req.FollowerID,
+		Amount:      -req.Amount,
+		SourceType:  entity.PointMutationSourceTypeRedemption,
+		Description: req.Description,
+	}
+	if pointMutation.Description == nil {
+		pointMutation.Description = utility.PointOf("Points redeemed")
+	}
+
+	err = s.applyMutation(ctx, &pointMutation, "redeem")
+	if err != nil {
+		return
+	}
+
+	return
+}
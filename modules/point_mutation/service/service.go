@@ -1,17 +1,25 @@
 package service
 
 import (
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/follower"
 	"github.com/PhantomX7/dhamma/modules/point_mutation"
 )
 
 type service struct {
-	pointMutationRepo point_mutation.Repository
+	pointMutationRepo  point_mutation.Repository
+	followerRepo       follower.Repository
+	transactionManager transaction_manager.Client
 }
 
 func New(
 	pointMutationRepo point_mutation.Repository,
+	followerRepo follower.Repository,
+	transactionManager transaction_manager.Client,
 ) point_mutation.Service {
 	return &service{
-		pointMutationRepo: pointMutationRepo,
+		pointMutationRepo:  pointMutationRepo,
+		followerRepo:       followerRepo,
+		transactionManager: transactionManager,
 	}
 }
@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"github.com/PhantomX7/dhamma/modules/portal"
+)
+
+type controller struct {
+	portalService portal.Service
+}
+
+func New(portalService portal.Service) portal.Controller {
+	return &controller{
+		portalService: portalService,
+	}
+}
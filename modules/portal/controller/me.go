@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Me handles the HTTP GET request returning the signed-in follower's points and cards.
+// Expected route: GET /:domain_code/portal/me
+func (c *controller) Me(ctx *gin.Context) {
+	res, err := c.portalService.Me(ctx.Request.Context())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/portal/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// PointMutations handles the HTTP GET request listing the signed-in follower's point history.
+// Expected route: GET /:domain_code/portal/point-mutations
+func (c *controller) PointMutations(ctx *gin.Context) {
+	res, meta, err := c.portalService.PointMutations(ctx.Request.Context(), request.NewPortalPointMutationPagination(ctx.Request.URL.Query()))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildPaginationResponseSuccess("ok", res, meta))
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/portal/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// RequestOTP handles the HTTP POST request sending a sign-in code to a follower's phone number.
+// Expected route: POST /:domain_code/portal/auth/otp
+func (c *controller) RequestOTP(ctx *gin.Context) {
+	var req request.PortalRequestOTPRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	if err := c.portalService.RequestOTP(ctx.Request.Context(), ctx.Param("domain_code"), req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("if the phone number is registered, a sign-in code has been sent", nil))
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/portal/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// UpcomingEvents handles the HTTP GET request listing the upcoming events of the signed-in follower's domain.
+// Expected route: GET /:domain_code/portal/events?days=30
+func (c *controller) UpcomingEvents(ctx *gin.Context) {
+	var req request.PortalUpcomingEventsRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.portalService.UpcomingEvents(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/modules/portal/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// VerifyOTP handles the HTTP POST request signing a follower in with a sign-in code.
+// Expected route: POST /:domain_code/portal/auth/verify
+func (c *controller) VerifyOTP(ctx *gin.Context) {
+	var req request.PortalVerifyOTPRequest
+
+	// validate request
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.portalService.VerifyOTP(ctx.Request.Context(), ctx.Param("domain_code"), req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, utility.BuildResponseSuccess("ok", res))
+}
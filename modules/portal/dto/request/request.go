@@ -0,0 +1,45 @@
+package request
+
+import "github.com/PhantomX7/dhamma/utility/pagination"
+
+// PortalRequestOTPRequest defines the payload for requesting a sign-in code.
+type PortalRequestOTPRequest struct {
+	Phone string `json:"phone" form:"phone" binding:"required,max=50"`
+}
+
+// PortalVerifyOTPRequest defines the payload for signing in with a code.
+// FollowerID picks the follower to sign in as when several followers share the phone number.
+type PortalVerifyOTPRequest struct {
+	Phone      string  `json:"phone" form:"phone" binding:"required,max=50"`
+	Code       string  `json:"code" form:"code" binding:"required,len=6,numeric"`
+	FollowerID *uint64 `json:"follower_id" form:"follower_id" binding:"omitempty"`
+}
+
+// PortalUpcomingEventsRequest defines how far ahead upcoming events are listed, 30 days by default.
+type PortalUpcomingEventsRequest struct {
+	Days int `json:"days" form:"days" binding:"omitempty,min=1,max=90"`
+}
+
+// NewPortalPointMutationPagination paginates the signed-in follower's point history.
+func NewPortalPointMutationPagination(conditions map[string][]string) *pagination.Pagination {
+	filterDef := pagination.NewFilterDefinition().
+		AddFilter("created_at", pagination.FilterConfig{
+			Field:     "created_at",
+			Type:      pagination.FilterTypeDateTime,
+			Operators: []pagination.FilterOperator{pagination.OperatorBetween, pagination.OperatorEquals},
+		}).
+		AddSort("created_at", pagination.SortConfig{
+			Field:   "created_at",
+			Allowed: true,
+		})
+
+	return pagination.NewPagination(
+		conditions,
+		filterDef,
+		pagination.PaginationOptions{
+			DefaultLimit: 20,
+			MaxLimit:     100,
+			DefaultOrder: "id desc",
+		},
+	)
+}
@@ -0,0 +1,46 @@
+package response
+
+import "time"
+
+// PortalAuthResponse is the result of signing in with a code.
+// When several followers share the phone number and none was picked, AccessToken is empty and
+// Followers lists the choices; the same code can then be sent again with a follower ID.
+type PortalAuthResponse struct {
+	AccessToken string                 `json:"access_token,omitempty"`
+	ExpiresAt   *time.Time             `json:"expires_at,omitempty"`
+	Followers   []PortalFollowerChoice `json:"followers,omitempty"`
+}
+
+// PortalFollowerChoice is a follower that can be signed in as with the verified phone number.
+type PortalFollowerChoice struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+}
+
+// PortalMeResponse is the signed-in follower's profile.
+type PortalMeResponse struct {
+	ID         uint64       `json:"id"`
+	Name       string       `json:"name"`
+	Points     int          `json:"points"`
+	DomainName string       `json:"domain_name"`
+	Cards      []PortalCard `json:"cards"`
+}
+
+// PortalCard is one of the follower's cards with its status at the time of the request.
+type PortalCard struct {
+	Code      string     `json:"code"`
+	Status    string     `json:"status"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// PortalEvent is an upcoming occurrence of an event in the follower's domain.
+type PortalEvent struct {
+	EventID        uint64    `json:"event_id"`
+	Name           string    `json:"name"`
+	Description    *string   `json:"description"`
+	PointsAwarded  int       `json:"points_awarded"`
+	StartAt        time.Time `json:"start_at"`
+	EndAt          time.Time `json:"end_at"`
+	CheckInOpenAt  time.Time `json:"check_in_open_at"`
+	CheckInCloseAt time.Time `json:"check_in_close_at"`
+}
@@ -0,0 +1,31 @@
+package portal
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/portal/dto/request"
+	"github.com/PhantomX7/dhamma/modules/portal/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// Service is the self-service portal of followers. Followers sign in with a one-time code
+// sent to their phone number and can only read their own data.
+type Service interface {
+	RequestOTP(ctx context.Context, domainCode string, req request.PortalRequestOTPRequest) error
+	VerifyOTP(ctx context.Context, domainCode string, req request.PortalVerifyOTPRequest) (response.PortalAuthResponse, error)
+	Me(ctx context.Context) (response.PortalMeResponse, error)
+	PointMutations(ctx context.Context, pg *pagination.Pagination) ([]entity.PointMutation, utility.PaginationMeta, error)
+	UpcomingEvents(ctx context.Context, req request.PortalUpcomingEventsRequest) ([]response.PortalEvent, error)
+}
+
+type Controller interface {
+	RequestOTP(ctx *gin.Context)
+	VerifyOTP(ctx *gin.Context)
+	Me(ctx *gin.Context)
+	PointMutations(ctx *gin.Context)
+	UpcomingEvents(ctx *gin.Context)
+}
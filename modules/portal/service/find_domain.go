@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// findDomain returns the active domain with the given code.
+func (s *service) findDomain(ctx context.Context, domainCode string) (domain entity.Domain, err error) {
+	domain, err = s.domainRepo.FindOneByField(ctx, "code", domainCode)
+	if err != nil || !domain.IsActive {
+		return domain, &errors.AppError{
+			Message: "domain not found",
+			Status:  http.StatusNotFound,
+		}
+	}
+	return
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/PhantomX7/dhamma/config"
+)
+
+// hashCode returns the HMAC of a sign-in code, bound to the domain and phone number it was sent to.
+func hashCode(domainID uint64, phoneKey string, code string) string {
+	mac := hmac.New(sha256.New, []byte(config.FOLLOWER_JWT_SECRET))
+	mac.Write([]byte(strconv.FormatUint(domainID, 10) + ":" + phoneKey + ":" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
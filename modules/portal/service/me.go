@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/modules/portal/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Me returns the signed-in follower's points and cards.
+// Cards replaced by another card are left out since they can no longer be used.
+func (s *service) Me(ctx context.Context) (res response.PortalMeResponse, err error) {
+	values, err := utility.FollowerFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	follower, err := s.followerRepo.FindByID(ctx, values.FollowerID, "Domain", "Cards")
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	res = response.PortalMeResponse{
+		ID:     follower.ID,
+		Name:   follower.Name,
+		Points: follower.Points,
+		Cards:  make([]response.PortalCard, 0, len(follower.Cards)),
+	}
+	if follower.Domain != nil {
+		res.DomainName = follower.Domain.Name
+	}
+
+	for _, card := range follower.Cards {
+		if card.ReplacedByID != nil {
+			continue
+		}
+		res.Cards = append(res.Cards, response.PortalCard{
+			Code:      card.Code,
+			Status:    card.StatusAt(now),
+			ExpiresAt: card.ExpiresAt,
+		})
+	}
+
+	return
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// PointMutations returns the signed-in follower's point history.
+func (s *service) PointMutations(ctx context.Context, pg *pagination.Pagination) (
+	pointMutations []entity.PointMutation, meta utility.PaginationMeta, err error,
+) {
+	values, err := utility.FollowerFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	pg.AddCustomScope(func(db *gorm.DB) *gorm.DB {
+		return db.Where("follower_id = ?", values.FollowerID)
+	})
+
+	pointMutations, err = s.pointMutationRepo.FindAll(ctx, pg)
+	if err != nil {
+		return
+	}
+
+	count, err := s.pointMutationRepo.Count(ctx, pg)
+	if err != nil {
+		return
+	}
+
+	meta.Limit = pg.Limit
+	meta.Offset = pg.Offset
+	meta.Total = count
+
+	return
+}
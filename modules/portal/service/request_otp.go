@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/otp"
+	"github.com/PhantomX7/dhamma/modules/portal/dto/request"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/fuzzy"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// RequestOTP sends a sign-in code to the phone number if it belongs to a follower of the domain.
+// It succeeds whether or not the number is known, is throttled silently and does not report
+// delivery failures, so the response never reveals which phone numbers are registered.
+func (s *service) RequestOTP(ctx context.Context, domainCode string, req request.PortalRequestOTPRequest) (err error) {
+	domain, err := s.findDomain(ctx, domainCode)
+	if err != nil {
+		return
+	}
+
+	phoneKey := fuzzy.NormalizePhone(req.Phone)
+	if phoneKey == "" {
+		return &errors.AppError{
+			Message: "invalid phone number",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	followers, err := s.followerRepo.FindByPhoneKey(ctx, domain.ID, phoneKey)
+	if err != nil || len(followers) == 0 {
+		return
+	}
+
+	now := time.Now()
+	latest, found, err := s.followerOTPRepo.FindLatest(ctx, domain.ID, phoneKey)
+	if err != nil {
+		return
+	}
+	if found && now.Sub(latest.CreatedAt) < entity.FollowerOTPResendInterval {
+		return
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return
+	}
+
+	err = s.followerOTPRepo.Create(ctx, &entity.FollowerOTP{
+		DomainID:  domain.ID,
+		PhoneKey:  phoneKey,
+		CodeHash:  hashCode(domain.ID, phoneKey, code),
+		ExpiresAt: now.Add(entity.FollowerOTPExpiry),
+	}, nil)
+	if err != nil {
+		return
+	}
+
+	sendErr := s.otpSender.Send(ctx, otp.Code{Phone: req.Phone, Code: code, DomainName: domain.Name})
+	if sendErr != nil {
+		logger.FromCtx(ctx).Error("failed to send follower portal sign-in code", zap.Error(sendErr))
+	}
+
+	return
+}
+
+// generateCode returns a random six digit code.
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
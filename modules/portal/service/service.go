@@ -0,0 +1,39 @@
+package service
+
+import (
+	"github.com/PhantomX7/dhamma/libs/otp"
+	"github.com/PhantomX7/dhamma/modules/domain"
+	"github.com/PhantomX7/dhamma/modules/event"
+	"github.com/PhantomX7/dhamma/modules/follower"
+	"github.com/PhantomX7/dhamma/modules/follower_otp"
+	"github.com/PhantomX7/dhamma/modules/point_mutation"
+	"github.com/PhantomX7/dhamma/modules/portal"
+)
+
+type service struct {
+	domainRepo        domain.Repository
+	followerRepo      follower.Repository
+	followerOTPRepo   follower_otp.Repository
+	pointMutationRepo point_mutation.Repository
+	eventRepo         event.Repository
+	otpSender         otp.Sender
+}
+
+// New creates a new follower portal service instance.
+func New(
+	domainRepo domain.Repository,
+	followerRepo follower.Repository,
+	followerOTPRepo follower_otp.Repository,
+	pointMutationRepo point_mutation.Repository,
+	eventRepo event.Repository,
+	otpSender otp.Sender,
+) portal.Service {
+	return &service{
+		domainRepo:        domainRepo,
+		followerRepo:      followerRepo,
+		followerOTPRepo:   followerOTPRepo,
+		pointMutationRepo: pointMutationRepo,
+		eventRepo:         eventRepo,
+		otpSender:         otpSender,
+	}
+}
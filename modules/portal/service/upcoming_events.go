@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/PhantomX7/dhamma/modules/portal/dto/request"
+	"github.com/PhantomX7/dhamma/modules/portal/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+const (
+	// defaultUpcomingDays is how far ahead events are listed when the request does not say.
+	defaultUpcomingDays = 30
+	// maxUpcomingEvents caps how many occurrences are listed.
+	maxUpcomingEvents = 50
+)
+
+// UpcomingEvents lists the occurrences of the domain's events that have not ended yet and start
+// within the requested number of days, soonest first. Unscheduled events are not listed.
+func (s *service) UpcomingEvents(ctx context.Context, req request.PortalUpcomingEventsRequest) (events []response.PortalEvent, err error) {
+	values, err := utility.FollowerFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	days := req.Days
+	if days == 0 {
+		days = defaultUpcomingDays
+	}
+
+	// Start at midnight so events that are already under way today are still listed
+	now := time.Now().In(values.Location)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, values.Location)
+	to := now.AddDate(0, 0, days)
+
+	candidates, err := s.eventRepo.FindScheduled(ctx, values.DomainID, from, to)
+	if err != nil {
+		return
+	}
+
+	events = make([]response.PortalEvent, 0)
+	for _, event := range candidates {
+		for _, occurrence := range event.Occurrences(from, to, maxUpcomingEvents) {
+			if occurrence.EndAt.Before(now) {
+				continue
+			}
+			events = append(events, response.PortalEvent{
+				EventID:        event.ID,
+				Name:           event.Name,
+				Description:    event.Description,
+				PointsAwarded:  event.PointsAwarded,
+				StartAt:        occurrence.StartAt,
+				EndAt:          occurrence.EndAt,
+				CheckInOpenAt:  occurrence.CheckInOpenAt,
+				CheckInCloseAt: occurrence.CheckInCloseAt,
+			})
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].StartAt.Before(events[j].StartAt)
+	})
+	if len(events) > maxUpcomingEvents {
+		events = events[:maxUpcomingEvents]
+	}
+
+	return
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/constants"
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/portal/dto/request"
+	"github.com/PhantomX7/dhamma/modules/portal/dto/response"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/fuzzy"
+)
+
+// invalidCodeError is returned for every failed sign-in so callers cannot tell unknown numbers, wrong codes and expired codes apart.
+func invalidCodeError() error {
+	return &errors.AppError{
+		Message: "invalid or expired code",
+		Status:  http.StatusUnauthorized,
+	}
+}
+
+// VerifyOTP signs a follower in with the code sent to their phone number.
+// Each guess counts against the code, which stops working after entity.FollowerOTPMaxAttempts.
+func (s *service) VerifyOTP(ctx context.Context, domainCode string, req request.PortalVerifyOTPRequest) (res response.PortalAuthResponse, err error) {
+	domain, err := s.findDomain(ctx, domainCode)
+	if err != nil {
+		return
+	}
+
+	phoneKey := fuzzy.NormalizePhone(req.Phone)
+	if phoneKey == "" {
+		return res, invalidCodeError()
+	}
+
+	now := time.Now()
+	otp, found, err := s.followerOTPRepo.FindLatest(ctx, domain.ID, phoneKey)
+	if err != nil {
+		return
+	}
+	if !found || !otp.IsUsable(now) {
+		return res, invalidCodeError()
+	}
+
+	// The guess is counted before the code is compared, so parallel guesses cannot exceed the limit
+	counted, err := s.followerOTPRepo.UseAttempt(ctx, otp.ID, now)
+	if err != nil {
+		return
+	}
+	if !counted {
+		return res, invalidCodeError()
+	}
+
+	if !hmac.Equal([]byte(otp.CodeHash), []byte(hashCode(domain.ID, phoneKey, req.Code))) {
+		return res, invalidCodeError()
+	}
+
+	followers, err := s.followerRepo.FindByPhoneKey(ctx, domain.ID, phoneKey)
+	if err != nil {
+		return
+	}
+
+	follower, ok := pickFollower(followers, req.FollowerID)
+	if !ok {
+		if req.FollowerID != nil || len(followers) == 0 {
+			return res, invalidCodeError()
+		}
+
+		// Leave the code unconsumed so it can be sent again with the chosen follower
+		for _, choice := range followers {
+			res.Followers = append(res.Followers, response.PortalFollowerChoice{ID: choice.ID, Name: choice.Name})
+		}
+		return
+	}
+
+	consumed, err := s.followerOTPRepo.Consume(ctx, otp.ID, now)
+	if err != nil {
+		return
+	}
+	if !consumed {
+		return res, invalidCodeError()
+	}
+
+	expiresAt := now.Add(constants.FollowerAccessTokenExpiry)
+	res.AccessToken, err = generateAccessToken(follower, now, expiresAt)
+	if err != nil {
+		return
+	}
+	res.ExpiresAt = utility.PointOf(expiresAt)
+
+	return
+}
+
+// pickFollower returns the follower to sign in as: the requested one, or the only one sharing the phone number.
+func pickFollower(followers []entity.Follower, followerID *uint64) (entity.Follower, bool) {
+	if followerID == nil {
+		if len(followers) == 1 {
+			return followers[0], true
+		}
+		return entity.Follower{}, false
+	}
+
+	for _, follower := range followers {
+		if follower.ID == *followerID {
+			return follower, true
+		}
+	}
+	return entity.Follower{}, false
+}
+
+// generateAccessToken issues a follower portal token. It uses FollowerClaims with the follower audience
+// and its own secret, so it is rejected by every user middleware.
+func generateAccessToken(follower entity.Follower, now time.Time, expiresAt time.Time) (string, error) {
+	claims := entity.FollowerClaims{
+		FollowerID: follower.ID,
+		DomainID:   follower.DomainID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{constants.FollowerTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(config.FOLLOWER_JWT_SECRET))
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/otp"
+	domainRepo "github.com/PhantomX7/dhamma/modules/domain/repository"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	followerOTPRepo "github.com/PhantomX7/dhamma/modules/follower_otp/repository"
+	"github.com/PhantomX7/dhamma/modules/portal"
+	"github.com/PhantomX7/dhamma/modules/portal/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/fuzzy"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// recordingSender remembers the last code sent.
+type recordingSender struct {
+	mu   sync.Mutex
+	code string
+}
+
+func (s *recordingSender) Send(ctx context.Context, code otp.Code) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.code = code.Code
+	return nil
+}
+
+// portalFixture is a domain with a follower whose phone number is stored with formatting.
+type portalFixture struct {
+	db       *gorm.DB
+	service  portal.Service
+	sender   *recordingSender
+	domain   entity.Domain
+	follower entity.Follower
+}
+
+func newPortalFixture(t *testing.T) portalFixture {
+	db := testdb.New(t, &entity.Domain{}, &entity.Follower{}, &entity.FollowerOTP{})
+	domain := testdb.Domain(t, db)
+	follower := testdb.Follower(t, db, domain.ID, testdb.WithPhone("0812-3456-7890"))
+
+	sender := &recordingSender{}
+	return portalFixture{
+		db:       db,
+		service:  New(domainRepo.New(db), followerRepo.New(db), followerOTPRepo.New(db), nil, nil, sender),
+		sender:   sender,
+		domain:   domain,
+		follower: follower,
+	}
+}
+
+// requestCode sends a sign-in code to the follower and returns it.
+func (f portalFixture) requestCode(t *testing.T) string {
+	t.Helper()
+
+	require.NoError(t, f.service.RequestOTP(context.Background(), f.domain.Code, request.PortalRequestOTPRequest{Phone: "+62 812 3456 7890"}))
+	require.NotEmpty(t, f.sender.code)
+	return f.sender.code
+}
+
+func (f portalFixture) verify(code string) error {
+	_, err := f.service.VerifyOTP(context.Background(), f.domain.Code, request.PortalVerifyOTPRequest{Phone: "6281234567890", Code: code})
+	return err
+}
+
+// wrongCode returns a code that differs from code.
+func wrongCode(code string) string {
+	if code == "000000" {
+		return "111111"
+	}
+	return "000000"
+}
+
+func TestFindByPhoneKey_MatchesFormattedNumbers(t *testing.T) {
+	f := newPortalFixture(t)
+
+	other := entity.Follower{DomainID: f.domain.ID + 1, Name: "Citra", Phone: f.follower.Phone}
+	require.NoError(t, f.db.Create(&other).Error)
+
+	phoneKey := fuzzy.NormalizePhone("+62 812 3456 7890")
+	followers, err := followerRepo.New(f.db).FindByPhoneKey(context.Background(), f.domain.ID, phoneKey)
+	require.NoError(t, err)
+	require.Len(t, followers, 1)
+	assert.Equal(t, f.follower.ID, followers[0].ID)
+
+	// The key follows the phone number when it changes
+	f.follower.Phone = utility.PointOf("0813 0000 0000")
+	require.NoError(t, followerRepo.New(f.db).Update(context.Background(), &f.follower, nil))
+
+	followers, err = followerRepo.New(f.db).FindByPhoneKey(context.Background(), f.domain.ID, phoneKey)
+	require.NoError(t, err)
+	assert.Empty(t, followers)
+}
+
+func TestVerifyOTP_SignsIn(t *testing.T) {
+	f := newPortalFixture(t)
+	code := f.requestCode(t)
+
+	res, err := f.service.VerifyOTP(context.Background(), f.domain.Code, request.PortalVerifyOTPRequest{Phone: "0812 3456 7890", Code: code})
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.AccessToken)
+
+	// The code is single use
+	assert.Error(t, f.verify(code))
+}
+
+func TestVerifyOTP_CodeIsBurntAfterMaxAttempts(t *testing.T) {
+	f := newPortalFixture(t)
+	code := f.requestCode(t)
+
+	for range entity.FollowerOTPMaxAttempts {
+		assert.Error(t, f.verify(wrongCode(code)))
+	}
+
+	assert.Error(t, f.verify(code))
+}
+
+func TestVerifyOTP_ConcurrentGuessesStayWithinMaxAttempts(t *testing.T) {
+	const guesses = 20
+
+	f := newPortalFixture(t)
+	code := f.requestCode(t)
+
+	var wg sync.WaitGroup
+	for range guesses {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = f.verify(wrongCode(code))
+		}()
+	}
+	wg.Wait()
+
+	var stored entity.FollowerOTP
+	require.NoError(t, f.db.First(&stored).Error)
+	assert.Equal(t, entity.FollowerOTPMaxAttempts, stored.Attempts)
+
+	assert.Error(t, f.verify(code))
+}
@@ -0,0 +1,42 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Session is a signed-in device: a refresh token family with a token that can still be used.
+type Session struct {
+	ID              uuid.UUID `json:"id"` // Family ID of the session's refresh tokens
+	DomainID        *uint64   `json:"domain_id"`
+	Scopes          []string  `json:"scopes"`
+	UserAgent       string    `json:"user_agent"`
+	IPAddress       string    `json:"ip_address"` // Address of the latest sign-in or refresh
+	SignedInAt      time.Time `json:"signed_in_at"`
+	LastRefreshedAt time.Time `json:"last_refreshed_at"`
+	ExpiresAt       time.Time `json:"expires_at"`
+	Current         bool      `json:"current"` // Whether the session is the one making the request
+}
+
+// NewSessions describes the sessions of the given valid refresh tokens.
+// currentID is the session of the request, if any.
+func NewSessions(refreshTokens []entity.RefreshToken, currentID string) []Session {
+	sessions := make([]Session, 0, len(refreshTokens))
+	for _, refreshToken := range refreshTokens {
+		sessions = append(sessions, Session{
+			ID:              refreshToken.FamilyID,
+			DomainID:        refreshToken.DomainID,
+			Scopes:          refreshToken.ScopeList(),
+			UserAgent:       refreshToken.UserAgent,
+			IPAddress:       refreshToken.IPAddress,
+			SignedInAt:      refreshToken.SignedInAt,
+			LastRefreshedAt: refreshToken.CreatedAt,
+			ExpiresAt:       refreshToken.ExpiresAt,
+			Current:         currentID != "" && refreshToken.FamilyID.String() == currentID,
+		})
+	}
+	return sessions
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/PhantomX7/dhamma/entity"
+	"github.com/google/uuid"
 
 	"gorm.io/gorm"
 )
@@ -16,4 +17,20 @@ type Repository interface {
 	GetValidCountByUserID(ctx context.Context, userID uint64) (int64, error)
 	DeleteInvalidToken(ctx context.Context) error
 	InvalidateAllByUserID(ctx context.Context, userID uint64) error
+	// InvalidateAllByUserIDExceptFamily invalidates the user's tokens of every session but familyID, or of every session when it is uuid.Nil.
+	InvalidateAllByUserIDExceptFamily(ctx context.Context, userID uint64, familyID uuid.UUID, tx *gorm.DB) error
+	// InvalidateAllByUserIDAndDomainID invalidates the user's tokens bound to a domain.
+	InvalidateAllByUserIDAndDomainID(ctx context.Context, userID uint64, domainID uint64) error
+	// FindIssuedByID returns a refresh token whether or not it is still valid, so reuse of rotated tokens can be detected.
+	FindIssuedByID(ctx context.Context, refreshTokenID string) (entity.RefreshToken, error)
+	// Rotate atomically invalidates a valid token, recording the token it was rotated into.
+	// It returns false when the token was no longer valid.
+	Rotate(ctx context.Context, refreshTokenID uuid.UUID, replacedByID uuid.UUID, tx *gorm.DB) (bool, error)
+	// InvalidateFamily invalidates the valid tokens of one of the user's refresh token families,
+	// optionally only if the family is bound to domainID. It returns false when there was none.
+	InvalidateFamily(ctx context.Context, userID uint64, familyID uuid.UUID, domainID *uint64) (bool, error)
+	// HasValidInFamily reports whether a refresh token family still has a valid token.
+	HasValidInFamily(ctx context.Context, familyID uuid.UUID) (bool, error)
+	// FindActiveByUserID returns the valid tokens of the user, one per signed-in session, optionally only those bound to a domain.
+	FindActiveByUserID(ctx context.Context, userID uint64, domainID *uint64) ([]entity.RefreshToken, error)
 }
@@ -11,9 +11,11 @@ import (
 	"github.com/PhantomX7/dhamma/utility/logger"
 )
 
+// DeleteInvalidToken deletes expired tokens. Rotated tokens are kept until they expire,
+// so presenting one again is still recognised as reuse.
 func (r *repository) DeleteInvalidToken(ctx context.Context) error {
 	err := r.db.WithContext(ctx).
-		Where("expires_at < ? OR is_valid = ?", time.Now(), false).
+		Where("expires_at < ?", time.Now()).
 		Delete(&entity.RefreshToken{}).Error
 
 	if err != nil {
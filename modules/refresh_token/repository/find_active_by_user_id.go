@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+func (r *repository) FindActiveByUserID(ctx context.Context, userID uint64, domainID *uint64) ([]entity.RefreshToken, error) {
+	var refreshTokens []entity.RefreshToken
+
+	query := r.db.WithContext(ctx).
+		Where("user_id = ? AND is_valid = ? AND expires_at > ?", userID, true, time.Now())
+	if domainID != nil {
+		query = query.Where("domain_id = ?", *domainID)
+	}
+
+	err := query.Order("created_at desc").Find(&refreshTokens).Error
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrDatabase, "failed to find active refresh tokens")
+	}
+
+	return refreshTokens, nil
+}
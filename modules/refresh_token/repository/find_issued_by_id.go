@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	customErrors "github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+func (r *repository) FindIssuedByID(ctx context.Context, refreshTokenID string) (entity.RefreshToken, error) {
+	var refreshToken entity.RefreshToken
+
+	result := r.db.WithContext(ctx).
+		Where("id = ?", refreshTokenID).
+		Take(&refreshToken)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return refreshToken, customErrors.ErrNotFound
+		}
+
+		logger.FromCtx(ctx).Error("refresh token not found", zap.String("id", refreshTokenID), zap.Error(result.Error))
+		return refreshToken, customErrors.WrapError(customErrors.ErrDatabase, "failed to find refresh token")
+	}
+
+	return refreshToken, nil
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+func (r *repository) HasValidInFamily(ctx context.Context, familyID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entity.RefreshToken{}).
+		Where("family_id = ? AND is_valid = ? AND expires_at > ?", familyID, true, time.Now()).
+		Count(&count).Error
+
+	if err != nil {
+		return false, errors.WrapError(errors.ErrDatabase, "failed to check refresh token family")
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+func (r *repository) InvalidateAllByUserIDAndDomainID(ctx context.Context, userID uint64, domainID uint64) error {
+	err := r.db.WithContext(ctx).
+		Model(&entity.RefreshToken{}).
+		Where("user_id = ? AND domain_id = ?", userID, domainID).
+		Update("is_valid", false).Error
+
+	if err != nil {
+		return errors.WrapError(errors.ErrDatabase, "failed to invalidate tokens for user")
+	}
+
+	return nil
+}
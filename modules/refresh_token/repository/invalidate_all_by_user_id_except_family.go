@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+func (r *repository) InvalidateAllByUserIDExceptFamily(ctx context.Context, userID uint64, familyID uuid.UUID, tx *gorm.DB) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	query := db.WithContext(ctx).
+		Model(&entity.RefreshToken{}).
+		Where("user_id = ? AND is_valid = ?", userID, true)
+	if familyID != uuid.Nil {
+		query = query.Where("family_id <> ?", familyID)
+	}
+
+	if err := query.Update("is_valid", false).Error; err != nil {
+		return errors.WrapError(errors.ErrDatabase, "failed to invalidate tokens for user")
+	}
+
+	return nil
+}
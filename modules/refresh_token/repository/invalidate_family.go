@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+func (r *repository) InvalidateFamily(ctx context.Context, userID uint64, familyID uuid.UUID, domainID *uint64) (bool, error) {
+	query := r.db.WithContext(ctx).
+		Model(&entity.RefreshToken{}).
+		Where("user_id = ? AND family_id = ? AND is_valid = ?", userID, familyID, true)
+	if domainID != nil {
+		query = query.Where("domain_id = ?", *domainID)
+	}
+
+	result := query.Update("is_valid", false)
+	if result.Error != nil {
+		return false, errors.WrapError(errors.ErrDatabase, "failed to invalidate refresh token family")
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Rotate invalidates the token with a single conditional UPDATE, so of two refreshes racing
+// with the same token only one can rotate it; the other sees it as reused.
+func (r *repository) Rotate(ctx context.Context, refreshTokenID uuid.UUID, replacedByID uuid.UUID, tx *gorm.DB) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&entity.RefreshToken{}).
+		Where("id = ? AND is_valid = ?", refreshTokenID, true).
+		Updates(map[string]any{"is_valid": false, "replaced_by_id": replacedByID})
+	if result.Error != nil {
+		return false, errors.WrapError(errors.ErrDatabase, "failed to rotate refresh token")
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -3,35 +3,69 @@ package modules
 import (
 	"go.uber.org/fx"
 
+	bloodDonationRepo "github.com/PhantomX7/dhamma/modules/blood_donation/repository"
+	campaignRepo "github.com/PhantomX7/dhamma/modules/campaign/repository"
+	campaignRunRepo "github.com/PhantomX7/dhamma/modules/campaign_run/repository"
 	cardRepo "github.com/PhantomX7/dhamma/modules/card/repository"
 	chatTemplateRepo "github.com/PhantomX7/dhamma/modules/chat_template/repository"
+	chatTemplateVersionRepo "github.com/PhantomX7/dhamma/modules/chat_template_version/repository"
 	domainRepo "github.com/PhantomX7/dhamma/modules/domain/repository"
 	eventRepo "github.com/PhantomX7/dhamma/modules/event/repository"
 	eventAttendanceRepo "github.com/PhantomX7/dhamma/modules/event_attendance/repository"
+	eventSelfCheckInRepo "github.com/PhantomX7/dhamma/modules/event_self_check_in/repository"
 	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	followerMergeRepo "github.com/PhantomX7/dhamma/modules/follower_merge/repository"
+	followerOTPRepo "github.com/PhantomX7/dhamma/modules/follower_otp/repository"
+	followerSegmentRepo "github.com/PhantomX7/dhamma/modules/follower_segment/repository"
+	loginThrottleRepo "github.com/PhantomX7/dhamma/modules/login_throttle/repository"
+	outboundMessageRepo "github.com/PhantomX7/dhamma/modules/outbound_message/repository"
+	passwordHistoryRepo "github.com/PhantomX7/dhamma/modules/password_history/repository"
+	passwordResetTokenRepo "github.com/PhantomX7/dhamma/modules/password_reset_token/repository"
 	permissionRepo "github.com/PhantomX7/dhamma/modules/permission/repository"
 	pointMutationRepo "github.com/PhantomX7/dhamma/modules/point_mutation/repository"
 	refreshTokenRepo "github.com/PhantomX7/dhamma/modules/refresh_token/repository"
+	rewardRepo "github.com/PhantomX7/dhamma/modules/reward/repository"
+	rewardRedemptionRepo "github.com/PhantomX7/dhamma/modules/reward_redemption/repository"
 	roleRepo "github.com/PhantomX7/dhamma/modules/role/repository"
+	securityEventRepo "github.com/PhantomX7/dhamma/modules/security_event/repository"
 	userRepo "github.com/PhantomX7/dhamma/modules/user/repository"
 	userDomainRepo "github.com/PhantomX7/dhamma/modules/user_domain/repository"
+	userMFARepo "github.com/PhantomX7/dhamma/modules/user_mfa/repository"
+	userRecoveryCodeRepo "github.com/PhantomX7/dhamma/modules/user_recovery_code/repository"
 	userRoleRepo "github.com/PhantomX7/dhamma/modules/user_role/repository"
 )
 
 var RepositoryModule = fx.Options(
 	fx.Provide(
+		bloodDonationRepo.New,
+		campaignRepo.New,
+		campaignRunRepo.New,
 		cardRepo.New,
 		chatTemplateRepo.New,
+		chatTemplateVersionRepo.New,
 		domainRepo.New,
 		eventRepo.New,
 		eventAttendanceRepo.New,
+		eventSelfCheckInRepo.New,
 		followerRepo.New,
+		followerMergeRepo.New,
+		followerOTPRepo.New,
+		followerSegmentRepo.New,
+		loginThrottleRepo.New,
+		outboundMessageRepo.New,
+		passwordHistoryRepo.New,
+		passwordResetTokenRepo.New,
 		permissionRepo.New,
 		pointMutationRepo.New,
 		refreshTokenRepo.New,
+		rewardRepo.New,
+		rewardRedemptionRepo.New,
+		securityEventRepo.New,
 		roleRepo.New,
 		userRepo.New,
 		userDomainRepo.New,
+		userMFARepo.New,
+		userRecoveryCodeRepo.New,
 		userRoleRepo.New,
 	),
 )
@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"github.com/PhantomX7/dhamma/modules/reward"
+)
+
+type controller struct {
+	rewardService reward.Service
+}
+
+func New(rewardService reward.Service) reward.Controller {
+	return &controller{
+		rewardService: rewardService,
+	}
+}
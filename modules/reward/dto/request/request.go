@@ -0,0 +1,73 @@
+package request
+
+import (
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// RewardCreateRequest defines the payload for adding a reward to a domain's catalogue.
+type RewardCreateRequest struct {
+	DomainID    uint64  `json:"domain_id" form:"domain_id" binding:"required,exist=domains.id"`
+	Name        string  `json:"name" form:"name" binding:"required,max=255"`
+	Description *string `json:"description" form:"description" binding:"omitempty,max=500"`
+	PointCost   int     `json:"point_cost" form:"point_cost" binding:"required,min=1,max=1000000"`
+	Stock       int     `json:"stock" form:"stock" binding:"omitempty,min=0,max=1000000"`
+	IsActive    *bool   `json:"is_active" form:"is_active"` // Defaults to true
+}
+
+// RewardUpdateRequest defines the payload for updating a reward.
+// A new point cost only applies to orders placed after the change.
+type RewardUpdateRequest struct {
+	Name        *string `json:"name" form:"name" binding:"omitempty,max=255"`
+	Description *string `json:"description" form:"description" binding:"omitempty,max=500"`
+	PointCost   *int    `json:"point_cost" form:"point_cost" binding:"omitempty,min=1,max=1000000"`
+	Stock       *int    `json:"stock" form:"stock" binding:"omitempty,min=0,max=1000000"`
+	IsActive    *bool   `json:"is_active" form:"is_active"`
+}
+
+func NewRewardPagination(conditions map[string][]string) *pagination.Pagination {
+	filterDef := pagination.NewFilterDefinition().
+		AddFilter("name", pagination.FilterConfig{
+			TableName: "rewards",
+			Field:     "name",
+			Type:      pagination.FilterTypeString,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals, pagination.OperatorLike,
+			},
+		}).
+		AddFilter("is_active", pagination.FilterConfig{
+			TableName: "rewards",
+			Field:     "is_active",
+			Type:      pagination.FilterTypeBool,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("point_cost", pagination.FilterConfig{
+			TableName: "rewards",
+			Field:     "point_cost",
+			Type:      pagination.FilterTypeNumber,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorEquals, pagination.OperatorBetween, pagination.OperatorGte, pagination.OperatorLte,
+			},
+		}).
+		AddSort("name", pagination.SortConfig{
+			TableName: "rewards",
+			Field:     "name",
+			Allowed:   true,
+		}).
+		AddSort("point_cost", pagination.SortConfig{
+			TableName: "rewards",
+			Field:     "point_cost",
+			Allowed:   true,
+		})
+
+	return pagination.NewPagination(
+		conditions,
+		filterDef,
+		pagination.PaginationOptions{
+			DefaultLimit: 20,
+			MaxLimit:     100,
+			DefaultOrder: "id desc",
+		},
+	)
+}
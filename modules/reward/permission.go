@@ -0,0 +1,21 @@
+package reward
+
+type permission struct {
+	Key string
+	// Index all rewards of the catalogue
+	Index string
+	// View reward details
+	Show string
+	// Add a reward to the catalogue
+	Create string
+	// Update a reward, including its point cost and stock
+	Update string
+}
+
+var Permissions = permission{
+	Key:    "reward",
+	Index:  "index",
+	Show:   "show",
+	Create: "create",
+	Update: "update",
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// DecrementStock atomically takes quantity units off the reward's stock with a single UPDATE.
+// Nothing is updated when the stock does not cover the quantity, in which case applied is false.
+func (r *repository) DecrementStock(ctx context.Context, rewardID uint64, quantity int, tx *gorm.DB) (applied bool, err error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Table(entity.Reward{}.TableName()).
+		Where("id = ? AND stock >= ?", rewardID, quantity).
+		UpdateColumn("stock", gorm.Expr("stock - ?", quantity))
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// IncrementStock atomically puts quantity units back on the reward's stock.
+func (r *repository) IncrementStock(ctx context.Context, rewardID uint64, quantity int, tx *gorm.DB) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	return db.WithContext(ctx).
+		Table(entity.Reward{}.TableName()).
+		Where("id = ?", rewardID).
+		UpdateColumn("stock", gorm.Expr("stock + ?", quantity)).
+		Error
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/reward"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.Reward] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new reward repository instance.
+func New(db *gorm.DB) reward.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.Reward](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all reward entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.Reward, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a reward entity by its ID.
+func (r *repository) FindByID(ctx context.Context, rewardID uint64, preloads ...string) (entity.Reward, error) {
+	return r.base.FindByID(ctx, rewardID, preloads...)
+}
+
+// Create creates a new reward entity.
+func (r *repository) Create(ctx context.Context, reward *entity.Reward, tx *gorm.DB) error {
+	return r.base.Create(ctx, reward, tx)
+}
+
+// Update updates an existing reward entity.
+func (r *repository) Update(ctx context.Context, reward *entity.Reward, tx *gorm.DB) error {
+	return r.base.Update(ctx, reward, tx)
+}
+
+// Delete deletes a reward entity.
+func (r *repository) Delete(ctx context.Context, reward *entity.Reward, tx *gorm.DB) error {
+	return r.base.Delete(ctx, reward, tx)
+}
+
+// Count counts reward entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves reward entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.Reward, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single reward entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.Reward, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves reward entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.Reward, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single reward entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.Reward, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any reward records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
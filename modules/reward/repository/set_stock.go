@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// SetStock replaces the reward's stock. Stock is not written by Update, so saving other
+// fields of a reward read before a redemption cannot undo that redemption's decrement.
+// The stock updates go through the table rather than the model, which gorm would not let write it.
+func (r *repository) SetStock(ctx context.Context, rewardID uint64, stock int, tx *gorm.DB) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	return db.WithContext(ctx).
+		Table(entity.Reward{}.TableName()).
+		Where("id = ?", rewardID).
+		UpdateColumn("stock", stock).
+		Error
+}
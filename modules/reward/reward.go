@@ -0,0 +1,39 @@
+package reward
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/reward/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.Reward]
+	// DecrementStock atomically takes quantity units off the reward's stock.
+	// Quantities the stock does not cover are not applied and return false.
+	DecrementStock(ctx context.Context, rewardID uint64, quantity int, tx *gorm.DB) (bool, error)
+	// IncrementStock atomically puts quantity units back on the reward's stock.
+	IncrementStock(ctx context.Context, rewardID uint64, quantity int, tx *gorm.DB) error
+	// SetStock replaces the reward's stock, e.g. after a stocktake.
+	SetStock(ctx context.Context, rewardID uint64, stock int, tx *gorm.DB) error
+}
+
+type Service interface {
+	Index(ctx context.Context, pg *pagination.Pagination) ([]entity.Reward, utility.PaginationMeta, error)
+	Show(ctx context.Context, rewardID uint64) (entity.Reward, error)
+	Create(ctx context.Context, req request.RewardCreateRequest) (entity.Reward, error)
+	Update(ctx context.Context, rewardID uint64, req request.RewardUpdateRequest) (entity.Reward, error)
+}
+
+type Controller interface {
+	Index(ctx *gin.Context)
+	Show(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/reward/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Create implements reward.Service
+func (s *service) Create(ctx context.Context, req request.RewardCreateRequest) (reward entity.Reward, err error) {
+	_, err = utility.CheckDomainContext(ctx, req.DomainID, "reward", "create")
+	if err != nil {
+		return
+	}
+
+	reward = entity.Reward{
+		DomainID:    req.DomainID,
+		Name:        req.Name,
+		Description: req.Description,
+		PointCost:   req.PointCost,
+		Stock:       req.Stock,
+		IsActive:    true,
+	}
+	if req.IsActive != nil {
+		reward.IsActive = *req.IsActive
+	}
+
+	err = s.rewardRepo.Create(ctx, &reward, nil)
+	return
+}
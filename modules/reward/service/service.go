@@ -0,0 +1,21 @@
+package service
+
+import (
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/reward"
+)
+
+type service struct {
+	rewardRepo         reward.Repository
+	transactionManager transaction_manager.Client
+}
+
+func New(
+	rewardRepo reward.Repository,
+	transactionManager transaction_manager.Client,
+) reward.Service {
+	return &service{
+		rewardRepo:         rewardRepo,
+		transactionManager: transactionManager,
+	}
+}
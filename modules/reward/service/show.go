@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Show implements reward.Service
+func (s *service) Show(ctx context.Context, rewardID uint64) (reward entity.Reward, err error) {
+	reward, err = s.rewardRepo.FindByID(ctx, rewardID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, reward.DomainID, "reward", "show")
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/reward/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Update implements reward.Service. Orders already placed keep the points they were charged.
+// The stock, when given, replaces the current stock rather than being saved with the other fields.
+func (s *service) Update(ctx context.Context, rewardID uint64, req request.RewardUpdateRequest) (reward entity.Reward, err error) {
+	reward, err = s.rewardRepo.FindByID(ctx, rewardID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, reward.DomainID, "reward", "update")
+	if err != nil {
+		return
+	}
+
+	if req.Name != nil {
+		reward.Name = *req.Name
+	}
+	if req.Description != nil {
+		reward.Description = req.Description
+	}
+	if req.PointCost != nil {
+		reward.PointCost = *req.PointCost
+	}
+	if req.IsActive != nil {
+		reward.IsActive = *req.IsActive
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		if err := s.rewardRepo.Update(ctx, &reward, tx); err != nil {
+			return err
+		}
+
+		if req.Stock == nil {
+			return nil
+		}
+		if err := s.rewardRepo.SetStock(ctx, reward.ID, *req.Stock, tx); err != nil {
+			return err
+		}
+		reward.Stock = *req.Stock
+		return nil
+	})
+	return
+}
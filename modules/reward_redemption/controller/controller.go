@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"github.com/PhantomX7/dhamma/modules/reward_redemption"
+)
+
+type controller struct {
+	rewardRedemptionService reward_redemption.Service
+}
+
+func New(rewardRedemptionService reward_redemption.Service) reward_redemption.Controller {
+	return &controller{
+		rewardRedemptionService: rewardRedemptionService,
+	}
+}
@@ -0,0 +1,70 @@
+package request
+
+import (
+	"github.com/PhantomX7/dhamma/utility/pagination"
+)
+
+// RewardRedemptionCreateRequest defines the payload for redeeming a follower's points for a reward.
+type RewardRedemptionCreateRequest struct {
+	RewardID   uint64  `json:"reward_id" form:"reward_id" binding:"required,exist=rewards.id"`
+	FollowerID uint64  `json:"follower_id" form:"follower_id" binding:"required,exist=followers.id"`
+	Quantity   int     `json:"quantity" form:"quantity" binding:"omitempty,min=1,max=100"` // Defaults to 1
+	Note       *string `json:"note" form:"note" binding:"omitempty,max=255"`
+}
+
+// RewardRedemptionCancelRequest defines the payload for cancelling a pending redemption order.
+type RewardRedemptionCancelRequest struct {
+	Reason *string `json:"reason" form:"reason" binding:"omitempty,max=255"`
+}
+
+func NewRewardRedemptionPagination(conditions map[string][]string) *pagination.Pagination {
+	filterDef := pagination.NewFilterDefinition().
+		AddFilter("status", pagination.FilterConfig{
+			TableName:  "reward_redemptions",
+			Field:      "status",
+			Type:       pagination.FilterTypeEnum,
+			EnumValues: []string{"pending", "fulfilled", "cancelled"},
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorEquals, pagination.OperatorIn,
+			},
+		}).
+		AddFilter("reward_id", pagination.FilterConfig{
+			TableName: "reward_redemptions",
+			Field:     "reward_id",
+			Type:      pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("follower_id", pagination.FilterConfig{
+			TableName: "reward_redemptions",
+			Field:     "follower_id",
+			Type:      pagination.FilterTypeID,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorIn, pagination.OperatorEquals,
+			},
+		}).
+		AddFilter("created_at", pagination.FilterConfig{
+			TableName: "reward_redemptions",
+			Field:     "created_at",
+			Type:      pagination.FilterTypeDate,
+			Operators: []pagination.FilterOperator{
+				pagination.OperatorBetween, pagination.OperatorEquals,
+			},
+		}).
+		AddSort("created_at", pagination.SortConfig{
+			TableName: "reward_redemptions",
+			Field:     "created_at",
+			Allowed:   true,
+		})
+
+	return pagination.NewPagination(
+		conditions,
+		filterDef,
+		pagination.PaginationOptions{
+			DefaultLimit: 20,
+			MaxLimit:     100,
+			DefaultOrder: "id desc",
+		},
+	)
+}
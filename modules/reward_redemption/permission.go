@@ -0,0 +1,24 @@
+package reward_redemption
+
+type permission struct {
+	Key string
+	// Index all reward redemption orders
+	Index string
+	// View reward redemption order details
+	Show string
+	// Redeem a follower's points for a reward
+	Create string
+	// Mark a redemption order as handed over
+	Fulfill string
+	// Cancel a pending redemption order, refunding its points
+	Cancel string
+}
+
+var Permissions = permission{
+	Key:     "reward-redemption",
+	Index:   "index",
+	Show:    "show",
+	Create:  "create",
+	Fulfill: "fulfill",
+	Cancel:  "cancel",
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/reward_redemption"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.RewardRedemption] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new reward_redemption repository instance.
+func New(db *gorm.DB) reward_redemption.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.RewardRedemption](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all reward_redemption entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.RewardRedemption, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a reward_redemption entity by its ID.
+func (r *repository) FindByID(ctx context.Context, rewardRedemptionID uint64, preloads ...string) (entity.RewardRedemption, error) {
+	return r.base.FindByID(ctx, rewardRedemptionID, preloads...)
+}
+
+// Create creates a new reward_redemption entity.
+func (r *repository) Create(ctx context.Context, rewardRedemption *entity.RewardRedemption, tx *gorm.DB) error {
+	return r.base.Create(ctx, rewardRedemption, tx)
+}
+
+// Update updates an existing reward_redemption entity.
+func (r *repository) Update(ctx context.Context, rewardRedemption *entity.RewardRedemption, tx *gorm.DB) error {
+	return r.base.Update(ctx, rewardRedemption, tx)
+}
+
+// Delete deletes a reward_redemption entity.
+func (r *repository) Delete(ctx context.Context, rewardRedemption *entity.RewardRedemption, tx *gorm.DB) error {
+	return r.base.Delete(ctx, rewardRedemption, tx)
+}
+
+// Count counts reward_redemption entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves reward_redemption entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.RewardRedemption, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single reward_redemption entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.RewardRedemption, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves reward_redemption entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.RewardRedemption, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single reward_redemption entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.RewardRedemption, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any reward_redemption records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// TransitionStatus moves the order from one status to another with a single conditional UPDATE,
+// so two concurrent requests cannot both act on the same pending order. The columns in
+// changes are updated along with the status. Applied is false when the order was no longer in from.
+func (r *repository) TransitionStatus(ctx context.Context, redemptionID uint64, from string, to string, changes map[string]any, tx *gorm.DB) (applied bool, err error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	columns := map[string]any{"status": to}
+	for column, value := range changes {
+		columns[column] = value
+	}
+
+	result := db.WithContext(ctx).
+		Model(&entity.RewardRedemption{}).
+		Where("id = ? AND status = ?", redemptionID, from).
+		Updates(columns)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
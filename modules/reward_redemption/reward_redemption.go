@@ -0,0 +1,39 @@
+package reward_redemption
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/reward_redemption/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.RewardRedemption]
+	// TransitionStatus atomically moves the order from one status to another, updating changes along with it.
+	// Orders no longer in the from status are not updated and return false.
+	TransitionStatus(ctx context.Context, redemptionID uint64, from string, to string, changes map[string]any, tx *gorm.DB) (bool, error)
+	// ReassignFollower moves every order of fromFollowerID to toFollowerID and returns how many were moved.
+	ReassignFollower(ctx context.Context, fromFollowerID uint64, toFollowerID uint64, tx *gorm.DB) (int64, error)
+}
+
+type Service interface {
+	Index(ctx context.Context, pg *pagination.Pagination) ([]entity.RewardRedemption, utility.PaginationMeta, error)
+	Show(ctx context.Context, redemptionID uint64) (entity.RewardRedemption, error)
+	Create(ctx context.Context, req request.RewardRedemptionCreateRequest) (entity.RewardRedemption, error)
+	Fulfill(ctx context.Context, redemptionID uint64) (entity.RewardRedemption, error)
+	Cancel(ctx context.Context, redemptionID uint64, req request.RewardRedemptionCancelRequest) (entity.RewardRedemption, error)
+}
+
+type Controller interface {
+	Index(ctx *gin.Context)
+	Show(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Fulfill(ctx *gin.Context)
+	Cancel(ctx *gin.Context)
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/reward_redemption/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Cancel cancels a pending redemption order. The points charged for it are refunded with a positive
+// point mutation against the same order and its quantity is put back on the reward's stock,
+// all in the transaction that changes the status, so an order is only ever refunded once.
+func (s *service) Cancel(ctx context.Context, redemptionID uint64, req request.RewardRedemptionCancelRequest) (redemption entity.RewardRedemption, err error) {
+	redemption, err = s.rewardRedemptionRepo.FindByID(ctx, redemptionID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, redemption.DomainID, "reward redemption", "cancel")
+	if err != nil {
+		return
+	}
+
+	notPending := &errors.AppError{
+		Message: "only pending redemption orders can be cancelled",
+		Status:  http.StatusBadRequest,
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		applied, err := s.rewardRedemptionRepo.TransitionStatus(
+			ctx, redemption.ID,
+			entity.RewardRedemptionStatusPending, entity.RewardRedemptionStatusCancelled,
+			map[string]any{"cancelled_at": time.Now(), "cancel_reason": req.Reason},
+			tx,
+		)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			return notPending
+		}
+
+		// Refund what the ledger holds for the order rather than the order's points, in case they were adjusted
+		spent, err := s.pointMutationRepo.SumBySource(ctx, entity.PointMutationSourceTypeRewardRedemption, redemption.ID, tx)
+		if err != nil {
+			return err
+		}
+
+		if spent < 0 {
+			if _, err := s.followerRepo.IncrementPoints(ctx, redemption.FollowerID, -spent, tx); err != nil {
+				return err
+			}
+
+			pointMutation := entity.PointMutation{
+				FollowerID:  redemption.FollowerID,
+				Amount:      -spent,
+				SourceType:  entity.PointMutationSourceTypeRewardRedemption,
+				SourceID:    &redemption.ID,
+				Description: utility.PointOf("Reward redemption cancelled"),
+			}
+			if err := s.pointMutationRepo.Create(ctx, &pointMutation, tx); err != nil {
+				return err
+			}
+		}
+
+		return s.rewardRepo.IncrementStock(ctx, redemption.RewardID, redemption.Quantity, tx)
+	})
+	if err != nil {
+		return
+	}
+
+	return s.rewardRedemptionRepo.FindByID(ctx, redemption.ID, "Reward", "Follower")
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/reward_redemption/dto/request"
+)
+
+func TestCancel_RefundsPointsAndStockOnce(t *testing.T) {
+	f := newRedemptionFixture(t, 50, 5)
+
+	redemption, err := f.redeem(2)
+	require.NoError(t, err)
+
+	cancelled, err := f.service.Cancel(f.ctx, redemption.ID, request.RewardRedemptionCancelRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, entity.RewardRedemptionStatusCancelled, cancelled.Status)
+	assert.NotNil(t, cancelled.CancelledAt)
+
+	assertRedemptionState(t, f, 50, 5, 1, 2)
+
+	// A cancelled order can be neither cancelled again nor fulfilled
+	_, err = f.service.Cancel(f.ctx, redemption.ID, request.RewardRedemptionCancelRequest{})
+	assertBadRequest(t, err)
+	_, err = f.service.Fulfill(f.ctx, redemption.ID)
+	assertBadRequest(t, err)
+
+	assertRedemptionState(t, f, 50, 5, 1, 2)
+}
+
+func TestFulfill_FulfilledOrderCannotBeCancelled(t *testing.T) {
+	f := newRedemptionFixture(t, 50, 5)
+
+	redemption, err := f.redeem(1)
+	require.NoError(t, err)
+
+	fulfilled, err := f.service.Fulfill(f.ctx, redemption.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RewardRedemptionStatusFulfilled, fulfilled.Status)
+	assert.NotNil(t, fulfilled.FulfilledAt)
+
+	_, err = f.service.Fulfill(f.ctx, redemption.ID)
+	assertBadRequest(t, err)
+	_, err = f.service.Cancel(f.ctx, redemption.ID, request.RewardRedemptionCancelRequest{})
+	assertBadRequest(t, err)
+
+	assertRedemptionState(t, f, 40, 4, 1, 1)
+}
+
+func TestCancel_ConcurrentCancelAndFulfillActOnce(t *testing.T) {
+	const attempts = 8
+
+	f := newRedemptionFixture(t, 50, 5)
+
+	redemption, err := f.redeem(2)
+	require.NoError(t, err)
+
+	// Half of the requests cancel the order and half fulfil it; only the first to change its status may act
+	var wg sync.WaitGroup
+	errs := make([]error, attempts)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				_, errs[i] = f.service.Cancel(f.ctx, redemption.ID, request.RewardRedemptionCancelRequest{})
+				return
+			}
+			_, errs[i] = f.service.Fulfill(f.ctx, redemption.ID)
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assertBadRequest(t, err)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	var stored entity.RewardRedemption
+	require.NoError(t, f.db.First(&stored, redemption.ID).Error)
+	if stored.Status == entity.RewardRedemptionStatusCancelled {
+		assertRedemptionState(t, f, 50, 5, 1, 2)
+	} else {
+		assert.Equal(t, entity.RewardRedemptionStatusFulfilled, stored.Status)
+		assertRedemptionState(t, f, 30, 3, 1, 1)
+	}
+}
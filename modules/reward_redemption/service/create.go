@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/reward_redemption/dto/request"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Create places a redemption order, charging the follower the reward's current point cost per unit.
+// The stock and the follower's points are both taken off with conditional updates in one transaction,
+// so an order that either of them no longer covers is rolled back as a whole.
+func (s *service) Create(ctx context.Context, req request.RewardRedemptionCreateRequest) (redemption entity.RewardRedemption, err error) {
+	reward, err := s.rewardRepo.FindByID(ctx, req.RewardID)
+	if err != nil {
+		return
+	}
+
+	contextValues, err := utility.CheckDomainContext(ctx, reward.DomainID, "reward redemption", "create")
+	if err != nil {
+		return
+	}
+
+	follower, err := s.followerRepo.FindByID(ctx, req.FollowerID)
+	if err != nil {
+		return
+	}
+
+	if follower.DomainID != reward.DomainID {
+		return redemption, &errors.AppError{
+			Message: "follower and reward belong to different domains",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	if !reward.IsActive {
+		return redemption, &errors.AppError{
+			Message: "reward is not available for redemption",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	quantity := req.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	redemption = entity.RewardRedemption{
+		DomainID:   reward.DomainID,
+		RewardID:   reward.ID,
+		FollowerID: follower.ID,
+		Quantity:   quantity,
+		Points:     reward.PointCost * quantity,
+		Status:     entity.RewardRedemptionStatusPending,
+		Note:       req.Note,
+		CreatedBy:  contextValues.UserID,
+	}
+
+	outOfStock := &errors.AppError{
+		Message: "not enough of this reward left in stock",
+		Status:  http.StatusBadRequest,
+	}
+	insufficientPoints := &errors.AppError{
+		Message: "insufficient points",
+		Status:  http.StatusBadRequest,
+	}
+
+	err = s.transactionManager.ExecuteInTransaction(func(tx *gorm.DB) error {
+		applied, err := s.rewardRepo.DecrementStock(ctx, reward.ID, quantity, tx)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			return outOfStock
+		}
+
+		applied, err = s.followerRepo.IncrementPoints(ctx, follower.ID, -redemption.Points, tx)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			return insufficientPoints
+		}
+
+		if err := s.rewardRedemptionRepo.Create(ctx, &redemption, tx); err != nil {
+			return err
+		}
+
+		pointMutation := entity.PointMutation{
+			FollowerID:  follower.ID,
+			Amount:      -redemption.Points,
+			SourceType:  entity.PointMutationSourceTypeRewardRedemption,
+			SourceID:    &redemption.ID,
+			Description: utility.PointOf(fmt.Sprintf("Redeemed %d x %s", quantity, reward.Name)),
+		}
+		return s.pointMutationRepo.Create(ctx, &pointMutation, tx)
+	})
+	if err != nil {
+		return
+	}
+
+	return
+}
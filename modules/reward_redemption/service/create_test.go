@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	followerRepo "github.com/PhantomX7/dhamma/modules/follower/repository"
+	pointMutationRepo "github.com/PhantomX7/dhamma/modules/point_mutation/repository"
+	rewardRepo "github.com/PhantomX7/dhamma/modules/reward/repository"
+	"github.com/PhantomX7/dhamma/modules/reward_redemption"
+	"github.com/PhantomX7/dhamma/modules/reward_redemption/dto/request"
+	rewardRedemptionRepo "github.com/PhantomX7/dhamma/modules/reward_redemption/repository"
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/PhantomX7/dhamma/utility/testdb"
+)
+
+// redemptionFixture is a follower of a domain and a reward costing 10 points a unit.
+type redemptionFixture struct {
+	db       *gorm.DB
+	service  reward_redemption.Service
+	follower entity.Follower
+	reward   entity.Reward
+	ctx      context.Context
+}
+
+func newRedemptionFixture(t *testing.T, points int, stock int) redemptionFixture {
+	db := testdb.New(t, &entity.Domain{}, &entity.Follower{}, &entity.Reward{}, &entity.RewardRedemption{}, &entity.PointMutation{})
+	domain := testdb.Domain(t, db)
+	follower := testdb.Follower(t, db, domain.ID, testdb.WithPoints(points))
+
+	reward := entity.Reward{DomainID: domain.ID, Name: "Book", PointCost: 10, Stock: stock, IsActive: true}
+	require.NoError(t, db.Create(&reward).Error)
+
+	return redemptionFixture{
+		db: db,
+		service: New(
+			rewardRedemptionRepo.New(db),
+			rewardRepo.New(db),
+			followerRepo.New(db),
+			pointMutationRepo.New(db),
+			transaction_manager.New(db),
+		),
+		follower: follower,
+		reward:   reward,
+		ctx:      testdb.Context(domain),
+	}
+}
+
+func (f redemptionFixture) redeem(quantity int) (entity.RewardRedemption, error) {
+	return f.service.Create(f.ctx, request.RewardRedemptionCreateRequest{
+		RewardID:   f.reward.ID,
+		FollowerID: f.follower.ID,
+		Quantity:   quantity,
+	})
+}
+
+// assertRedemptionState checks the follower's points, the reward's stock, the orders placed and
+// the mutations recorded against them.
+func assertRedemptionState(t *testing.T, f redemptionFixture, points int, stock int, orders int64, mutations int64) {
+	t.Helper()
+
+	var follower entity.Follower
+	require.NoError(t, f.db.First(&follower, f.follower.ID).Error)
+	assert.Equal(t, points, follower.Points)
+
+	var reward entity.Reward
+	require.NoError(t, f.db.First(&reward, f.reward.ID).Error)
+	assert.Equal(t, stock, reward.Stock)
+
+	var count int64
+	require.NoError(t, f.db.Model(&entity.RewardRedemption{}).Count(&count).Error)
+	assert.Equal(t, orders, count)
+
+	require.NoError(t, f.db.Model(&entity.PointMutation{}).
+		Where("source_type = ?", entity.PointMutationSourceTypeRewardRedemption).
+		Count(&count).Error)
+	assert.Equal(t, mutations, count)
+}
+
+func assertBadRequest(t *testing.T, err error) {
+	t.Helper()
+
+	var appErr *errors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusBadRequest, appErr.Status)
+}
+
+func TestCreate_ChargesPointsAndTakesStock(t *testing.T) {
+	f := newRedemptionFixture(t, 50, 5)
+
+	redemption, err := f.redeem(3)
+	require.NoError(t, err)
+	assert.Equal(t, 30, redemption.Points)
+	assert.Equal(t, entity.RewardRedemptionStatusPending, redemption.Status)
+
+	assertRedemptionState(t, f, 20, 2, 1, 1)
+
+	var mutation entity.PointMutation
+	require.NoError(t, f.db.Where("source_id = ?", redemption.ID).First(&mutation).Error)
+	assert.Equal(t, -30, mutation.Amount)
+}
+
+func TestCreate_OutOfStockChangesNothing(t *testing.T) {
+	f := newRedemptionFixture(t, 50, 2)
+
+	_, err := f.redeem(3)
+	assertBadRequest(t, err)
+
+	assertRedemptionState(t, f, 50, 2, 0, 0)
+}
+
+func TestCreate_InsufficientPointsPutsStockBack(t *testing.T) {
+	f := newRedemptionFixture(t, 25, 5)
+
+	_, err := f.redeem(3)
+	assertBadRequest(t, err)
+
+	assertRedemptionState(t, f, 25, 5, 0, 0)
+}
+
+func TestCreate_InactiveRewardIsRefused(t *testing.T) {
+	f := newRedemptionFixture(t, 50, 5)
+	require.NoError(t, f.db.Model(&f.reward).Update("is_active", false).Error)
+
+	_, err := f.redeem(1)
+	assertBadRequest(t, err)
+
+	assertRedemptionState(t, f, 50, 5, 0, 0)
+}
+
+func TestCreate_ConcurrentOrdersStayWithinStockAndPoints(t *testing.T) {
+	tests := []struct {
+		name      string
+		points    int
+		stock     int
+		succeeded int
+	}{
+		{name: "stock runs out", points: 1000, stock: 3, succeeded: 3},
+		{name: "points run out", points: 20, stock: 10, succeeded: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const orders = 8
+
+			f := newRedemptionFixture(t, tt.points, tt.stock)
+
+			var wg sync.WaitGroup
+			errs := make([]error, orders)
+			for i := range errs {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					_, errs[i] = f.redeem(1)
+				}(i)
+			}
+			wg.Wait()
+
+			succeeded := 0
+			for _, err := range errs {
+				if err == nil {
+					succeeded++
+					continue
+				}
+				assertBadRequest(t, err)
+			}
+			assert.Equal(t, tt.succeeded, succeeded)
+
+			assertRedemptionState(t, f, tt.points-10*tt.succeeded, tt.stock-tt.succeeded, int64(tt.succeeded), int64(tt.succeeded))
+		})
+	}
+}
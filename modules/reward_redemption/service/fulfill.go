@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// Fulfill marks a pending redemption order as handed over to the follower.
+func (s *service) Fulfill(ctx context.Context, redemptionID uint64) (redemption entity.RewardRedemption, err error) {
+	redemption, err = s.rewardRedemptionRepo.FindByID(ctx, redemptionID)
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, redemption.DomainID, "reward redemption", "fulfill")
+	if err != nil {
+		return
+	}
+
+	applied, err := s.rewardRedemptionRepo.TransitionStatus(
+		ctx, redemption.ID,
+		entity.RewardRedemptionStatusPending, entity.RewardRedemptionStatusFulfilled,
+		map[string]any{"fulfilled_at": time.Now()},
+		nil,
+	)
+	if err != nil {
+		return
+	}
+	if !applied {
+		return redemption, &errors.AppError{
+			Message: "only pending redemption orders can be fulfilled",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	return s.rewardRedemptionRepo.FindByID(ctx, redemption.ID, "Reward", "Follower")
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"github.com/PhantomX7/dhamma/libs/transaction_manager"
+	"github.com/PhantomX7/dhamma/modules/follower"
+	"github.com/PhantomX7/dhamma/modules/point_mutation"
+	"github.com/PhantomX7/dhamma/modules/reward"
+	"github.com/PhantomX7/dhamma/modules/reward_redemption"
+)
+
+type service struct {
+	rewardRedemptionRepo reward_redemption.Repository
+	rewardRepo           reward.Repository
+	followerRepo         follower.Repository
+	pointMutationRepo    point_mutation.Repository
+	transactionManager   transaction_manager.Client
+}
+
+func New(
+	rewardRedemptionRepo reward_redemption.Repository,
+	rewardRepo reward.Repository,
+	followerRepo follower.Repository,
+	pointMutationRepo point_mutation.Repository,
+	transactionManager transaction_manager.Client,
+) reward_redemption.Service {
+	return &service{
+		rewardRedemptionRepo: rewardRedemptionRepo,
+		rewardRepo:           rewardRepo,
+		followerRepo:         followerRepo,
+		pointMutationRepo:    pointMutationRepo,
+		transactionManager:   transactionManager,
+	}
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// Show implements reward_redemption.Service
+func (s *service) Show(ctx context.Context, redemptionID uint64) (redemption entity.RewardRedemption, err error) {
+	redemption, err = s.rewardRedemptionRepo.FindByID(ctx, redemptionID, "Reward", "Follower")
+	if err != nil {
+		return
+	}
+
+	_, err = utility.CheckDomainContext(ctx, redemption.DomainID, "reward redemption", "show")
+	if err != nil {
+		return
+	}
+
+	return
+}
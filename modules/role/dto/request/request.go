@@ -7,12 +7,14 @@ type RoleCreateRequest struct {
 	Name        string `json:"name" form:"name" binding:"required"`
 	Description string `json:"description" form:"description"`
 	IsActive    *bool  `json:"is_active" form:"is_active" binding:"required"`
+	RequireMFA  *bool  `json:"require_mfa" form:"require_mfa"`
 }
 
 type RoleUpdateRequest struct {
 	Name        *string `json:"name" form:"name"`
 	Description *string `json:"description" form:"description"`
 	IsActive    *bool   `json:"is_active" form:"is_active"`
+	RequireMFA  *bool   `json:"require_mfa" form:"require_mfa"`
 }
 
 type RoleAddPermissionsRequest struct {
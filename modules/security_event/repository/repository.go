@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/security_event"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.SecurityEvent] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new security_event repository instance.
+func New(db *gorm.DB) security_event.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.SecurityEvent](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all security_event entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.SecurityEvent, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a security_event entity by its ID.
+func (r *repository) FindByID(ctx context.Context, securityEventID uint64, preloads ...string) (entity.SecurityEvent, error) {
+	return r.base.FindByID(ctx, securityEventID, preloads...)
+}
+
+// Create creates a new security_event entity.
+func (r *repository) Create(ctx context.Context, securityEvent *entity.SecurityEvent, tx *gorm.DB) error {
+	return r.base.Create(ctx, securityEvent, tx)
+}
+
+// Update updates an existing security_event entity.
+func (r *repository) Update(ctx context.Context, securityEvent *entity.SecurityEvent, tx *gorm.DB) error {
+	return r.base.Update(ctx, securityEvent, tx)
+}
+
+// Delete deletes a security_event entity.
+func (r *repository) Delete(ctx context.Context, securityEvent *entity.SecurityEvent, tx *gorm.DB) error {
+	return r.base.Delete(ctx, securityEvent, tx)
+}
+
+// Count counts security_event entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves security_event entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.SecurityEvent, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single security_event entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.SecurityEvent, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves security_event entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.SecurityEvent, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single security_event entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.SecurityEvent, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any security_event records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
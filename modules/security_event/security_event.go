@@ -0,0 +1,10 @@
+package security_event
+
+import (
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.SecurityEvent]
+}
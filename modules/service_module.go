@@ -4,14 +4,21 @@ import (
 	"go.uber.org/fx"
 
 	authService "github.com/PhantomX7/dhamma/modules/auth/service"
+	bloodDonationService "github.com/PhantomX7/dhamma/modules/blood_donation/service"
+	campaignService "github.com/PhantomX7/dhamma/modules/campaign/service"
 	chatTemplateService "github.com/PhantomX7/dhamma/modules/chat_template/service"
 	cronService "github.com/PhantomX7/dhamma/modules/cron/service"
 	domainService "github.com/PhantomX7/dhamma/modules/domain/service"
 	eventService "github.com/PhantomX7/dhamma/modules/event/service"
 	eventAttendanceService "github.com/PhantomX7/dhamma/modules/event_attendance/service"
 	followerService "github.com/PhantomX7/dhamma/modules/follower/service"
+	followerSegmentService "github.com/PhantomX7/dhamma/modules/follower_segment/service"
+	outboundMessageService "github.com/PhantomX7/dhamma/modules/outbound_message/service"
 	permissionService "github.com/PhantomX7/dhamma/modules/permission/service"
 	pointMutationService "github.com/PhantomX7/dhamma/modules/point_mutation/service"
+	portalService "github.com/PhantomX7/dhamma/modules/portal/service"
+	rewardService "github.com/PhantomX7/dhamma/modules/reward/service"
+	rewardRedemptionService "github.com/PhantomX7/dhamma/modules/reward_redemption/service"
 	roleService "github.com/PhantomX7/dhamma/modules/role/service"
 	userService "github.com/PhantomX7/dhamma/modules/user/service"
 )
@@ -19,14 +26,21 @@ import (
 var ServiceModule = fx.Options(
 	fx.Provide(
 		authService.New,
+		bloodDonationService.New,
+		campaignService.New,
 		chatTemplateService.New,
 		cronService.New,
 		domainService.New,
 		eventService.New,
 		eventAttendanceService.New,
 		followerService.New,
+		followerSegmentService.New,
+		outboundMessageService.New,
 		permissionService.New,
 		pointMutationService.New,
+		portalService.New,
+		rewardService.New,
+		rewardRedemptionService.New,
 		roleService.New,
 		userService.New,
 	),
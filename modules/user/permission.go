@@ -17,16 +17,25 @@ type permission struct {
 	RemoveRole string
 	// Force logout a user
 	ForceLogout string
+	// List the signed-in sessions of a user
+	Sessions string
+	// Sign one session of a user out
+	RevokeSession string
+	// Lift the sign-in lockout of a user
+	UnlockLogin string
 }
 
 // Permissions defines all permissions for the user module
 var Permissions = permission{
-	Key:         "user",
-	Index:       "index",
-	Show:        "show",
-	Create:      "create",
-	Update:      "update",
-	AssignRole:  "assign-role",
-	RemoveRole:  "remove-role",
-	ForceLogout: "force-logout",
+	Key:           "user",
+	Index:         "index",
+	Show:          "show",
+	Create:        "create",
+	Update:        "update",
+	AssignRole:    "assign-role",
+	RemoveRole:    "remove-role",
+	ForceLogout:   "force-logout",
+	Sessions:      "sessions",
+	RevokeSession: "revoke-session",
+	UnlockLogin:   "unlock-login",
 }
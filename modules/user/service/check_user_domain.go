@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// checkUserDomain makes sure a domain-scoped request only manages users of that domain.
+func (s *service) checkUserDomain(ctx context.Context, userID uint64) (contextValues utility.ContextValues, err error) {
+	contextValues, err = utility.ValuesFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	if contextValues.DomainID == nil {
+		return
+	}
+
+	validDomain, err := s.userDomainRepo.HasDomain(ctx, userID, *contextValues.DomainID)
+	if err != nil {
+		return
+	}
+
+	if !validDomain {
+		err = &errors.AppError{
+			Message: "forbidden",
+			Status:  http.StatusForbidden,
+		}
+	}
+	return
+}
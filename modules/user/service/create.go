@@ -12,6 +12,7 @@ import (
 
 	"github.com/PhantomX7/dhamma/entity"
 	"github.com/PhantomX7/dhamma/modules/user/dto/request"
+	"github.com/PhantomX7/dhamma/utility/passwordpolicy"
 )
 
 func (s *service) Create(ctx context.Context, request request.UserCreateRequest) (user entity.User, err error) {
@@ -29,6 +30,11 @@ func (s *service) Create(ctx context.Context, request request.UserCreateRequest)
 
 	_ = copier.Copy(&user, &request)
 
+	err = passwordpolicy.FromConfig().Validate(request.Password, request.Username)
+	if err != nil {
+		return
+	}
+
 	password, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
 	if err != nil {
 		err = errors.New("failed to hash password")
@@ -4,6 +4,16 @@ import (
 	"context"
 )
 
+// ForceLogout signs the user out of every session. Within a domain only the sessions bound to it are signed out.
 func (s *service) ForceLogout(ctx context.Context, userID uint64) (err error) {
+	contextValues, err := s.checkUserDomain(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	if contextValues.DomainID != nil {
+		return s.refreshTokenRepo.InvalidateAllByUserIDAndDomainID(ctx, userID, *contextValues.DomainID)
+	}
+
 	return s.refreshTokenRepo.InvalidateAllByUserID(ctx, userID)
 }
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// RevokeSession signs one session of the user out. Within a domain only sessions bound to it can be revoked.
+func (s *service) RevokeSession(ctx context.Context, userID uint64, sessionID string) (err error) {
+	contextValues, err := s.checkUserDomain(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	familyID, err := uuid.Parse(sessionID)
+	if err != nil {
+		return &errors.AppError{
+			Message: "invalid session id",
+			Status:  http.StatusBadRequest,
+		}
+	}
+
+	revoked, err := s.refreshTokenRepo.InvalidateFamily(ctx, userID, familyID, contextValues.DomainID)
+	if err != nil {
+		return
+	}
+
+	if !revoked {
+		return &errors.AppError{
+			Message: "session not found",
+			Status:  http.StatusNotFound,
+		}
+	}
+
+	return
+}
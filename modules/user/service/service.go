@@ -4,8 +4,10 @@ import (
 	"github.com/PhantomX7/dhamma/libs/casbin"
 	"github.com/PhantomX7/dhamma/libs/transaction_manager"
 	"github.com/PhantomX7/dhamma/modules/domain"
+	"github.com/PhantomX7/dhamma/modules/login_throttle"
 	"github.com/PhantomX7/dhamma/modules/refresh_token"
 	"github.com/PhantomX7/dhamma/modules/role"
+	"github.com/PhantomX7/dhamma/modules/security_event"
 	"github.com/PhantomX7/dhamma/modules/user"
 	"github.com/PhantomX7/dhamma/modules/user_domain"
 	"github.com/PhantomX7/dhamma/modules/user_role"
@@ -18,6 +20,8 @@ type service struct {
 	userRoleRepo       user_role.Repository
 	domainRepo         domain.Repository
 	refreshTokenRepo   refresh_token.Repository
+	loginThrottleRepo  login_throttle.Repository
+	securityEventRepo  security_event.Repository
 	transactionManager transaction_manager.Client
 	casbin             casbin.Client
 }
@@ -29,6 +33,8 @@ func New(
 	userRoleRepo user_role.Repository,
 	domainRepo domain.Repository,
 	refreshTokenRepo refresh_token.Repository,
+	loginThrottleRepo login_throttle.Repository,
+	securityEventRepo security_event.Repository,
 	transactionManager transaction_manager.Client,
 	casbin casbin.Client,
 ) user.Service {
@@ -39,6 +45,8 @@ func New(
 		userRoleRepo:       userRoleRepo,
 		domainRepo:         domainRepo,
 		refreshTokenRepo:   refreshTokenRepo,
+		loginThrottleRepo:  loginThrottleRepo,
+		securityEventRepo:  securityEventRepo,
 		transactionManager: transactionManager,
 		casbin:             casbin,
 	}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	sessionResponse "github.com/PhantomX7/dhamma/modules/refresh_token/dto/response"
+)
+
+// Sessions lists the user's active sessions. Within a domain only the sessions bound to it are listed.
+func (s *service) Sessions(ctx context.Context, userID uint64) (sessions []sessionResponse.Session, err error) {
+	contextValues, err := s.checkUserDomain(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	refreshTokens, err := s.refreshTokenRepo.FindActiveByUserID(ctx, userID, contextValues.DomainID)
+	if err != nil {
+		return
+	}
+
+	return sessionResponse.NewSessions(refreshTokens, ""), nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+	"github.com/PhantomX7/dhamma/utility/logger"
+)
+
+// UnlockLogin lifts the sign-in backoff or lockout of the user's username and records it in the audit log.
+// Lockouts of IP addresses are left to expire on their own.
+func (s *service) UnlockLogin(ctx context.Context, userID uint64) (err error) {
+	contextValues, err := s.checkUserDomain(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	cleared, err := s.loginThrottleRepo.Clear(ctx, entity.LoginThrottleScopeUsername, user.Username)
+	if err != nil || !cleared {
+		return
+	}
+
+	securityEvent := entity.SecurityEvent{
+		Type:     entity.SecurityEventTypeLoginUnlock,
+		UserID:   &user.ID,
+		DomainID: contextValues.DomainID,
+		Detail:   utility.PointOf(fmt.Sprintf("sign-in failures of %q cleared by user %d", user.Username, contextValues.UserID)),
+	}
+	if err = s.securityEventRepo.Create(ctx, &securityEvent, nil); err != nil {
+		// The user is already unlocked, which is what the admin asked for
+		logger.FromCtx(ctx).Error("failed to record security event", zap.Error(err))
+		err = nil
+	}
+
+	return
+}
@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 
+	sessionResponse "github.com/PhantomX7/dhamma/modules/refresh_token/dto/response"
 	"github.com/PhantomX7/dhamma/modules/user/dto/request"
 
 	"github.com/gin-gonic/gin"
@@ -25,6 +26,10 @@ type Service interface {
 	AssignRole(ctx context.Context, userID uint64, request request.AssignRoleRequest) error
 	RemoveDomain(ctx context.Context, userID uint64, request request.RemoveDomainRequest) error
 	RemoveRole(ctx context.Context, userID uint64, request request.RemoveRoleRequest) error
+	Sessions(ctx context.Context, userID uint64) ([]sessionResponse.Session, error)
+	RevokeSession(ctx context.Context, userID uint64, sessionID string) error
+	ForceLogout(ctx context.Context, userID uint64) error
+	UnlockLogin(ctx context.Context, userID uint64) error
 }
 
 // Update the Controller interface to include RemoveDomain and RemoveRole
@@ -36,4 +41,8 @@ type Controller interface {
 	AssignRole(ctx *gin.Context)
 	RemoveDomain(ctx *gin.Context)
 	RemoveRole(ctx *gin.Context)
+	Sessions(ctx *gin.Context)
+	RevokeSession(ctx *gin.Context)
+	ForceLogout(ctx *gin.Context)
+	UnlockLogin(ctx *gin.Context)
 }
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Enable marks a pending authenticator as confirmed and reports false if it already was.
+func (r *repository) Enable(ctx context.Context, userMFAID uint64, at time.Time, tx *gorm.DB) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&entity.UserMFA{}).
+		Where("id = ? AND enabled_at IS NULL", userMFAID).
+		UpdateColumn("enabled_at", at)
+
+	return result.RowsAffected == 1, result.Error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// FindByUserID returns the authenticator of a user and reports false if there is none.
+func (r *repository) FindByUserID(ctx context.Context, userID uint64) (entity.UserMFA, bool, error) {
+	var userMFA entity.UserMFA
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Take(&userMFA).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return userMFA, false, nil
+	}
+	if err != nil {
+		return userMFA, false, err
+	}
+
+	return userMFA, true, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/user_mfa"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.UserMFA] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new user_mfa repository instance.
+func New(db *gorm.DB) user_mfa.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.UserMFA](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all user_mfa entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.UserMFA, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a user_mfa entity by its ID.
+func (r *repository) FindByID(ctx context.Context, userMFAID uint64, preloads ...string) (entity.UserMFA, error) {
+	return r.base.FindByID(ctx, userMFAID, preloads...)
+}
+
+// Create creates a new user_mfa entity.
+func (r *repository) Create(ctx context.Context, userMFA *entity.UserMFA, tx *gorm.DB) error {
+	return r.base.Create(ctx, userMFA, tx)
+}
+
+// Update updates an existing user_mfa entity.
+func (r *repository) Update(ctx context.Context, userMFA *entity.UserMFA, tx *gorm.DB) error {
+	return r.base.Update(ctx, userMFA, tx)
+}
+
+// Delete deletes a user_mfa entity.
+func (r *repository) Delete(ctx context.Context, userMFA *entity.UserMFA, tx *gorm.DB) error {
+	return r.base.Delete(ctx, userMFA, tx)
+}
+
+// Count counts user_mfa entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves user_mfa entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.UserMFA, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single user_mfa entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.UserMFA, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves user_mfa entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.UserMFA, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single user_mfa entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.UserMFA, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any user_mfa records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// UseStep records an accepted code and reports false if a code of the same or a later step was already used.
+// The check and the update are one statement, so a code raced through two requests only signs in once.
+func (r *repository) UseStep(ctx context.Context, userMFAID uint64, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.UserMFA{}).
+		Where("id = ? AND last_used_step < ?", userMFAID, step).
+		UpdateColumn("last_used_step", step)
+
+	return result.RowsAffected == 1, result.Error
+}
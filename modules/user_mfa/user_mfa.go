@@ -0,0 +1,23 @@
+package user_mfa
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.UserMFA]
+	// FindByUserID returns the authenticator of a user and reports false if there is none.
+	FindByUserID(ctx context.Context, userID uint64) (entity.UserMFA, bool, error)
+	// UseStep records an accepted code and reports false if a code of the same or a later step was already used.
+	UseStep(ctx context.Context, userMFAID uint64, step int64) (bool, error)
+	// Enable marks a pending authenticator as confirmed and reports false if it already was.
+	Enable(ctx context.Context, userMFAID uint64, at time.Time, tx *gorm.DB) (bool, error)
+	// DeleteByUserID removes the authenticator of a user.
+	DeleteByUserID(ctx context.Context, userID uint64, tx *gorm.DB) error
+}
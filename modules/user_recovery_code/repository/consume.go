@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// Consume marks an unused code of the user as used and reports false if there is none with the hash.
+func (r *repository) Consume(ctx context.Context, userID uint64, codeHash string, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.UserRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		UpdateColumn("used_at", at)
+
+	return result.RowsAffected > 0, result.Error
+}
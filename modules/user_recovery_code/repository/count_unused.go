@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// CountUnused counts the codes the user has left.
+func (r *repository) CountUnused(ctx context.Context, userID uint64) (count int64, err error) {
+	err = r.db.WithContext(ctx).
+		Model(&entity.UserRecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+)
+
+// DeleteByUserID removes all codes of a user.
+func (r *repository) DeleteByUserID(ctx context.Context, userID uint64, tx *gorm.DB) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	return db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&entity.UserRecoveryCode{}).Error
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/modules/user_recovery_code"
+	"github.com/PhantomX7/dhamma/utility/pagination"
+	baseRepo "github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type repository struct {
+	base baseRepo.BaseRepositoryInterface[entity.UserRecoveryCode] // Use the interface type
+	db   *gorm.DB
+}
+
+// New creates a new user_recovery_code repository instance.
+func New(db *gorm.DB) user_recovery_code.Repository {
+	return &repository{
+		base: baseRepo.NewBaseRepository[entity.UserRecoveryCode](db), // Instantiate the concrete base repository
+		db:   db,
+	}
+}
+
+// FindAll retrieves all user_recovery_code entities with pagination.
+func (r *repository) FindAll(ctx context.Context, pg *pagination.Pagination) ([]entity.UserRecoveryCode, error) {
+	return r.base.FindAll(ctx, pg)
+}
+
+// FindByID retrieves a user_recovery_code entity by its ID.
+func (r *repository) FindByID(ctx context.Context, userRecoveryCodeID uint64, preloads ...string) (entity.UserRecoveryCode, error) {
+	return r.base.FindByID(ctx, userRecoveryCodeID, preloads...)
+}
+
+// Create creates a new user_recovery_code entity.
+func (r *repository) Create(ctx context.Context, userRecoveryCode *entity.UserRecoveryCode, tx *gorm.DB) error {
+	return r.base.Create(ctx, userRecoveryCode, tx)
+}
+
+// Update updates an existing user_recovery_code entity.
+func (r *repository) Update(ctx context.Context, userRecoveryCode *entity.UserRecoveryCode, tx *gorm.DB) error {
+	return r.base.Update(ctx, userRecoveryCode, tx)
+}
+
+// Delete deletes a user_recovery_code entity.
+func (r *repository) Delete(ctx context.Context, userRecoveryCode *entity.UserRecoveryCode, tx *gorm.DB) error {
+	return r.base.Delete(ctx, userRecoveryCode, tx)
+}
+
+// Count counts user_recovery_code entities matching pagination filters.
+func (r *repository) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
+	return r.base.Count(ctx, pg)
+}
+
+// FindByField retrieves user_recovery_code entities where a specific field matches the given value.
+func (r *repository) FindByField(ctx context.Context, fieldName string, value any, preloads ...string) ([]entity.UserRecoveryCode, error) {
+	return r.base.FindByField(ctx, fieldName, value, preloads...)
+}
+
+// FindOneByField retrieves a single user_recovery_code entity where a specific field matches the given value.
+func (r *repository) FindOneByField(ctx context.Context, fieldName string, value any, preloads ...string) (entity.UserRecoveryCode, error) {
+	return r.base.FindOneByField(ctx, fieldName, value, preloads...)
+}
+
+// FindByFields retrieves user_recovery_code entities matching multiple field conditions.
+func (r *repository) FindByFields(ctx context.Context, conditions map[string]any, preloads ...string) ([]entity.UserRecoveryCode, error) {
+	return r.base.FindByFields(ctx, conditions, preloads...)
+}
+
+// FindOneByFields retrieves a single user_recovery_code entity matching multiple field conditions.
+func (r *repository) FindOneByFields(ctx context.Context, conditions map[string]any, preloads ...string) (entity.UserRecoveryCode, error) {
+	return r.base.FindOneByFields(ctx, conditions, preloads...)
+}
+
+// Exists checks if any user_recovery_code records match the given conditions.
+func (r *repository) Exists(ctx context.Context, conditions map[string]any) (bool, error) {
+	return r.base.Exists(ctx, conditions)
+}
@@ -0,0 +1,21 @@
+package user_recovery_code
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/repository"
+)
+
+type Repository interface {
+	repository.BaseRepositoryInterface[entity.UserRecoveryCode]
+	// Consume marks an unused code of the user as used and reports false if there is none with the hash.
+	Consume(ctx context.Context, userID uint64, codeHash string, at time.Time) (bool, error)
+	// CountUnused counts the codes the user has left.
+	CountUnused(ctx context.Context, userID uint64) (int64, error)
+	// DeleteByUserID removes all codes of a user.
+	DeleteByUserID(ctx context.Context, userID uint64, tx *gorm.DB) error
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// RequiresMFA reports whether the user holds an active role of the domain that requires an authenticator.
+func (r *repository) RequiresMFA(ctx context.Context, userID, domainID uint64) (bool, error) {
+	var count int64
+	err := r.prepareDB(ctx, nil).
+		Model(&entity.UserRole{}).
+		Joins("JOIN roles ON roles.id = user_roles.role_id").
+		Where("user_roles.user_id = ? AND user_roles.domain_id = ?", userID, domainID).
+		Where("roles.require_mfa = ? AND roles.is_active = ?", true, true).
+		Count(&count).Error
+	if err != nil {
+		return false, errors.WrapError(errors.ErrDatabase, "error checking roles requiring two-factor authentication")
+	}
+
+	return count > 0, nil
+}
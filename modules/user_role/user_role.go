@@ -17,6 +17,8 @@ type Repository interface {
 	FindByUserID(ctx context.Context, userID uint64, preloads ...string) ([]entity.UserRole, error)
 	FindByUserIDAndDomainID(ctx context.Context, userID uint64, domainID uint64, preloads ...string) ([]entity.UserRole, error)
 	HasRole(ctx context.Context, userID, roleID uint64) (bool, error)
+	// RequiresMFA reports whether the user holds an active role of the domain that requires an authenticator.
+	RequiresMFA(ctx context.Context, userID, domainID uint64) (bool, error)
 	RemoveRole(ctx context.Context, userID, roleID uint64, tx *gorm.DB) error
 	RemoveRolesByUserAndDomainID(ctx context.Context, userID, domainID uint64, tx *gorm.DB) error
 }
@@ -12,10 +12,22 @@ func AuthRoute(route *gin.Engine, middleware *middleware.Middleware, authControl
 		routes.POST("/signin", authController.SignIn)
 		//routes.POST("/signup", authController.SignUp)
 		routes.POST("/refresh", authController.Refresh)
+		// The second step of a sign-in, authorized by its challenge token
+		routes.POST("/mfa/verify", authController.VerifyMFA)
+		routes.POST("/mfa/challenge/setup", authController.SetupMFAChallenge)
+		routes.POST("/password/forgot", authController.ForgotPassword)
+		routes.POST("/password/reset", authController.ResetPassword)
 		authenticated := routes.Use(middleware.AuthHandle(), middleware.IsRoot())
 		{
 			authenticated.GET("/me", authController.GetMe)
 			authenticated.PATCH("/password", authController.UpdatePassword)
+			authenticated.GET("/sessions", authController.Sessions)
+			authenticated.DELETE("/sessions/:session_id", authController.RevokeSession)
+			authenticated.GET("/mfa", authController.MFAStatus)
+			authenticated.POST("/mfa/setup", authController.SetupMFA)
+			authenticated.POST("/mfa/enable", authController.EnableMFA)
+			authenticated.POST("/mfa/disable", authController.DisableMFA)
+			authenticated.POST("/mfa/recovery-codes", authController.RegenerateRecoveryCodes)
 		}
 	}
 }
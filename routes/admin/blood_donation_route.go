@@ -0,0 +1,19 @@
+package admin
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/blood_donation"
+	"github.com/gin-gonic/gin"
+)
+
+// BloodDonationRoute defines admin routes for blood donation tracking
+func BloodDonationRoute(route *gin.Engine, middleware *middleware.Middleware, bloodDonationController blood_donation.Controller) {
+	routes := route.Group("api/blood-donation", middleware.AuthHandle(), middleware.IsRoot())
+	{
+		routes.GET("", bloodDonationController.Index)
+		routes.GET("/eligible", bloodDonationController.Eligible)
+		routes.GET("/:id", bloodDonationController.Show)
+		routes.POST("", bloodDonationController.Create)
+		routes.DELETE("/:id", bloodDonationController.Delete)
+	}
+}
@@ -0,0 +1,19 @@
+package admin
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/campaign"
+	"github.com/gin-gonic/gin"
+)
+
+// CampaignRoute defines admin routes for broadcast campaign management
+func CampaignRoute(route *gin.Engine, middleware *middleware.Middleware, campaignController campaign.Controller) {
+	routes := route.Group("api/campaign", middleware.AuthHandle(), middleware.IsRoot())
+	{
+		routes.GET("", campaignController.Index)
+		routes.GET("/:id", campaignController.Show)
+		routes.POST("", campaignController.Create)
+		routes.PATCH("/:id", campaignController.Update)
+		routes.POST("/:id/cancel", campaignController.Cancel)
+	}
+}
@@ -15,6 +15,10 @@ func ChatTemplateRoute(route *gin.Engine, middleware *middleware.Middleware, cha
 		routes.POST("", chatTemplateController.Create)
 		routes.PATCH("/:id", chatTemplateController.Update)
 		routes.POST("/:id/set-default", chatTemplateController.SetAsDefault)
+		routes.POST("/:id/render", chatTemplateController.Render)
+		routes.GET("/:id/versions", chatTemplateController.Versions)
+		routes.GET("/:id/versions/diff", chatTemplateController.DiffVersions)
+		routes.POST("/:id/versions/:version/restore", chatTemplateController.RestoreVersion)
 		routes.GET("/domain/:domain_id/default", chatTemplateController.GetDefaultByDomain)
 	}
 }
@@ -10,6 +10,9 @@ func EventAttendanceRoute(route *gin.Engine, middleware *middleware.Middleware,
 	routes := route.Group("api/event-attendance", middleware.AuthHandle(), middleware.IsRoot())
 	{
 		routes.GET("", eventAttendanceController.Index)
+		routes.GET("/report", eventAttendanceController.Report)
+		routes.GET("/report/inactive", eventAttendanceController.Inactive)
 		routes.GET("/:id", eventAttendanceController.Show)
+		routes.POST("/:id/void", eventAttendanceController.Void)
 	}
 }
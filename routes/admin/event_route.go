@@ -11,9 +11,13 @@ func EventRoute(route *gin.Engine, middleware *middleware.Middleware, eventContr
 	{
 		routes.GET("", eventController.Index)
 		routes.GET("/:id", eventController.Show)
+		routes.GET("/:id/occurrences", eventController.Occurrences)
 		routes.POST("", eventController.Create)
 		routes.PATCH("/:id", eventController.Update)
 		routes.POST("/:id/attend", eventController.Attend)
+		routes.POST("/:id/attend-scan", eventController.AttendScan)
 		routes.POST("/:id/attend-by-id", eventController.AttendById)
+		routes.POST("/:id/attend-bulk", eventController.AttendBulk)
+		routes.GET("/:id/check-in-code", eventController.CheckInCode)
 	}
 }
@@ -10,10 +10,19 @@ func FollowerRoute(route *gin.Engine, middleware *middleware.Middleware, followe
 	routes := route.Group("api/follower", middleware.AuthHandle(), middleware.IsRoot())
 	{
 		routes.GET("", followerController.Index)
+		routes.GET("/duplicates", followerController.FindDuplicates)
+		routes.GET("/export", followerController.Export)
 		routes.GET("/:id", followerController.Show)
+		routes.GET("/:id/card-sheet", followerController.CardSheet)
 		routes.POST("", followerController.Create)
+		routes.POST("/import", followerController.Import)
+		routes.POST("/card-sheet", followerController.BatchCardSheet)
 		routes.PATCH("/:id", followerController.Update)
 		routes.POST("/:id/card", followerController.AddCard)
 		routes.DELETE("/:id/card/:card_id", followerController.DeleteCard)
+		routes.PATCH("/:id/card/:card_id/status", followerController.UpdateCardStatus)
+		routes.POST("/:id/card/:card_id/replace", followerController.ReplaceCard)
+		routes.POST("/:id/merge", followerController.Merge)
+		routes.GET("/:id/merges", followerController.Merges)
 	}
 }
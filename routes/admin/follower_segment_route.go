@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/follower_segment"
+	"github.com/gin-gonic/gin"
+)
+
+// FollowerSegmentRoute defines admin routes for follower segment management
+func FollowerSegmentRoute(route *gin.Engine, middleware *middleware.Middleware, followerSegmentController follower_segment.Controller) {
+	routes := route.Group("api/follower-segment", middleware.AuthHandle(), middleware.IsRoot())
+	{
+		routes.GET("", followerSegmentController.Index)
+		routes.GET("/:id", followerSegmentController.Show)
+		routes.POST("", followerSegmentController.Create)
+		routes.PATCH("/:id", followerSegmentController.Update)
+		routes.DELETE("/:id", followerSegmentController.Delete)
+		routes.GET("/:id/followers", followerSegmentController.Followers)
+	}
+}
@@ -0,0 +1,17 @@
+package admin
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/outbound_message"
+	"github.com/gin-gonic/gin"
+)
+
+func OutboundMessageRoute(route *gin.Engine, middleware *middleware.Middleware, outboundMessageController outbound_message.Controller) {
+	routes := route.Group("api/outbound-message", middleware.AuthHandle(), middleware.IsRoot())
+	{
+		routes.GET("", outboundMessageController.Index)
+		routes.GET("/:id", outboundMessageController.Show)
+		routes.POST("/broadcast", outboundMessageController.Broadcast)
+		routes.POST("/:id/retry", outboundMessageController.Retry)
+	}
+}
@@ -11,5 +11,8 @@ func PointMutationRoute(route *gin.Engine, middleware *middleware.Middleware, po
 	{
 		routes.GET("", pointMutationController.Index)
 		routes.GET("/:id", pointMutationController.Show)
+		routes.POST("", pointMutationController.Create)
+		routes.POST("/redeem", pointMutationController.Redeem)
+		routes.POST("/reconcile", pointMutationController.Reconcile)
 	}
 }
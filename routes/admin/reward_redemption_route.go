@@ -0,0 +1,19 @@
+package admin
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/reward_redemption"
+	"github.com/gin-gonic/gin"
+)
+
+// RewardRedemptionRoute defines admin routes for reward redemption orders
+func RewardRedemptionRoute(route *gin.Engine, middleware *middleware.Middleware, rewardRedemptionController reward_redemption.Controller) {
+	routes := route.Group("api/reward-redemption", middleware.AuthHandle(), middleware.IsRoot())
+	{
+		routes.GET("", rewardRedemptionController.Index)
+		routes.GET("/:id", rewardRedemptionController.Show)
+		routes.POST("", rewardRedemptionController.Create)
+		routes.POST("/:id/fulfill", rewardRedemptionController.Fulfill)
+		routes.POST("/:id/cancel", rewardRedemptionController.Cancel)
+	}
+}
@@ -0,0 +1,18 @@
+package admin
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/reward"
+	"github.com/gin-gonic/gin"
+)
+
+// RewardRoute defines admin routes for the rewards catalogue
+func RewardRoute(route *gin.Engine, middleware *middleware.Middleware, rewardController reward.Controller) {
+	routes := route.Group("api/reward", middleware.AuthHandle(), middleware.IsRoot())
+	{
+		routes.GET("", rewardController.Index)
+		routes.GET("/:id", rewardController.Show)
+		routes.POST("", rewardController.Create)
+		routes.PATCH("/:id", rewardController.Update)
+	}
+}
@@ -16,5 +16,9 @@ func UserRoute(route *gin.Engine, middleware *middleware.Middleware, userControl
 		routes.POST("/:id/assign-role", userController.AssignRole)
 		routes.POST("/:id/remove-domain", userController.RemoveDomain)
 		routes.POST("/:id/remove-role", userController.RemoveRole)
+		routes.GET("/:id/sessions", userController.Sessions)
+		routes.DELETE("/:id/sessions/:session_id", userController.RevokeSession)
+		routes.POST("/:id/force-logout", userController.ForceLogout)
+		routes.POST("/:id/unlock-login", userController.UnlockLogin)
 	}
 }
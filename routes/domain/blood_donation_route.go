@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/blood_donation"
+	"github.com/gin-gonic/gin"
+)
+
+// BloodDonationRoute defines domain-specific routes for blood donation tracking
+func BloodDonationRoute(route *gin.Engine, middleware *middleware.Middleware, bloodDonationController blood_donation.Controller) {
+	routes := route.Group(":domain_code/blood-donation", middleware.AuthHandle(), middleware.ValidateDomain())
+	{
+		routes.GET("", middleware.Permission(blood_donation.Permissions.Key, blood_donation.Permissions.Index), bloodDonationController.Index)
+		routes.GET("/eligible", middleware.Permission(blood_donation.Permissions.Key, blood_donation.Permissions.Eligible), bloodDonationController.Eligible)
+		routes.GET("/:id", middleware.Permission(blood_donation.Permissions.Key, blood_donation.Permissions.Show), bloodDonationController.Show)
+		routes.POST("", middleware.Permission(blood_donation.Permissions.Key, blood_donation.Permissions.Create), bloodDonationController.Create)
+		routes.DELETE("/:id", middleware.Permission(blood_donation.Permissions.Key, blood_donation.Permissions.Delete), bloodDonationController.Delete)
+	}
+}
@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/campaign"
+	"github.com/gin-gonic/gin"
+)
+
+// CampaignRoute defines domain-specific routes for broadcast campaign management
+func CampaignRoute(route *gin.Engine, middleware *middleware.Middleware, campaignController campaign.Controller) {
+	routes := route.Group(":domain_code/campaign", middleware.AuthHandle(), middleware.ValidateDomain())
+	{
+		routes.GET("", middleware.Permission(campaign.Permissions.Key, campaign.Permissions.Index), campaignController.Index)
+		routes.GET("/:id", middleware.Permission(campaign.Permissions.Key, campaign.Permissions.Show), campaignController.Show)
+		routes.POST("", middleware.Permission(campaign.Permissions.Key, campaign.Permissions.Create), campaignController.Create)
+		routes.PATCH("/:id", middleware.Permission(campaign.Permissions.Key, campaign.Permissions.Update), campaignController.Update)
+		routes.POST("/:id/cancel", middleware.Permission(campaign.Permissions.Key, campaign.Permissions.Cancel), campaignController.Cancel)
+	}
+}
@@ -15,6 +15,10 @@ func ChatTemplateRoute(route *gin.Engine, middleware *middleware.Middleware, cha
 		routes.POST("", middleware.Permission(chat_template.Permissions.Key, chat_template.Permissions.Create), chatTemplateController.Create)
 		routes.PATCH("/:id", middleware.Permission(chat_template.Permissions.Key, chat_template.Permissions.Update), chatTemplateController.Update)
 		routes.POST("/:id/set-default", middleware.Permission(chat_template.Permissions.Key, chat_template.Permissions.SetAsDefault), chatTemplateController.SetAsDefault)
+		routes.POST("/:id/render", middleware.Permission(chat_template.Permissions.Key, chat_template.Permissions.Render), chatTemplateController.Render)
+		routes.GET("/:id/versions", middleware.Permission(chat_template.Permissions.Key, chat_template.Permissions.Versions), chatTemplateController.Versions)
+		routes.GET("/:id/versions/diff", middleware.Permission(chat_template.Permissions.Key, chat_template.Permissions.Versions), chatTemplateController.DiffVersions)
+		routes.POST("/:id/versions/:version/restore", middleware.Permission(chat_template.Permissions.Key, chat_template.Permissions.RestoreVersion), chatTemplateController.RestoreVersion)
 		routes.GET("/domain/:domain_id/default", middleware.Permission(chat_template.Permissions.Key, chat_template.Permissions.GetDefault), chatTemplateController.GetDefaultByDomain)
 	}
 }
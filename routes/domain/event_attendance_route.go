@@ -11,6 +11,9 @@ func EventAttendanceRoute(route *gin.Engine, middleware *middleware.Middleware,
 
 	{
 		routes.GET("", middleware.Permission(event_attendance.Permissions.Key, event_attendance.Permissions.Index), eventAttendanceController.Index)
+		routes.GET("/report", middleware.Permission(event_attendance.Permissions.Key, event_attendance.Permissions.Report), eventAttendanceController.Report)
+		routes.GET("/report/inactive", middleware.Permission(event_attendance.Permissions.Key, event_attendance.Permissions.Report), eventAttendanceController.Inactive)
 		routes.GET("/:id", middleware.Permission(event_attendance.Permissions.Key, event_attendance.Permissions.Show), eventAttendanceController.Show)
+		routes.POST("/:id/void", middleware.Permission(event_attendance.Permissions.Key, event_attendance.Permissions.Void), eventAttendanceController.Void)
 	}
 }
@@ -11,8 +11,15 @@ func EventRoute(route *gin.Engine, middleware *middleware.Middleware, eventContr
 	{
 		routes.GET("", middleware.Permission(event.Permissions.Key, event.Permissions.Index), eventController.Index)
 		routes.GET("/:id", middleware.Permission(event.Permissions.Key, event.Permissions.Show), eventController.Show)
+		routes.GET("/:id/occurrences", middleware.Permission(event.Permissions.Key, event.Permissions.Show), eventController.Occurrences)
 		routes.POST("", middleware.Permission(event.Permissions.Key, event.Permissions.Create), eventController.Create)
 		routes.PATCH("/:id", middleware.Permission(event.Permissions.Key, event.Permissions.Update), eventController.Update)
 		routes.POST("/:id/attend", middleware.Permission(event.Permissions.Key, event.Permissions.Attend), eventController.Attend)
+		routes.POST("/:id/attend-scan", middleware.Permission(event.Permissions.Key, event.Permissions.Attend), eventController.AttendScan)
+		routes.POST("/:id/attend-bulk", middleware.Permission(event.Permissions.Key, event.Permissions.AttendBulk), eventController.AttendBulk)
+		routes.GET("/:id/check-in-code", middleware.Permission(event.Permissions.Key, event.Permissions.CheckInCode), eventController.CheckInCode)
 	}
+
+	// Followers check themselves in with the code shown at the event, so this route has no sign-in
+	route.POST(":domain_code/event/:id/self-check-in", eventController.SelfCheckIn)
 }
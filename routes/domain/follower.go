@@ -10,10 +10,19 @@ func FollowerRoute(route *gin.Engine, middleware *middleware.Middleware, followe
 	routes := route.Group(":domain_code/follower", middleware.AuthHandle(), middleware.ValidateDomain())
 	{
 		routes.GET("", middleware.Permission(follower.Permissions.Key, follower.Permissions.Index), followerController.Index)
+		routes.GET("/duplicates", middleware.Permission(follower.Permissions.Key, follower.Permissions.FindDuplicates), followerController.FindDuplicates)
+		routes.GET("/export", middleware.Permission(follower.Permissions.Key, follower.Permissions.Export), followerController.Export)
 		routes.GET("/:id", middleware.Permission(follower.Permissions.Key, follower.Permissions.Show), followerController.Show)
+		routes.GET("/:id/card-sheet", middleware.Permission(follower.Permissions.Key, follower.Permissions.PrintCards), followerController.CardSheet)
 		routes.POST("", middleware.Permission(follower.Permissions.Key, follower.Permissions.Create), followerController.Create)
+		routes.POST("/import", middleware.Permission(follower.Permissions.Key, follower.Permissions.Import), followerController.Import)
+		routes.POST("/card-sheet", middleware.Permission(follower.Permissions.Key, follower.Permissions.PrintCards), followerController.BatchCardSheet)
 		routes.PATCH("/:id", middleware.Permission(follower.Permissions.Key, follower.Permissions.Update), followerController.Update)
 		routes.POST("/:id/card", middleware.Permission(follower.Permissions.Key, follower.Permissions.AddCard), followerController.AddCard)
 		routes.DELETE("/:id/card/:card_id", middleware.Permission(follower.Permissions.Key, follower.Permissions.DeleteCard), followerController.DeleteCard)
+		routes.PATCH("/:id/card/:card_id/status", middleware.Permission(follower.Permissions.Key, follower.Permissions.UpdateCardStatus), followerController.UpdateCardStatus)
+		routes.POST("/:id/card/:card_id/replace", middleware.Permission(follower.Permissions.Key, follower.Permissions.ReplaceCard), followerController.ReplaceCard)
+		routes.POST("/:id/merge", middleware.Permission(follower.Permissions.Key, follower.Permissions.Merge), followerController.Merge)
+		routes.GET("/:id/merges", middleware.Permission(follower.Permissions.Key, follower.Permissions.Show), followerController.Merges)
 	}
 }
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/follower_segment"
+	"github.com/gin-gonic/gin"
+)
+
+// FollowerSegmentRoute defines domain-specific routes for follower segment management
+func FollowerSegmentRoute(route *gin.Engine, middleware *middleware.Middleware, followerSegmentController follower_segment.Controller) {
+	routes := route.Group(":domain_code/follower-segment", middleware.AuthHandle(), middleware.ValidateDomain())
+	{
+		routes.GET("", middleware.Permission(follower_segment.Permissions.Key, follower_segment.Permissions.Index), followerSegmentController.Index)
+		routes.GET("/:id", middleware.Permission(follower_segment.Permissions.Key, follower_segment.Permissions.Show), followerSegmentController.Show)
+		routes.POST("", middleware.Permission(follower_segment.Permissions.Key, follower_segment.Permissions.Create), followerSegmentController.Create)
+		routes.PATCH("/:id", middleware.Permission(follower_segment.Permissions.Key, follower_segment.Permissions.Update), followerSegmentController.Update)
+		routes.DELETE("/:id", middleware.Permission(follower_segment.Permissions.Key, follower_segment.Permissions.Delete), followerSegmentController.Delete)
+		routes.GET("/:id/followers", middleware.Permission(follower_segment.Permissions.Key, follower_segment.Permissions.Followers), followerSegmentController.Followers)
+	}
+}
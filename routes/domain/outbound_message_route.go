@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/outbound_message"
+	"github.com/gin-gonic/gin"
+)
+
+func OutboundMessageRoute(route *gin.Engine, middleware *middleware.Middleware, outboundMessageController outbound_message.Controller) {
+	routes := route.Group(":domain_code/outbound-message", middleware.AuthHandle(), middleware.ValidateDomain())
+	{
+		routes.GET("", middleware.Permission(outbound_message.Permissions.Key, outbound_message.Permissions.Index), outboundMessageController.Index)
+		routes.GET("/:id", middleware.Permission(outbound_message.Permissions.Key, outbound_message.Permissions.Show), outboundMessageController.Show)
+		routes.POST("/broadcast", middleware.Permission(outbound_message.Permissions.Key, outbound_message.Permissions.Broadcast), outboundMessageController.Broadcast)
+		routes.POST("/:id/retry", middleware.Permission(outbound_message.Permissions.Key, outbound_message.Permissions.Retry), outboundMessageController.Retry)
+	}
+}
@@ -12,5 +12,7 @@ func PointMutationRoute(route *gin.Engine, middleware *middleware.Middleware, po
 	{
 		routes.GET("", pointMutationController.Index)
 		routes.GET("/:id", pointMutationController.Show)
+		routes.POST("", middleware.Permission(point_mutation.Permissions.Key, point_mutation.Permissions.Create), pointMutationController.Create)
+		routes.POST("/redeem", middleware.Permission(point_mutation.Permissions.Key, point_mutation.Permissions.Redeem), pointMutationController.Redeem)
 	}
 }
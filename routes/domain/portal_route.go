@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/portal"
+	"github.com/gin-gonic/gin"
+)
+
+// PortalRoute registers the follower self-service portal. Its routes authenticate followers with
+// FollowerAuthHandle and never use the user middlewares.
+func PortalRoute(route *gin.Engine, middleware *middleware.Middleware, portalController portal.Controller) {
+	routes := route.Group(":domain_code/portal")
+	{
+		routes.POST("/auth/otp", middleware.RateLimit(), portalController.RequestOTP)
+		routes.POST("/auth/verify", middleware.RateLimit(), portalController.VerifyOTP)
+		authenticated := routes.Use(middleware.FollowerAuthHandle())
+		{
+			authenticated.GET("/me", portalController.Me)
+			authenticated.GET("/point-mutations", portalController.PointMutations)
+			authenticated.GET("/events", portalController.UpcomingEvents)
+		}
+	}
+}
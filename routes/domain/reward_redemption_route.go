@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/reward_redemption"
+	"github.com/gin-gonic/gin"
+)
+
+// RewardRedemptionRoute defines domain-specific routes for reward redemption orders
+func RewardRedemptionRoute(route *gin.Engine, middleware *middleware.Middleware, rewardRedemptionController reward_redemption.Controller) {
+	routes := route.Group(":domain_code/reward-redemption", middleware.AuthHandle(), middleware.ValidateDomain())
+	{
+		routes.GET("", middleware.Permission(reward_redemption.Permissions.Key, reward_redemption.Permissions.Index), rewardRedemptionController.Index)
+		routes.GET("/:id", middleware.Permission(reward_redemption.Permissions.Key, reward_redemption.Permissions.Show), rewardRedemptionController.Show)
+		routes.POST("", middleware.Permission(reward_redemption.Permissions.Key, reward_redemption.Permissions.Create), rewardRedemptionController.Create)
+		routes.POST("/:id/fulfill", middleware.Permission(reward_redemption.Permissions.Key, reward_redemption.Permissions.Fulfill), rewardRedemptionController.Fulfill)
+		routes.POST("/:id/cancel", middleware.Permission(reward_redemption.Permissions.Key, reward_redemption.Permissions.Cancel), rewardRedemptionController.Cancel)
+	}
+}
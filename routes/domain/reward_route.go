@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"github.com/PhantomX7/dhamma/middleware"
+	"github.com/PhantomX7/dhamma/modules/reward"
+	"github.com/gin-gonic/gin"
+)
+
+// RewardRoute defines domain-specific routes for the rewards catalogue
+func RewardRoute(route *gin.Engine, middleware *middleware.Middleware, rewardController reward.Controller) {
+	routes := route.Group(":domain_code/reward", middleware.AuthHandle(), middleware.ValidateDomain())
+	{
+		routes.GET("", middleware.Permission(reward.Permissions.Key, reward.Permissions.Index), rewardController.Index)
+		routes.GET("/:id", middleware.Permission(reward.Permissions.Key, reward.Permissions.Show), rewardController.Show)
+		routes.POST("", middleware.Permission(reward.Permissions.Key, reward.Permissions.Create), rewardController.Create)
+		routes.PATCH("/:id", middleware.Permission(reward.Permissions.Key, reward.Permissions.Update), rewardController.Update)
+	}
+}
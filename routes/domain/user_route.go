@@ -14,5 +14,9 @@ func UserRoute(route *gin.Engine, middleware *middleware.Middleware, userControl
 		routes.POST("", middleware.Permission(user.Permissions.Key, user.Permissions.Create), userController.Create)
 		routes.POST("/:id/assign-role", middleware.Permission(user.Permissions.Key, user.Permissions.AssignRole), userController.AssignRole)
 		routes.POST("/:id/remove-role", middleware.Permission(user.Permissions.Key, user.Permissions.RemoveRole), userController.RemoveRole)
+		routes.GET("/:id/sessions", middleware.Permission(user.Permissions.Key, user.Permissions.Sessions), userController.Sessions)
+		routes.DELETE("/:id/sessions/:session_id", middleware.Permission(user.Permissions.Key, user.Permissions.RevokeSession), userController.RevokeSession)
+		routes.POST("/:id/force-logout", middleware.Permission(user.Permissions.Key, user.Permissions.ForceLogout), userController.ForceLogout)
+		routes.POST("/:id/unlock-login", middleware.Permission(user.Permissions.Key, user.Permissions.UnlockLogin), userController.UnlockLogin)
 	}
 }
@@ -9,25 +9,38 @@ import (
 
 var Module = fx.Invoke(
 	admin.AuthRoute,
+	admin.BloodDonationRoute,
+	admin.CampaignRoute,
 	admin.ChatTemplateRoute,
 	admin.DomainRoute,
 	admin.EventAttendanceRoute,
 	admin.EventRoute,
 	admin.FollowerRoute,
+	admin.FollowerSegmentRoute,
 	admin.HealthRoute,
+	admin.OutboundMessageRoute,
 	admin.PermissionRoute,
 	admin.PointMutationRoute,
+	admin.RewardRoute,
+	admin.RewardRedemptionRoute,
 	admin.UserRoute,
 	admin.RoleRoute,
 
 	// domain specific route
 	domain.AuthRoute,
+	domain.BloodDonationRoute,
+	domain.CampaignRoute,
 	domain.ChatTemplateRoute,
 	domain.EventAttendanceRoute,
 	domain.EventRoute,
 	domain.FollowerRoute,
+	domain.FollowerSegmentRoute,
+	domain.OutboundMessageRoute,
 	domain.PermissionRoute,
 	domain.PointMutationRoute,
+	domain.PortalRoute,
+	domain.RewardRoute,
+	domain.RewardRedemptionRoute,
 	domain.UserRoute,
 	domain.RoleRoute,
 
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 
 	"github.com/PhantomX7/dhamma/seeder/seed"
@@ -23,12 +24,13 @@ func main() {
 	}
 
 	dsn := fmt.Sprintf(
-		"%s:%s@(%s:%s)/%s?charset=utf8mb4&parseTime=true&loc=Asia%%2FJakarta",
+		"%s:%s@(%s:%s)/%s?charset=utf8mb4&parseTime=true&loc=%s",
 		os.Getenv("DATABASE_USERNAME"),
 		os.Getenv("DATABASE_PASSWORD"),
 		os.Getenv("DATABASE_HOST"),
 		os.Getenv("DATABASE_PORT"),
 		os.Getenv("DATABASE_NAME"),
+		url.QueryEscape(databaseTimezone()),
 	)
 
 	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
@@ -81,3 +83,11 @@ func main() {
 
 	log.Println("finish seeding")
 }
+
+// databaseTimezone returns the timezone DATETIME values are stored in, matching config.DATABASE_TIMEZONE.
+func databaseTimezone() string {
+	if timezone := os.Getenv("DATABASE_TIMEZONE"); timezone != "" {
+		return timezone
+	}
+	return "Asia/Jakarta"
+}
@@ -0,0 +1,175 @@
+// Package cardcode generates membership card codes from a per-domain format such as "{domain}-{seq:6}".
+//
+// A format mixes literal characters with these tokens:
+//
+//	{domain}  the domain code in upper case
+//	{year}    the four digit year the card is issued in
+//	{seq:N}   the domain's card sequence number, zero padded to N digits
+//	{rand:N}  N random upper case letters and digits
+//
+// Every format needs a {seq:N} or {rand:N} token so that two cards never share a code.
+package cardcode
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultFormat is the card code format of a domain that does not set one.
+const DefaultFormat = "{domain}-{seq:6}"
+
+// MaxLength is the longest code a format may produce, matching the size of the cards.code column.
+const MaxLength = 100
+
+// randAlphabet leaves out 0, O, 1 and I, which are easily mixed up when a code is typed in by hand.
+const randAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+var (
+	// ErrMalformed is returned for unknown tokens, bad widths and characters that are not allowed.
+	ErrMalformed = errors.New("malformed card code format")
+	// ErrNotUnique is returned for formats without a {seq:N} or {rand:N} token.
+	ErrNotUnique = errors.New("card code format needs a {seq:N} or {rand:N} token")
+	// ErrTooLong is returned when a format produces codes longer than MaxLength.
+	ErrTooLong = errors.New("card code format produces codes that are too long")
+)
+
+var (
+	tokenPattern   = regexp.MustCompile(`\{([a-z]+)(?::(\d+))?\}`)
+	literalPattern = regexp.MustCompile(`^[A-Za-z0-9_./-]*$`)
+)
+
+// Vars are the values a format is filled with.
+type Vars struct {
+	DomainCode string
+	Sequence   uint64
+	IssuedAt   time.Time
+}
+
+// Validate checks that format only uses known tokens and allowed literal characters
+// and that it always produces unique codes.
+func Validate(format string) error {
+	unique := false
+	length := 0
+	err := walk(format, func(literal string) {
+		length += len(literal)
+	}, func(name string, width int) {
+		switch name {
+		case "seq", "rand":
+			unique = true
+			length += width
+		case "year":
+			length += 4
+		case "domain":
+			// Domain codes are at most 50 characters long
+			length += 50
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if !unique {
+		return ErrNotUnique
+	}
+	if length > MaxLength {
+		return ErrTooLong
+	}
+	return nil
+}
+
+// Generate fills format with vars. An empty format generates codes in DefaultFormat.
+func Generate(format string, vars Vars) (string, error) {
+	if format == "" {
+		format = DefaultFormat
+	}
+	if err := Validate(format); err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	var randErr error
+	_ = walk(format, func(literal string) {
+		builder.WriteString(literal)
+	}, func(name string, width int) {
+		switch name {
+		case "domain":
+			builder.WriteString(strings.ToUpper(vars.DomainCode))
+		case "year":
+			builder.WriteString(strconv.Itoa(vars.IssuedAt.Year()))
+		case "seq":
+			builder.WriteString(fmt.Sprintf("%0*d", width, vars.Sequence))
+		case "rand":
+			value, err := randomString(width)
+			if err != nil {
+				randErr = err
+			}
+			builder.WriteString(value)
+		}
+	})
+	if randErr != nil {
+		return "", randErr
+	}
+
+	return builder.String(), nil
+}
+
+// walk splits format into literals and tokens, validating each of them.
+func walk(format string, onLiteral func(literal string), onToken func(name string, width int)) error {
+	position := 0
+	for _, match := range tokenPattern.FindAllStringSubmatchIndex(format, -1) {
+		if err := literal(format[position:match[0]], onLiteral); err != nil {
+			return err
+		}
+
+		name := format[match[2]:match[3]]
+		width := 0
+		if match[4] != -1 {
+			width, _ = strconv.Atoi(format[match[4]:match[5]])
+		}
+
+		switch name {
+		case "domain", "year":
+			if match[4] != -1 {
+				return fmt.Errorf("%w: {%s} takes no width", ErrMalformed, name)
+			}
+		case "seq", "rand":
+			if width < 1 || width > 20 {
+				return fmt.Errorf("%w: {%s} needs a width between 1 and 20", ErrMalformed, name)
+			}
+		default:
+			return fmt.Errorf("%w: unknown token {%s}", ErrMalformed, name)
+		}
+		onToken(name, width)
+		position = match[1]
+	}
+
+	return literal(format[position:], onLiteral)
+}
+
+// literal validates the text between two tokens.
+func literal(text string, onLiteral func(literal string)) error {
+	if !literalPattern.MatchString(text) {
+		return fmt.Errorf("%w: %q may only contain letters, digits and - _ . /", ErrMalformed, text)
+	}
+	onLiteral(text)
+	return nil
+}
+
+// randomString returns length characters drawn from randAlphabet.
+func randomString(length int) (string, error) {
+	max := big.NewInt(int64(len(randAlphabet)))
+	value := make([]byte, length)
+	for i := range value {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		value[i] = randAlphabet[n.Int64()]
+	}
+	return string(value), nil
+}
@@ -0,0 +1,64 @@
+package cardcode
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		err    error
+	}{
+		{name: "default", format: DefaultFormat},
+		{name: "random only", format: "MBR/{rand:8}"},
+		{name: "every token", format: "{domain}.{year}-{seq:5}_{rand:3}"},
+		{name: "unknown token", format: "{domain}-{serial:6}", err: ErrMalformed},
+		{name: "missing width", format: "{domain}-{seq}", err: ErrMalformed},
+		{name: "zero width", format: "{domain}-{rand:0}", err: ErrMalformed},
+		{name: "width on domain", format: "{domain:3}-{seq:6}", err: ErrMalformed},
+		{name: "space in literal", format: "{domain} {seq:6}", err: ErrMalformed},
+		{name: "unclosed token", format: "{domain}-{seq:6", err: ErrMalformed},
+		{name: "no unique token", format: "{domain}-{year}", err: ErrNotUnique},
+		{name: "too long", format: "{domain}-{seq:20}-{rand:20}-{rand:20}", err: ErrTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.format)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	vars := Vars{
+		DomainCode: "jkt",
+		Sequence:   42,
+		IssuedAt:   time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	code, err := Generate("", vars)
+	require.NoError(t, err)
+	assert.Equal(t, "JKT-000042", code)
+
+	code, err = Generate("{domain}/{year}/{seq:4}", vars)
+	require.NoError(t, err)
+	assert.Equal(t, "JKT/2026/0042", code)
+
+	code, err = Generate("M-{rand:6}", vars)
+	require.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^M-[A-HJ-NP-Z2-9]{6}$`), code)
+
+	_, err = Generate("{domain}", vars)
+	assert.ErrorIs(t, err, ErrNotUnique)
+}
@@ -0,0 +1,72 @@
+// Package cardqr signs and verifies the payload printed in a membership card's QR code.
+//
+// A payload has the form "DC1.<domain id>.<card code>.<signature>", where the card code is
+// base64url encoded and the signature is a truncated HMAC-SHA256 of the domain ID and card code.
+// Scanners can therefore reject tampered cards and cards of another domain without a database lookup.
+package cardqr
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// version prefixes every payload so the format can change without breaking printed cards.
+const version = "DC1"
+
+// signatureLength is the number of HMAC bytes kept in a payload.
+const signatureLength = 16
+
+var (
+	// ErrInvalidPayload is returned for payloads that are not well formed or whose signature does not match.
+	ErrInvalidPayload = errors.New("invalid card qr code")
+	// ErrWrongDomain is returned for valid payloads of a card that belongs to another domain.
+	ErrWrongDomain = errors.New("card qr code belongs to another domain")
+)
+
+// Sign returns the QR payload of the card with the given domain ID and code.
+func Sign(secret []byte, domainID uint64, code string) string {
+	domain := strconv.FormatUint(domainID, 10)
+	encodedCode := base64.RawURLEncoding.EncodeToString([]byte(code))
+
+	return strings.Join([]string{version, domain, encodedCode, signature(secret, domain, encodedCode)}, ".")
+}
+
+// Verify checks payload's signature and returns the card code it carries.
+// Payloads of cards outside domainID return ErrWrongDomain.
+func Verify(secret []byte, payload string, domainID uint64) (string, error) {
+	parts := strings.Split(strings.TrimSpace(payload), ".")
+	if len(parts) != 4 || parts[0] != version {
+		return "", ErrInvalidPayload
+	}
+
+	expected := signature(secret, parts[1], parts[2])
+	if !hmac.Equal([]byte(parts[3]), []byte(expected)) {
+		return "", ErrInvalidPayload
+	}
+
+	payloadDomainID, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return "", ErrInvalidPayload
+	}
+	if payloadDomainID != domainID {
+		return "", ErrWrongDomain
+	}
+
+	code, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || len(code) == 0 {
+		return "", ErrInvalidPayload
+	}
+
+	return string(code), nil
+}
+
+// signature returns the encoded, truncated HMAC of the payload's domain and code parts.
+func signature(secret []byte, domain string, encodedCode string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(version + "." + domain + "." + encodedCode))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:signatureLength])
+}
@@ -0,0 +1,50 @@
+package cardqr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	secret := []byte("secret")
+	payload := Sign(secret, 7, "JKT-000042")
+
+	code, err := Verify(secret, payload, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "JKT-000042", code)
+
+	_, err = Verify(secret, payload, 8)
+	assert.ErrorIs(t, err, ErrWrongDomain)
+
+	_, err = Verify([]byte("other secret"), payload, 7)
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+}
+
+func TestVerifyRejectsTamperedPayloads(t *testing.T) {
+	secret := []byte("secret")
+	payload := Sign(secret, 7, "JKT-000042")
+	parts := strings.Split(payload, ".")
+	otherCode := strings.Split(Sign(secret, 7, "JKT-000043"), ".")[2]
+
+	tests := []struct {
+		name    string
+		payload string
+	}{
+		{name: "empty", payload: ""},
+		{name: "plain card code", payload: "JKT-000042"},
+		{name: "unknown version", payload: strings.Join(append([]string{"DC9"}, parts[1:]...), ".")},
+		{name: "moved to another domain", payload: strings.Join([]string{parts[0], "8", parts[2], parts[3]}, ".")},
+		{name: "swapped card code", payload: strings.Join([]string{parts[0], parts[1], otherCode, parts[3]}, ".")},
+		{name: "missing signature", payload: strings.Join(parts[:3], ".")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(secret, tt.payload, 7)
+			assert.ErrorIs(t, err, ErrInvalidPayload)
+		})
+	}
+}
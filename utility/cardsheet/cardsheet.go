@@ -0,0 +1,118 @@
+// Package cardsheet renders membership cards onto printable A4 PDF sheets.
+// Cards are laid out ten to a page in the ISO/IEC 7810 ID-1 size so they can be cut out and laminated.
+package cardsheet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/go-pdf/fpdf"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// ContentType is the MIME type of a rendered sheet.
+const ContentType = "application/pdf"
+
+// Card and page dimensions in millimetres.
+const (
+	cardWidth    = 85.6
+	cardHeight   = 54
+	columns      = 2
+	rows         = 5
+	marginLeft   = (210 - columns*cardWidth) / 2
+	marginTop    = (297 - rows*cardHeight) / 2
+	padding      = 4
+	qrSize       = 34
+	qrResolution = 256
+)
+
+// ErrNoCards is returned when there is nothing to render.
+var ErrNoCards = errors.New("no cards to print")
+
+// Card is a single membership card on a sheet.
+type Card struct {
+	DomainName   string
+	FollowerName string
+	Code         string
+	// QRPayload is the value encoded in the card's QR code
+	QRPayload string
+}
+
+// Render returns a PDF with every card, filling pages from left to right and top to bottom.
+func Render(cards []Card) ([]byte, error) {
+	if len(cards) == 0 {
+		return nil, ErrNoCards
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetTitle("Membership cards", true)
+	// Core fonts only cover cp1252, translate names so accented letters still print
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for i, card := range cards {
+		position := i % (columns * rows)
+		if position == 0 {
+			pdf.AddPage()
+		}
+
+		x := marginLeft + float64(position%columns)*cardWidth
+		y := marginTop + float64(position/columns)*cardHeight
+		if err := drawCard(pdf, translate, card, fmt.Sprintf("qr-%d", i), x, y); err != nil {
+			return nil, err
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := pdf.Output(&buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// drawCard draws the cut line, texts and QR code of one card with its top left corner at x, y.
+func drawCard(pdf *fpdf.Fpdf, translate func(string) string, card Card, imageName string, x float64, y float64) error {
+	png, err := qrcode.Encode(card.QRPayload, qrcode.Medium, qrResolution)
+	if err != nil {
+		return err
+	}
+
+	pdf.SetDrawColor(180, 180, 180)
+	pdf.SetDashPattern([]float64{1, 1}, 0)
+	pdf.Rect(x, y, cardWidth, cardHeight, "D")
+	pdf.SetDashPattern([]float64{}, 0)
+
+	options := fpdf.ImageOptions{ImageType: "PNG"}
+	pdf.RegisterImageOptionsReader(imageName, options, bytes.NewReader(png))
+	pdf.ImageOptions(imageName, x+cardWidth-padding-qrSize, y+(cardHeight-qrSize)/2, qrSize, qrSize, false, options, 0, "")
+
+	textWidth := cardWidth - 3*padding - qrSize
+
+	pdf.SetXY(x+padding, y+padding)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.MultiCell(textWidth, 5, fit(pdf, translate(card.DomainName), textWidth), "", "L", false)
+
+	pdf.SetXY(x+padding, y+cardHeight/2-4)
+	pdf.SetFont("Helvetica", "", 12)
+	pdf.MultiCell(textWidth, 6, fit(pdf, translate(card.FollowerName), textWidth), "", "L", false)
+
+	pdf.SetXY(x+padding, y+cardHeight-padding-5)
+	pdf.SetFont("Courier", "B", 10)
+	pdf.CellFormat(textWidth, 5, fit(pdf, card.Code, textWidth), "", 0, "L", false, 0, "")
+
+	return pdf.Error()
+}
+
+// fit shortens text with an ellipsis until it fits in width with the current font.
+// Text is already single byte cp1252, so it is cut byte by byte.
+func fit(pdf *fpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+
+	for len(text) > 0 && pdf.GetStringWidth(text+"...") > width {
+		text = text[:len(text)-1]
+	}
+	return text + "..."
+}
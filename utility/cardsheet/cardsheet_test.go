@@ -0,0 +1,34 @@
+package cardsheet
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	cards := make([]Card, 0, 11)
+	for i := range 11 {
+		cards = append(cards, Card{
+			DomainName:   "Vihara Dhamma Jakarta",
+			FollowerName: fmt.Sprintf("Follower José with a very long name that does not fit %d", i),
+			Code:         fmt.Sprintf("JKT-%06d", i),
+			QRPayload:    fmt.Sprintf("DC1.1.payload-%d.signature", i),
+		})
+	}
+
+	data, err := Render(cards)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, []byte("%PDF-")))
+	// Ten cards fit on a page, the eleventh starts a second one
+	assert.Len(t, regexp.MustCompile(`/Type /Page\b`).FindAll(data, -1), 2)
+}
+
+func TestRenderWithoutCards(t *testing.T) {
+	_, err := Render(nil)
+	assert.ErrorIs(t, err, ErrNoCards)
+}
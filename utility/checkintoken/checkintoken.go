@@ -0,0 +1,88 @@
+// Package checkintoken derives the short-lived tokens an event displays as a QR code for self check-in.
+//
+// A token is "<step>.<signature>", where step counts Period long windows since the Unix epoch and the
+// signature is an HMAC of the event ID and step under the event's secret. Tokens of the current and the
+// previous window are accepted so a code scanned just before it rotates still works.
+package checkintoken
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period is how long a token is displayed before it rotates.
+const Period = 30 * time.Second
+
+// signatureLength is the number of encoded signature characters kept in a token.
+const signatureLength = 16
+
+var (
+	// ErrInvalid is returned for tokens that are not well formed, not signed by the event's secret or from the future.
+	ErrInvalid = errors.New("invalid check-in code")
+	// ErrExpired is returned for genuine tokens whose window has passed.
+	ErrExpired = errors.New("check-in code has expired")
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewSecret returns a random event secret.
+func NewSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// Generate returns the token of the window containing at and the time it rotates.
+func Generate(secret string, eventID uint64, at time.Time) (token string, expiresAt time.Time) {
+	step := stepAt(at)
+	return format(secret, eventID, step), time.Unix((step+1)*int64(Period/time.Second), 0)
+}
+
+// Verify checks token against the event's secret and returns its step.
+// Steps identify tokens, so callers can reject a token that was already used.
+func Verify(secret string, eventID uint64, token string, at time.Time) (int64, error) {
+	stepText, _, found := strings.Cut(token, ".")
+	if !found {
+		return 0, ErrInvalid
+	}
+
+	step, err := strconv.ParseInt(stepText, 36, 64)
+	if err != nil {
+		return 0, ErrInvalid
+	}
+
+	if !hmac.Equal([]byte(token), []byte(format(secret, eventID, step))) {
+		return 0, ErrInvalid
+	}
+
+	current := stepAt(at)
+	if step > current {
+		return 0, ErrInvalid
+	}
+	if step < current-1 {
+		return 0, ErrExpired
+	}
+
+	return step, nil
+}
+
+// stepAt returns the window containing at.
+func stepAt(at time.Time) int64 {
+	return at.Unix() / int64(Period/time.Second)
+}
+
+// format builds the token of a step.
+func format(secret string, eventID uint64, step int64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatUint(eventID, 10) + ":" + strconv.FormatInt(step, 10)))
+	return strconv.FormatInt(step, 36) + "." + encoding.EncodeToString(mac.Sum(nil))[:signatureLength]
+}
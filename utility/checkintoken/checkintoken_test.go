@@ -0,0 +1,70 @@
+package checkintoken
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAndVerify(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+
+	shownAt := time.Date(2026, 5, 1, 9, 0, 10, 0, time.UTC)
+	token, expiresAt := Generate(secret, 7, shownAt)
+	assert.Equal(t, time.Date(2026, 5, 1, 9, 0, 30, 0, time.UTC), expiresAt.UTC())
+
+	tests := []struct {
+		name string
+		at   time.Time
+		err  error
+	}{
+		{name: "same window", at: shownAt.Add(5 * time.Second)},
+		{name: "just after rotating", at: shownAt.Add(Period)},
+		{name: "two windows later", at: shownAt.Add(2 * Period), err: ErrExpired},
+		{name: "before it was shown", at: shownAt.Add(-Period), err: ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, err := Verify(secret, 7, token, tt.at)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, stepAt(shownAt), step)
+		})
+	}
+}
+
+func TestVerifyRejectsForeignTokens(t *testing.T) {
+	at := time.Now()
+	token, _ := Generate("secret", 7, at)
+	stepText, signature, _ := strings.Cut(token, ".")
+	nextStep, _ := Generate("secret", 7, at.Add(Period))
+
+	tests := []struct {
+		name    string
+		secret  string
+		eventID uint64
+		token   string
+	}{
+		{name: "other secret", secret: "other", eventID: 7, token: token},
+		{name: "other event", secret: "secret", eventID: 8, token: token},
+		{name: "moved to another step", secret: "secret", eventID: 7, token: strings.SplitN(nextStep, ".", 2)[0] + "." + signature},
+		{name: "changed signature", secret: "secret", eventID: 7, token: stepText + "." + strings.Repeat("A", signatureLength)},
+		{name: "no step", secret: "secret", eventID: 7, token: signature},
+		{name: "empty", secret: "secret", eventID: 7, token: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(tt.secret, tt.eventID, tt.token, at)
+			assert.ErrorIs(t, err, ErrInvalid)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/PhantomX7/dhamma/utility/errors"
 	"github.com/PhantomX7/dhamma/utility/logger"
@@ -14,6 +15,14 @@ type ContextValues struct {
 	DomainID *uint64
 	UserID   uint64
 	IsRoot   bool
+	// Location is the timezone of the domain, set for domain-scoped requests.
+	Location *time.Location
+	// TokenDomainID is the domain the access token was issued for, nil for root tokens.
+	TokenDomainID *uint64
+	// Scopes limit the permissions the access token can use, empty when it is not limited.
+	Scopes []string
+	// SessionID is the refresh token family the access token was issued for.
+	SessionID string
 }
 
 // NewContextWithValues creates a new context with the provided ContextValues.
@@ -62,6 +62,11 @@ func (e *AppError) Error() string {
 	return fmt.Sprintf("%s [%s]: %s", e.Type, e.Code, e.Message)
 }
 
+// Unwrap returns the underlying error so errors.Is and errors.As can inspect it
+func (e *AppError) Unwrap() error {
+	return e.Err
+}
+
 // WithRequestID adds request ID to the error
 func (e *AppError) WithRequestID(requestID string) *AppError {
 	e.RequestID = requestID
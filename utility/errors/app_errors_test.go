@@ -54,6 +54,20 @@ func TestAppError_Error(t *testing.T) {
 	}
 }
 
+func TestAppError_Unwrap(t *testing.T) {
+	appError := &AppError{
+		Message: "not found",
+		Err:     ErrNotFound,
+		Status:  http.StatusNotFound,
+	}
+
+	assert.Equal(t, ErrNotFound, appError.Unwrap())
+	assert.True(t, errors.Is(appError, ErrNotFound))
+	assert.True(t, IsNotFound(WrapError(ErrNotFound, "wrapped")))
+	assert.False(t, errors.Is(appError, ErrDuplicate))
+	assert.Nil(t, (&AppError{Message: "no cause"}).Unwrap())
+}
+
 func TestAppError_WithRequestID(t *testing.T) {
 	appError := &AppError{
 		Message: "test error",
@@ -0,0 +1,36 @@
+package utility
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// FollowerContextValues holds the signed-in follower of a follower portal request.
+// It is stored apart from ContextValues so portal requests never carry a user, domain permissions or root access.
+type FollowerContextValues struct {
+	FollowerID uint64
+	DomainID   uint64
+	// Location is the timezone of the follower's domain.
+	Location *time.Location
+}
+
+// NewContextWithFollower creates a new context with the provided FollowerContextValues.
+func NewContextWithFollower(ctx context.Context, values FollowerContextValues) context.Context {
+	return context.WithValue(ctx, "follower_values", values)
+}
+
+// FollowerFromContext retrieves FollowerContextValues from the given context.
+// It returns an unauthorized error outside follower portal requests.
+func FollowerFromContext(ctx context.Context) (FollowerContextValues, error) {
+	values, ok := ctx.Value("follower_values").(FollowerContextValues)
+	if !ok {
+		return FollowerContextValues{}, &errors.AppError{
+			Message: "follower sign-in required",
+			Status:  http.StatusUnauthorized,
+		}
+	}
+	return values, nil
+}
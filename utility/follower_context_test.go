@@ -0,0 +1,38 @@
+package utility
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/PhantomX7/dhamma/utility/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowerFromContext(t *testing.T) {
+	t.Run("follower values are returned", func(t *testing.T) {
+		ctx := NewContextWithFollower(context.Background(), FollowerContextValues{FollowerID: 7, DomainID: 3})
+
+		values, err := FollowerFromContext(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7), values.FollowerID)
+		assert.Equal(t, uint64(3), values.DomainID)
+	})
+
+	t.Run("user context values are not a follower", func(t *testing.T) {
+		ctx := NewContextWithValues(context.Background(), ContextValues{UserID: 7})
+
+		_, err := FollowerFromContext(ctx)
+		var appErr *errors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, http.StatusUnauthorized, appErr.Status)
+	})
+
+	t.Run("follower values are not user context values", func(t *testing.T) {
+		ctx := NewContextWithFollower(context.Background(), FollowerContextValues{FollowerID: 7, DomainID: 3})
+
+		_, err := ValuesFromContext(ctx)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,73 @@
+// Package fuzzy provides normalisation and similarity helpers for matching
+// hand-typed names and phone numbers.
+package fuzzy
+
+import (
+	"strings"
+	"unicode"
+)
+
+// phoneKeyDigits is how many trailing digits of a phone number are compared.
+// Using the subscriber part only makes "0812…", "62812…" and "+62 812…" match.
+const phoneKeyDigits = 9
+
+// NormalizePhone reduces a phone number to a key suitable for equality checks.
+// Everything but digits is dropped and only the last phoneKeyDigits digits are kept,
+// so national and international spellings of the same number produce the same key.
+// It returns an empty string when the number has too few digits to be compared.
+func NormalizePhone(phone string) string {
+	var digits strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+
+	key := digits.String()
+	if len(key) < phoneKeyDigits {
+		return ""
+	}
+	return key[len(key)-phoneKeyDigits:]
+}
+
+// NormalizeName lower-cases a name, drops punctuation and collapses whitespace.
+func NormalizeName(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, " ")
+}
+
+// Similarity returns how alike two strings are, from 0 (nothing in common) to 1 (identical),
+// based on their Levenshtein edit distance. The inputs are compared as given; normalise them first.
+func Similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein computes the edit distance between a and b using two rolling rows.
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,66 @@
+package fuzzy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		name     string
+		phone    string
+		expected string
+	}{
+		{name: "national format", phone: "0812-3456-7890", expected: "234567890"},
+		{name: "international format", phone: "+62 812 3456 7890", expected: "234567890"},
+		{name: "international without plus", phone: "6281234567890", expected: "234567890"},
+		{name: "too short", phone: "12345", expected: ""},
+		{name: "empty", phone: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizePhone(tt.phone))
+		})
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "case and spacing", input: "  Budi   SANTOSO ", expected: "budi santoso"},
+		{name: "punctuation", input: "Siti-Aminah, S.Pd.", expected: "siti aminah s pd"},
+		{name: "empty", input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeName(tt.input))
+		})
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected float64
+	}{
+		{name: "identical", a: "budi", b: "budi", expected: 1},
+		{name: "both empty", a: "", b: "", expected: 1},
+		{name: "one empty", a: "budi", b: "", expected: 0},
+		{name: "one typo", a: "budi santoso", b: "budi santosa", expected: 1 - 1.0/12},
+		{name: "completely different", a: "abc", b: "xyz", expected: 0},
+		{name: "multibyte runes", a: "café", b: "cafe", expected: 0.75},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, Similarity(tt.a, tt.b), 0.0001)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package pagination
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/PhantomX7/dhamma/utility/scope"
 )
@@ -73,6 +74,12 @@ func (fd *FilterDefinition) AddFilter(field string, config FilterConfig) *Filter
 	return fd
 }
 
+// HasFilter reports whether a filter is defined for the query key.
+func (fd *FilterDefinition) HasFilter(field string) bool {
+	_, exists := fd.configs[field]
+	return exists
+}
+
 func (fd *FilterDefinition) AddSort(field string, config SortConfig) *FilterDefinition {
 	fd.sorts[field] = config
 	return fd
@@ -95,6 +102,8 @@ type Pagination struct {
 	customScopes []scope.Scope
 	Preloads     []string
 	Filters      map[string]interface{}
+	// Location is the timezone used to resolve day boundaries of date filters
+	Location *time.Location
 }
 
 func NewPagination(conditions map[string][]string, filterDef *FilterDefinition, options PaginationOptions) *Pagination {
@@ -122,6 +131,11 @@ func (p *Pagination) AddCustomScope(scopes ...scope.Scope) {
 	p.customScopes = append(p.customScopes, scopes...)
 }
 
+// SetLocation sets the timezone used to resolve day boundaries of date filters.
+func (p *Pagination) SetLocation(location *time.Location) {
+	p.Location = location
+}
+
 func parseLimit(conditions map[string][]string, defaultLimit, maxLimit int) int {
 	if limitStr, exists := conditions[QueryKeyLimit]; exists && len(limitStr) > 0 {
 		if parsedLimit, err := strconv.Atoi(limitStr[0]); err == nil {
@@ -30,6 +30,17 @@ func TestFilterDefinition_AddFilter(t *testing.T) {
 	assert.Equal(t, config, fd.configs["name"])
 }
 
+func TestFilterDefinition_HasFilter(t *testing.T) {
+	fd := NewFilterDefinition().AddFilter("name", FilterConfig{
+		Field:     "name",
+		Type:      FilterTypeString,
+		Operators: []FilterOperator{OperatorEquals},
+	})
+
+	assert.True(t, fd.HasFilter("name"))
+	assert.False(t, fd.HasFilter("phone"))
+}
+
 func TestFilterDefinition_AddSort(t *testing.T) {
 	fd := NewFilterDefinition()
 	config := SortConfig{
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/PhantomX7/dhamma/utility"
 	"gorm.io/gorm"
 )
 
@@ -310,9 +311,9 @@ func (sb *ScopeBuilder) buildBoolScope(field string, op FilterOperation) func(*g
 }
 
 func (sb *ScopeBuilder) buildDateScope(field string, op FilterOperation) func(*gorm.DB) *gorm.DB {
-	location, err := time.LoadLocation("Asia/Jakarta") // GMT+7
-	if err != nil {
-		return nil
+	location := utility.LoadLocation(utility.DefaultTimezone)
+	if sb.pagination != nil && sb.pagination.Location != nil {
+		location = sb.pagination.Location
 	}
 
 	switch op.Operator {
@@ -325,7 +326,7 @@ func (sb *ScopeBuilder) buildDateScope(field string, op FilterOperation) func(*g
 			return db.Where(
 				fmt.Sprintf("%s BETWEEN ? AND ?", field),
 				t,
-				t.Add(24*time.Hour).Add(-time.Second),
+				t.AddDate(0, 0, 1).Add(-time.Second),
 			)
 		}
 	case OperatorBetween:
@@ -339,7 +340,7 @@ func (sb *ScopeBuilder) buildDateScope(field string, op FilterOperation) func(*g
 		}
 
 		// Adjust end date to include the entire day (23:59:59.999999999)
-		end = end.Add(24 * time.Hour).Add(-time.Nanosecond)
+		end = end.AddDate(0, 0, 1).Add(-time.Nanosecond)
 
 		return func(db *gorm.DB) *gorm.DB {
 			return db.Where(fmt.Sprintf("%s BETWEEN ? AND ?", field), start, end)
@@ -358,7 +359,7 @@ func (sb *ScopeBuilder) buildDateScope(field string, op FilterOperation) func(*g
 			return nil
 		}
 		// Adjust to include the entire day (23:59:59.999999999)
-		t = t.Add(24 * time.Hour).Add(-time.Nanosecond)
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
 		return func(db *gorm.DB) *gorm.DB {
 			return db.Where(fmt.Sprintf("%s <= ?", field), t)
 		}
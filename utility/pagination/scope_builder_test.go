@@ -971,6 +971,46 @@ func TestScopeBuilder_DryRun_DateTimeFilters(t *testing.T) {
 	assert.IsType(t, time.Time{}, stmt.Vars[1])
 }
 
+// Test date filters resolve day boundaries in the pagination location
+func TestScopeBuilder_DryRun_DateFilterLocation(t *testing.T) {
+	db := setupDryRunDB(t)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	filterDef := NewFilterDefinition().
+		AddFilter("created_at", FilterConfig{
+			Field:     "created_at",
+			Type:      FilterTypeDate,
+			Operators: []FilterOperator{OperatorBetween},
+		})
+
+	conditions := map[string][]string{
+		"created_at": {"between:2023-03-12,2023-03-12"},
+	}
+
+	pagination := NewPagination(conditions, filterDef, PaginationOptions{})
+	pagination.SetLocation(newYork)
+	filterScopes, _ := NewScopeBuilder(pagination).Build()
+
+	var models []TestModel
+	query := db.Model(&TestModel{})
+	for _, scope := range filterScopes {
+		query = scope(query)
+	}
+
+	stmt := query.Find(&models).Statement
+	validateSQL(t, stmt, []string{"created_at BETWEEN"})
+	assert.Len(t, stmt.Vars, 2)
+
+	start := stmt.Vars[0].(time.Time)
+	end := stmt.Vars[1].(time.Time)
+	assert.Equal(t, time.Date(2023, 3, 12, 0, 0, 0, 0, newYork), start)
+	// 2023-03-12 is a DST change day in New York, so it only lasts 23 hours
+	assert.Equal(t, time.Date(2023, 3, 12, 23, 59, 59, 999999999, newYork), end)
+	assert.Equal(t, 23*time.Hour-time.Nanosecond, end.Sub(start))
+}
+
 // Test dry run for custom scopes
 func TestScopeBuilder_DryRun_CustomScopes(t *testing.T) {
 	db := setupDryRunDB(t)
@@ -0,0 +1,124 @@
+// Package passwordpolicy checks new passwords against the configured password policy.
+//
+// A password must have a minimum length, contain the required character classes, not contain
+// the username and not be, or be built on, a commonly used password. Whether a password was
+// used before is checked by the caller, which holds the password history.
+package passwordpolicy
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/PhantomX7/dhamma/config"
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+// MaxLength is the longest password accepted. bcrypt ignores everything past 72 bytes.
+const MaxLength = 72
+
+//go:embed common_passwords.txt
+var commonPasswordList string
+
+// commonPasswords holds the lower case common passwords.
+var commonPasswords = func() map[string]bool {
+	passwords := map[string]bool{}
+	for _, password := range strings.Fields(commonPasswordList) {
+		passwords[password] = true
+	}
+	return passwords
+}()
+
+// Policy is a set of password rules.
+type Policy struct {
+	MinLength     int
+	RequireLower  bool
+	RequireUpper  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// History is how many recent passwords, the current one included, cannot be reused
+	History int
+}
+
+// FromConfig returns the policy configured in the PASSWORD_* variables.
+func FromConfig() Policy {
+	return Policy{
+		MinLength:     config.PASSWORD_MIN_LENGTH,
+		RequireLower:  config.PASSWORD_REQUIRE_LOWER,
+		RequireUpper:  config.PASSWORD_REQUIRE_UPPER,
+		RequireDigit:  config.PASSWORD_REQUIRE_DIGIT,
+		RequireSymbol: config.PASSWORD_REQUIRE_SYMBOL,
+		History:       config.PASSWORD_HISTORY,
+	}
+}
+
+// Check returns the rules the password breaks, or nothing if it is acceptable.
+func (p Policy) Check(password, username string) (problems []string) {
+	length := len([]rune(password))
+	if length < p.MinLength {
+		problems = append(problems, fmt.Sprintf("must be at least %d characters long", p.MinLength))
+	}
+	if len(password) > MaxLength {
+		problems = append(problems, fmt.Sprintf("must be at most %d bytes long", MaxLength))
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireLower && !hasLower {
+		problems = append(problems, "must contain a lower case letter")
+	}
+	if p.RequireUpper && !hasUpper {
+		problems = append(problems, "must contain an upper case letter")
+	}
+	if p.RequireDigit && !hasDigit {
+		problems = append(problems, "must contain a digit")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		problems = append(problems, "must contain a symbol")
+	}
+
+	lower := strings.ToLower(password)
+	if username = strings.ToLower(strings.TrimSpace(username)); len(username) >= 3 && strings.Contains(lower, username) {
+		problems = append(problems, "must not contain the username")
+	}
+	if isCommon(lower) {
+		problems = append(problems, "is too common")
+	}
+
+	return
+}
+
+// isCommon reports whether a lower case password is a common password, or one with digits and symbols tacked on.
+func isCommon(password string) bool {
+	if commonPasswords[password] {
+		return true
+	}
+
+	base := strings.TrimRightFunc(password, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	return base != "" && commonPasswords[base]
+}
+
+// Validate returns a validation error listing the rules the password breaks, or nil if it is acceptable.
+func (p Policy) Validate(password, username string) error {
+	problems := p.Check(password, username)
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return errors.NewValidationError("password does not meet the password policy", nil).
+		WithDetails(map[string]interface{}{"password": problems})
+}
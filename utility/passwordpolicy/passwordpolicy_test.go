@@ -0,0 +1,63 @@
+package passwordpolicy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhantomX7/dhamma/utility/errors"
+)
+
+func TestCheck(t *testing.T) {
+	policy := Policy{MinLength: 8, RequireLower: true, RequireUpper: true, RequireDigit: true}
+
+	tests := []struct {
+		name     string
+		password string
+		problems []string
+	}{
+		{name: "acceptable", password: "Lotus-Pond-42"},
+		{name: "too short", password: "Ab1xyz", problems: []string{"must be at least 8 characters long"}},
+		{name: "too long", password: "Aa1" + strings.Repeat("x", MaxLength), problems: []string{"must be at most 72 bytes long"}},
+		{name: "missing classes", password: "lotuspondpath", problems: []string{"must contain an upper case letter", "must contain a digit"}},
+		{name: "contains username", password: "Xjohndoe99", problems: []string{"must not contain the username"}},
+		{name: "common", password: "Password123", problems: []string{"is too common"}},
+		{name: "common with symbols", password: "Sunshine2024!", problems: []string{"is too common"}},
+		{name: "common without letters", password: "12345678", problems: []string{
+			"must contain a lower case letter", "must contain an upper case letter", "is too common",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.problems, policy.Check(tt.password, "johndoe"))
+		})
+	}
+}
+
+func TestCheckSymbolRequirement(t *testing.T) {
+	policy := Policy{RequireSymbol: true}
+
+	assert.Equal(t, []string{"must contain a symbol"}, policy.Check("Lotus Pond 42", ""))
+	assert.Empty(t, policy.Check("Lotus-Pond-42", ""))
+}
+
+func TestCheckShortUsernamesAreIgnored(t *testing.T) {
+	policy := Policy{}
+
+	assert.Empty(t, policy.Check("Lotus-ab-42", "ab"))
+}
+
+func TestValidate(t *testing.T) {
+	policy := Policy{MinLength: 8}
+
+	assert.NoError(t, policy.Validate("Lotus-Pond-42", "johndoe"))
+
+	err := policy.Validate("short", "johndoe")
+	var appErr *errors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, errors.CodeValidationFailed, appErr.Code)
+	assert.Equal(t, map[string]interface{}{"password": []string{"must be at least 8 characters long"}}, appErr.Details)
+}
@@ -0,0 +1,96 @@
+// Package placeholder fills {{variable}} placeholders in message templates.
+// Variable names are lower case, dot separated words such as "follower.name".
+package placeholder
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrMalformed is returned when a placeholder is not closed or its name is not a valid variable name.
+	ErrMalformed = errors.New("malformed placeholder")
+	// ErrUnknownVariable is returned when a template uses a variable that is not allowed.
+	ErrUnknownVariable = errors.New("unknown variable")
+	// ErrMissingVariable is returned when no value is given for a variable used by a template.
+	ErrMissingVariable = errors.New("missing variable")
+)
+
+var (
+	placeholderPattern = regexp.MustCompile(`\{\{([^{}]*)\}\}`)
+	namePattern        = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*$`)
+)
+
+// Variables returns the distinct variables used by content, in order of first appearance.
+func Variables(content string) ([]string, error) {
+	variables := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, match := range placeholderPattern.FindAllStringSubmatch(content, -1) {
+		name := strings.TrimSpace(match[1])
+		if !namePattern.MatchString(name) {
+			return nil, fmt.Errorf("%w: %s", ErrMalformed, match[0])
+		}
+		if !seen[name] {
+			seen[name] = true
+			variables = append(variables, name)
+		}
+	}
+
+	rest := placeholderPattern.ReplaceAllString(content, "")
+	if strings.Contains(rest, "{{") || strings.Contains(rest, "}}") {
+		return nil, fmt.Errorf("%w: unbalanced braces", ErrMalformed)
+	}
+
+	return variables, nil
+}
+
+// Validate checks that content is well formed and only uses the allowed variables.
+func Validate(content string, allowed []string) error {
+	variables, err := Variables(content)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(allowed))
+	for _, name := range allowed {
+		known[name] = true
+	}
+
+	unknown := make([]string, 0)
+	for _, name := range variables {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: %s (supported: %s)", ErrUnknownVariable, strings.Join(unknown, ", "), strings.Join(allowed, ", "))
+	}
+
+	return nil
+}
+
+// Render replaces every placeholder in content with its value.
+// It fails, listing every variable concerned, if a value is missing for any of them.
+func Render(content string, values map[string]string) (string, error) {
+	variables, err := Variables(content)
+	if err != nil {
+		return "", err
+	}
+
+	missing := make([]string, 0)
+	for _, name := range variables {
+		if _, ok := values[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrMissingVariable, strings.Join(missing, ", "))
+	}
+
+	return placeholderPattern.ReplaceAllStringFunc(content, func(match string) string {
+		return values[strings.TrimSpace(match[2:len(match)-2])]
+	}), nil
+}
@@ -0,0 +1,64 @@
+package placeholder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariables(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+		err      error
+	}{
+		{name: "no placeholders", content: "Hello everyone", expected: []string{}},
+		{name: "distinct in order", content: "{{follower.name}} {{ event.name }} {{follower.name}}", expected: []string{"follower.name", "event.name"}},
+		{name: "invalid name", content: "Hi {{Follower Name}}", err: ErrMalformed},
+		{name: "empty placeholder", content: "Hi {{ }}", err: ErrMalformed},
+		{name: "unclosed", content: "Hi {{follower.name", err: ErrMalformed},
+		{name: "stray closing", content: "Hi follower.name}}", err: ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			variables, err := Variables(tt.content)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, variables)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	allowed := []string{"follower.name", "event.name"}
+
+	assert.NoError(t, Validate("Hi {{follower.name}}, see you at {{event.name}}", allowed))
+
+	err := Validate("Hi {{follower.nama}} {{domain.name}}", allowed)
+	assert.ErrorIs(t, err, ErrUnknownVariable)
+	assert.Contains(t, err.Error(), "follower.nama, domain.name")
+
+	assert.ErrorIs(t, Validate("Hi {{follower.name", allowed), ErrMalformed)
+}
+
+func TestRender(t *testing.T) {
+	values := map[string]string{"follower.name": "Budi", "follower.points": "12"}
+
+	rendered, err := Render("Hi {{ follower.name }}, you have {{follower.points}} points. Bye {{follower.name}}!", values)
+	require.NoError(t, err)
+	assert.Equal(t, "Hi Budi, you have 12 points. Bye Budi!", rendered)
+
+	_, err = Render("Hi {{follower.name}}, see you at {{event.name}} on {{event.date}}", values)
+	assert.ErrorIs(t, err, ErrMissingVariable)
+	assert.Contains(t, err.Error(), "event.name, event.date")
+
+	rendered, err = Render("No placeholders", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "No placeholders", rendered)
+}
@@ -0,0 +1,47 @@
+// Package recoverycode generates the one-time recovery codes that replace an authenticator app once each.
+//
+// A code is 10 lower case base32 characters shown as "xxxxx-xxxxx". Only a SHA-256 hash of the
+// normalized code is stored; the codes carry 50 random bits, so a salted slow hash adds nothing.
+package recoverycode
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+)
+
+// Count is the number of codes issued at a time.
+const Count = 10
+
+// length is the number of characters of a code without the separator.
+const length = 10
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Generate returns Count new codes in their display form.
+func Generate() ([]string, error) {
+	codes := make([]string, Count)
+	for i := range codes {
+		random := make([]byte, 7)
+		if _, err := rand.Read(random); err != nil {
+			return nil, err
+		}
+
+		code := strings.ToLower(encoding.EncodeToString(random)[:length])
+		codes[i] = code[:length/2] + "-" + code[length/2:]
+	}
+	return codes, nil
+}
+
+// Hash returns the stored form of a code. Case, spaces and separators are ignored.
+func Hash(code string) string {
+	sum := sha256.Sum256([]byte(Normalize(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Normalize strips what people add or change when typing a code in.
+func Normalize(code string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(code)))
+}
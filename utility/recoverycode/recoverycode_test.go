@@ -0,0 +1,31 @@
+package recoverycode
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	codes, err := Generate()
+	require.NoError(t, err)
+	require.Len(t, codes, Count)
+
+	seen := map[string]bool{}
+	for _, code := range codes {
+		assert.Regexp(t, regexp.MustCompile(`^[a-z2-7]{5}-[a-z2-7]{5}$`), code)
+		assert.False(t, seen[code], "duplicate code %s", code)
+		seen[code] = true
+	}
+}
+
+func TestHashIgnoresFormatting(t *testing.T) {
+	expected := Hash("abcde-fghij")
+
+	assert.Equal(t, expected, Hash("ABCDE-FGHIJ"))
+	assert.Equal(t, expected, Hash(" abcdefghij "))
+	assert.Equal(t, expected, Hash("abcde fghij"))
+	assert.NotEqual(t, expected, Hash("abcde-fghik"))
+}
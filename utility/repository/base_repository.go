@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/PhantomX7/dhamma/utility"
 	customErrors "github.com/PhantomX7/dhamma/utility/errors"
 	"github.com/PhantomX7/dhamma/utility/logger"
 	"github.com/PhantomX7/dhamma/utility/pagination"
@@ -32,6 +33,7 @@ func NewBaseRepository[T any](db *gorm.DB) BaseRepository[T] {
 // Returns the count and any error encountered during the database operation.
 func (r BaseRepository[T]) Count(ctx context.Context, pg *pagination.Pagination) (int64, error) {
 	var count int64
+	r.applyLocation(ctx, pg)
 	scopeBuilder := pagination.NewScopeBuilder(pg)
 	scopes, _ := scopeBuilder.Build()
 
@@ -146,6 +148,7 @@ func (r BaseRepository[T]) Delete(ctx context.Context, model *T, tx *gorm.DB) er
 // if the database operation fails.
 func (r BaseRepository[T]) FindAll(ctx context.Context, pg *pagination.Pagination) ([]T, error) {
 	entities := make([]T, 0)
+	r.applyLocation(ctx, pg)
 	scopeBuilder := pagination.NewScopeBuilder(pg)
 	scopes, metaScopes := scopeBuilder.Build()
 
@@ -297,3 +300,11 @@ func (r BaseRepository[T]) prepareDB(ctx context.Context, tx *gorm.DB) *gorm.DB
 	}
 	return db.WithContext(ctx)
 }
+
+// applyLocation sets the domain timezone from the context on the pagination,
+// unless the caller already chose one, so date filters use the domain's day boundaries
+func (r BaseRepository[T]) applyLocation(ctx context.Context, pg *pagination.Pagination) {
+	if pg != nil && pg.Location == nil {
+		pg.SetLocation(utility.LocationFromContext(ctx))
+	}
+}
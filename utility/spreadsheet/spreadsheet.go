@@ -0,0 +1,135 @@
+// Package spreadsheet reads and writes tabular data as CSV or XLSX files.
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Supported spreadsheet formats.
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// sheetName is the worksheet written to and read from XLSX files.
+const sheetName = "Sheet1"
+
+// formulaPrefixes are the leading characters that make spreadsheet applications evaluate a CSV cell as a formula.
+const formulaPrefixes = "=+-@\t\r"
+
+// ErrUnsupportedFormat is returned for formats other than CSV and XLSX.
+var ErrUnsupportedFormat = errors.New("unsupported file format, use csv or xlsx")
+
+// FormatFromFilename returns the spreadsheet format matching the file extension.
+func FormatFromFilename(filename string) (string, error) {
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(filename)), ".")
+	if format != FormatCSV && format != FormatXLSX {
+		return "", ErrUnsupportedFormat
+	}
+	return format, nil
+}
+
+// ContentType returns the MIME type of the given format.
+func ContentType(format string) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv"
+}
+
+// Read parses every row of the file, including the header row.
+// For XLSX files only the first worksheet is read. CSV cells escaped by Write are unescaped.
+func Read(r io.Reader, format string) ([][]string, error) {
+	switch format {
+	case FormatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		rows, err := reader.ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			for i, value := range row {
+				row[i] = unescapeFormula(value)
+			}
+		}
+		return rows, nil
+	case FormatXLSX:
+		file, err := excelize.OpenReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		sheets := file.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, nil
+		}
+		return file.GetRows(sheets[0])
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// Write encodes rows into w using the given format.
+// CSV cells that would be evaluated as a formula are escaped; XLSX cells are always written as text.
+func Write(w io.Writer, format string, rows [][]string) error {
+	switch format {
+	case FormatCSV:
+		writer := csv.NewWriter(w)
+		for _, row := range rows {
+			escaped := make([]string, len(row))
+			for i, value := range row {
+				escaped[i] = escapeFormula(value)
+			}
+			if err := writer.Write(escaped); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case FormatXLSX:
+		file := excelize.NewFile()
+		defer file.Close()
+
+		for i, row := range rows {
+			cell, err := excelize.CoordinatesToCellName(1, i+1)
+			if err != nil {
+				return err
+			}
+
+			values := make([]interface{}, len(row))
+			for j, value := range row {
+				values[j] = value
+			}
+			if err = file.SetSheetRow(sheetName, cell, &values); err != nil {
+				return fmt.Errorf("failed to write row %d: %w", i+1, err)
+			}
+		}
+		return file.Write(w)
+	}
+	return ErrUnsupportedFormat
+}
+
+// escapeFormula prefixes a value starting like a formula with a single quote, so it is shown as text.
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// unescapeFormula removes the quote added by escapeFormula.
+func unescapeFormula(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(value[1])) {
+		return value[1:]
+	}
+	return value
+}
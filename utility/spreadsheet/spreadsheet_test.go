@@ -0,0 +1,86 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatFromFilename(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		expected string
+		wantErr  bool
+	}{
+		{name: "csv", filename: "followers.csv", expected: FormatCSV},
+		{name: "xlsx upper case", filename: "Followers.XLSX", expected: FormatXLSX},
+		{name: "xls is not supported", filename: "followers.xls", wantErr: true},
+		{name: "no extension", filename: "followers", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := FormatFromFilename(tt.filename)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnsupportedFormat)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, format)
+		})
+	}
+}
+
+func TestWriteRead_RoundTrip(t *testing.T) {
+	rows := [][]string{
+		{"name", "phone", "card_code"},
+		{"Budi", "+628123", "A-001"},
+		{"Siti, Jr.", "", "A-002;A-003"},
+		{"=HYPERLINK(\"http://x\")", "-1", "@SUM(A1)"},
+	}
+
+	for _, format := range []string{FormatCSV, FormatXLSX} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Write(&buf, format, rows))
+
+			read, err := Read(&buf, format)
+			require.NoError(t, err)
+
+			// XLSX drops trailing empty cells, so compare cell by cell
+			require.Len(t, read, len(rows))
+			for i, row := range rows {
+				for j, value := range row {
+					actual := ""
+					if j < len(read[i]) {
+						actual = read[i][j]
+					}
+					assert.Equal(t, value, actual, "row %d column %d", i, j)
+				}
+			}
+		})
+	}
+}
+
+func TestWrite_EscapesCSVFormulas(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatCSV, [][]string{{"=1+1", "+62812", "-5", "@A1", "\tx", "Budi", "'=kept"}}))
+
+	assert.Equal(t, "'=1+1,'+62812,'-5,'@A1,'\tx,Budi,'=kept\n", buf.String())
+}
+
+func TestRead_RaggedCSV(t *testing.T) {
+	read, err := Read(strings.NewReader("name,phone\nBudi\n"), FormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"name", "phone"}, {"Budi"}}, read)
+}
+
+func TestUnsupportedFormat(t *testing.T) {
+	_, err := Read(strings.NewReader(""), "xls")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	assert.ErrorIs(t, Write(&bytes.Buffer{}, "xls", nil), ErrUnsupportedFormat)
+}
@@ -0,0 +1,89 @@
+// Package testdb opens the SQLite databases the service tests run against and seeds the records
+// most of them start from.
+//
+// Each database is a file in a temporary directory of the test rather than an in-memory one, so that
+// concurrent tests can open several connections to it. Transactions take the write lock as they begin
+// and wait for each other instead of failing with a busy error.
+package testdb
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/PhantomX7/dhamma/entity"
+	"github.com/PhantomX7/dhamma/utility"
+)
+
+// New opens a new database for t and migrates the given models into it.
+func New(t testing.TB, models ...interface{}) *gorm.DB {
+	t.Helper()
+
+	dsn := fmt.Sprintf(
+		"file:%s?_txlock=immediate&_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)",
+		filepath.Join(t.TempDir(), "test.db"),
+	)
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, db.AutoMigrate(models...))
+
+	return db
+}
+
+// Domain creates an active domain in the default timezone, after applying changes to it.
+func Domain(t testing.TB, db *gorm.DB, changes ...func(*entity.Domain)) entity.Domain {
+	t.Helper()
+
+	domain := entity.Domain{Name: "Test", Code: "test", IsActive: true, Timezone: utility.DefaultTimezone}
+	for _, change := range changes {
+		change(&domain)
+	}
+	require.NoError(t, db.Create(&domain).Error)
+
+	return domain
+}
+
+// Follower creates a follower of the domain, after applying changes to it.
+func Follower(t testing.TB, db *gorm.DB, domainID uint64, changes ...func(*entity.Follower)) entity.Follower {
+	t.Helper()
+
+	follower := entity.Follower{DomainID: domainID, Name: "Budi"}
+	for _, change := range changes {
+		change(&follower)
+	}
+	require.NoError(t, db.Create(&follower).Error)
+
+	return follower
+}
+
+// WithPoints sets the points a follower starts with.
+func WithPoints(points int) func(*entity.Follower) {
+	return func(follower *entity.Follower) {
+		follower.Points = points
+	}
+}
+
+// WithPhone sets the phone number of a follower.
+func WithPhone(phone string) func(*entity.Follower) {
+	return func(follower *entity.Follower) {
+		follower.Phone = &phone
+	}
+}
+
+// Context returns the context of a request made by user 1 signed in to the domain.
+func Context(domain entity.Domain) context.Context {
+	return utility.NewContextWithValues(context.Background(), utility.ContextValues{
+		DomainID: &domain.ID,
+		UserID:   1,
+		Location: domain.Location(),
+	})
+}
@@ -0,0 +1,76 @@
+package textdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected []Line
+	}{
+		{name: "both empty", from: "", to: "", expected: []Line{}},
+		{
+			name: "identical",
+			from: "Hi\nBye",
+			to:   "Hi\nBye",
+			expected: []Line{
+				{Op: OpEqual, Text: "Hi"},
+				{Op: OpEqual, Text: "Bye"},
+			},
+		},
+		{
+			name:     "from empty",
+			from:     "",
+			to:       "Hi",
+			expected: []Line{{Op: OpInsert, Text: "Hi"}},
+		},
+		{
+			name:     "to empty",
+			from:     "Hi",
+			to:       "",
+			expected: []Line{{Op: OpDelete, Text: "Hi"}},
+		},
+		{
+			name: "replaced line",
+			from: "Hi {{ follower.name }}\nSee you at {{ event.name }}\nThanks",
+			to:   "Hi {{ follower.name }}\nSee you on {{ event.date }}\nThanks",
+			expected: []Line{
+				{Op: OpEqual, Text: "Hi {{ follower.name }}"},
+				{Op: OpDelete, Text: "See you at {{ event.name }}"},
+				{Op: OpInsert, Text: "See you on {{ event.date }}"},
+				{Op: OpEqual, Text: "Thanks"},
+			},
+		},
+		{
+			name: "inserted and deleted lines",
+			from: "a\nb\nc",
+			to:   "b\nc\nd",
+			expected: []Line{
+				{Op: OpDelete, Text: "a"},
+				{Op: OpEqual, Text: "b"},
+				{Op: OpEqual, Text: "c"},
+				{Op: OpInsert, Text: "d"},
+			},
+		},
+		{
+			name: "crlf matches lf",
+			from: "a\r\nb",
+			to:   "a\nb",
+			expected: []Line{
+				{Op: OpEqual, Text: "a"},
+				{Op: OpEqual, Text: "b"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Lines(tt.from, tt.to))
+		})
+	}
+}
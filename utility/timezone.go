@@ -0,0 +1,35 @@
+package utility
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultTimezone is the IANA timezone used when a domain has none configured.
+const DefaultTimezone = "Asia/Jakarta"
+
+// LoadLocation resolves an IANA timezone name to a *time.Location.
+// Empty or unknown names fall back to DefaultTimezone, and to UTC if that cannot be loaded either.
+func LoadLocation(name string) *time.Location {
+	if name != "" {
+		if location, err := time.LoadLocation(name); err == nil {
+			return location
+		}
+	}
+
+	location, err := time.LoadLocation(DefaultTimezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// LocationFromContext returns the timezone of the domain stored in the context.
+// It falls back to DefaultTimezone when the request is not scoped to a domain.
+func LocationFromContext(ctx context.Context) *time.Location {
+	values, ok := ctx.Value("values").(ContextValues)
+	if !ok || values.Location == nil {
+		return LoadLocation(DefaultTimezone)
+	}
+	return values.Location
+}
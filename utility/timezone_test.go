@@ -0,0 +1,47 @@
+package utility
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		expected string
+	}{
+		{name: "valid timezone", timezone: "America/New_York", expected: "America/New_York"},
+		{name: "empty falls back to default", timezone: "", expected: DefaultTimezone},
+		{name: "unknown falls back to default", timezone: "Mars/Olympus_Mons", expected: DefaultTimezone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, LoadLocation(tt.timezone).String())
+		})
+	}
+}
+
+func TestLocationFromContext(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	t.Run("location from context values", func(t *testing.T) {
+		ctx := NewContextWithValues(context.Background(), ContextValues{Location: tokyo})
+		assert.Equal(t, tokyo, LocationFromContext(ctx))
+	})
+
+	t.Run("context values without location", func(t *testing.T) {
+		ctx := NewContextWithValues(context.Background(), ContextValues{UserID: 1})
+		assert.Equal(t, DefaultTimezone, LocationFromContext(ctx).String())
+	})
+
+	t.Run("context without values", func(t *testing.T) {
+		assert.Equal(t, DefaultTimezone, LocationFromContext(context.Background()).String())
+	})
+}
@@ -0,0 +1,118 @@
+// Package totp implements the time-based one-time passwords of RFC 6238 used by authenticator apps.
+//
+// Codes are 6 digits derived with HMAC-SHA1 from a base32 secret over 30 second steps, the defaults
+// every authenticator app supports. Codes of the previous and the next step are accepted to allow
+// for clock drift between the server and the phone.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Period is how long a code is valid for.
+const Period = 30 * time.Second
+
+// Digits is the number of digits of a code.
+const Digits = 6
+
+// modulo keeps the last Digits digits of a value.
+const modulo = 1_000_000
+
+// secretSize is the number of random bytes in a secret, the size RFC 4226 recommends.
+const secretSize = 20
+
+// ErrInvalid is returned for codes that are not well formed or do not match the secret around the given time.
+var ErrInvalid = errors.New("invalid authentication code")
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewSecret returns a random base32 secret.
+func NewSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(secret), nil
+}
+
+// Generate returns the code of the step containing at.
+func Generate(secret string, at time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return generate(key, stepAt(at)), nil
+}
+
+// Verify checks code against the secret and returns its step.
+// Steps identify codes, so callers can reject a code that was already used.
+func Verify(secret, code string, at time.Time) (int64, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, err
+	}
+
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != Digits {
+		return 0, ErrInvalid
+	}
+
+	current := stepAt(at)
+	for _, step := range []int64{current, current - 1, current + 1} {
+		if hmac.Equal([]byte(code), []byte(generate(key, step))) {
+			return step, nil
+		}
+	}
+	return 0, ErrInvalid
+}
+
+// URI returns the otpauth URI authenticator apps read from a QR code.
+func URI(issuer, account, secret string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(int(Period/time.Second)))
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// stepAt returns the step containing at.
+func stepAt(at time.Time) int64 {
+	return at.Unix() / int64(Period/time.Second)
+}
+
+// decodeSecret decodes a base32 secret, accepting the lower case and spaced forms people type in.
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := encoding.DecodeString(strings.TrimRight(secret, "="))
+	if err != nil || len(key) == 0 {
+		return nil, errors.New("invalid totp secret")
+	}
+	return key, nil
+}
+
+// generate computes the HOTP value of RFC 4226 for a step.
+func generate(key []byte, step int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, value%modulo)
+}
@@ -0,0 +1,87 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 secret of the RFC 6238 test vectors.
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestGenerateMatchesRFCVectors(t *testing.T) {
+	// The RFC lists 8 digit codes, 6 digit codes are their last 6 digits
+	tests := []struct {
+		unix     int64
+		expected string
+	}{
+		{unix: 59, expected: "287082"},
+		{unix: 1111111109, expected: "081804"},
+		{unix: 1111111111, expected: "050471"},
+		{unix: 1234567890, expected: "005924"},
+		{unix: 2000000000, expected: "279037"},
+	}
+
+	for _, tt := range tests {
+		code, err := Generate(rfcSecret, time.Unix(tt.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, code)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	shownAt := time.Date(2026, 5, 1, 9, 0, 10, 0, time.UTC)
+	code, err := Generate(rfcSecret, shownAt)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		code  string
+		at    time.Time
+		valid bool
+	}{
+		{name: "same step", code: code, at: shownAt, valid: true},
+		{name: "next step", code: code, at: shownAt.Add(Period), valid: true},
+		{name: "previous step", code: code, at: shownAt.Add(-Period), valid: true},
+		{name: "two steps later", code: code, at: shownAt.Add(2 * Period)},
+		{name: "spaced", code: code[:3] + " " + code[3:], at: shownAt, valid: true},
+		{name: "too short", code: code[:5], at: shownAt},
+		{name: "wrong code", code: strings.Repeat("1", Digits), at: shownAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, err := Verify(rfcSecret, tt.code, tt.at)
+			if !tt.valid {
+				assert.ErrorIs(t, err, ErrInvalid)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, stepAt(shownAt), step)
+		})
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+
+	key, err := decodeSecret(secret)
+	require.NoError(t, err)
+	assert.Len(t, key, secretSize)
+}
+
+func TestVerifyRejectsInvalidSecret(t *testing.T) {
+	_, err := Verify("not base32!", "123456", time.Now())
+	assert.Error(t, err)
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("Dhamma", "john doe", "JBSWY3DPEHPK3PXP")
+
+	assert.Equal(t, "otpauth://totp/Dhamma:john%20doe?algorithm=SHA1&digits=6&issuer=Dhamma&period=30&secret=JBSWY3DPEHPK3PXP", uri)
+}
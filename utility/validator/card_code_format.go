@@ -0,0 +1,15 @@
+package validator
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	"github.com/PhantomX7/dhamma/utility/cardcode"
+)
+
+// check if value of request is a card code format that always produces unique codes
+// tag format : card_code_format
+func (cv cValidator) CardCodeFormat() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return cardcode.Validate(fl.Field().String()) == nil
+	}
+}
@@ -0,0 +1,38 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardCodeFormat_ValidatorFunction(t *testing.T) {
+	v := validator.New()
+
+	customValidator := New(nil)
+	v.RegisterValidation("card_code_format", customValidator.CardCodeFormat())
+
+	type TestStruct struct {
+		Format string `validate:"card_code_format"`
+	}
+
+	tests := []struct {
+		name     string
+		format   string
+		expected bool
+	}{
+		{name: "default", format: "{domain}-{seq:6}", expected: true},
+		{name: "random", format: "MBR-{rand:8}", expected: true},
+		{name: "empty", format: "", expected: false},
+		{name: "no unique token", format: "{domain}-{year}", expected: false},
+		{name: "unknown token", format: "{domain}-{serial:6}", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(TestStruct{Format: tt.format})
+			assert.Equal(t, tt.expected, err == nil)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package validator
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// check if value of request is a valid IANA timezone name
+// tag format : timezone
+func (cv cValidator) Timezone() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		name := fl.Field().String()
+		// time.LoadLocation treats "" as UTC and "Local" as the server timezone, neither is a real zone name
+		if name == "" || name == "Local" {
+			return false
+		}
+
+		_, err := time.LoadLocation(name)
+		return err == nil
+	}
+}
@@ -0,0 +1,40 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimezone_ValidatorFunction(t *testing.T) {
+	v := validator.New()
+
+	customValidator := New(nil)
+	v.RegisterValidation("timezone", customValidator.Timezone())
+
+	type TestStruct struct {
+		Timezone string `validate:"timezone"`
+	}
+
+	tests := []struct {
+		name     string
+		timezone string
+		expected bool
+	}{
+		{name: "jakarta", timezone: "Asia/Jakarta", expected: true},
+		{name: "new york", timezone: "America/New_York", expected: true},
+		{name: "utc", timezone: "UTC", expected: true},
+		{name: "empty", timezone: "", expected: false},
+		{name: "local", timezone: "Local", expected: false},
+		{name: "unknown zone", timezone: "Mars/Olympus_Mons", expected: false},
+		{name: "offset string", timezone: "+07:00", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(TestStruct{Timezone: tt.timezone})
+			assert.Equal(t, tt.expected, err == nil)
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 type CustomValidator interface {
 	Unique() validator.Func
 	Exist() validator.Func
+	Timezone() validator.Func
+	CardCodeFormat() validator.Func
 }
 
 // Validator interface for direct method calls in tests